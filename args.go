@@ -0,0 +1,161 @@
+package sflags
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const argRest = "rest"
+
+// Arg structure might be used by cli/flag libraries for positional arguments generation.
+type Arg struct {
+	Name     string // name for usage message
+	Usage    string // help message
+	Value    Value  // value as set
+	Required bool
+	Rest     bool // takes all remaining arguments
+}
+
+// Unwrap returns the value of the field without wrappers, see Flag.Unwrap.
+func (a *Arg) Unwrap() Value {
+	return unwrapValue(a.Value)
+}
+
+// ParseArgs parses structure and returns list of positional arguments,
+// that are described by `arg:"0"` ... `arg:"N"` and `arg:"rest"` tags.
+// Only fields of the top level structure are used as arguments.
+//
+// Tag format is `arg:"<index|rest> [name],required"`,
+// name is taken from the field name if it's omitted.
+func ParseArgs(cfg interface{}, optFuncs ...OptFunc) ([]*Arg, error) {
+	v, err := structValue(cfg)
+	if err != nil {
+		return nil, err
+	}
+	opt := defOpts().apply(optFuncs...)
+
+	var args []*Arg
+	indexes := map[*Arg]int{}
+	valueType := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		field := valueType.Field(i)
+		argTag, isArg := field.Tag.Lookup(defaultArgTag)
+		if !isArg || field.PkgPath != "" {
+			continue
+		}
+		argTags := strings.Split(argTag, ",")
+		pos := strings.Split(argTags[0], " ")
+		arg := &Arg{
+			Name:     camelToFlag(field.Name, opt.flagDivider),
+			Usage:    field.Tag.Get(opt.descTag),
+			Required: hasOption(argTags[1:], "required"),
+			Rest:     pos[0] == argRest,
+		}
+		if len(pos) > 1 && pos[1] != "" {
+			arg.Name = pos[1]
+		}
+		if !arg.Rest {
+			index, err := strconv.Atoi(pos[0])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid arg tag %q for field %s", argTag, field.Name)
+			}
+			indexes[arg] = index
+		}
+
+		isZero := v.Field(i).IsZero()
+		_, val, err := parseVal(v.Field(i), copyOpts(opt))
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return nil, fmt.Errorf("field %s has unsupported type %s for argument", field.Name, field.Type)
+		}
+		if arg.Rest {
+			if repeatable, casted := val.(RepeatableFlag); !casted || !repeatable.IsCumulative() {
+				return nil, fmt.Errorf("field %s should be a slice to take the rest of arguments", field.Name)
+			}
+		}
+		if opt.validator != nil {
+			val = &validateValue{
+				Value: val,
+				validateFunc: func(val string) error {
+					return opt.validator(val, field, v.Interface())
+				},
+			}
+		}
+		if err := setDefault(field, isZero, val); err != nil {
+			return nil, err
+		}
+		merge, err := parseMergeTag(field, val)
+		if err != nil {
+			return nil, err
+		}
+		// values from command line replace the default value of slices like they do for flags
+		arg.Value = newSourceValue(val, merge)
+		args = append(args, arg)
+	}
+
+	// positional arguments go first, sorted by index, the rest goes last.
+	sort.SliceStable(args, func(i, j int) bool {
+		if args[i].Rest != args[j].Rest {
+			return args[j].Rest
+		}
+		return indexes[args[i]] < indexes[args[j]]
+	})
+	for i, arg := range args {
+		if arg.Rest {
+			if i != len(args)-1 {
+				return nil, errors.New("only one argument can take the rest of arguments")
+			}
+			break
+		}
+		if indexes[arg] != i {
+			return nil, fmt.Errorf("argument %s has index %d, expected %d", arg.Name, indexes[arg], i)
+		}
+		if arg.Required && i > 0 && !args[i-1].Required {
+			return nil, fmt.Errorf("required argument %s can't follow optional argument %s", arg.Name, args[i-1].Name)
+		}
+	}
+	return args, nil
+}
+
+// SetArgs sets values of positional arguments from command line values,
+// that are left after flags parsing (e.g. flag.FlagSet.Args()).
+// Returns a single error with names of all missing required arguments.
+func SetArgs(args []*Arg, values []string) error {
+	var missing []string
+	for _, arg := range args {
+		if arg.Rest {
+			if arg.Required && len(values) == 0 {
+				missing = append(missing, arg.Name)
+			}
+			for _, val := range values {
+				if err := arg.Value.Set(val); err != nil {
+					return fmt.Errorf("invalid value %q for argument %s: %v", val, arg.Name, err)
+				}
+			}
+			values = nil
+			continue
+		}
+		if len(values) == 0 {
+			if arg.Required {
+				missing = append(missing, arg.Name)
+			}
+			continue
+		}
+		if err := arg.Value.Set(values[0]); err != nil {
+			return fmt.Errorf("invalid value %q for argument %s: %v", values[0], arg.Name, err)
+		}
+		values = values[1:]
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf(`required argument(s) "%s" not provided`, strings.Join(missing, `", "`))
+	}
+	if len(values) > 0 {
+		return fmt.Errorf("unexpected argument(s): %s", strings.Join(values, " "))
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package sflags
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseArgs(t *testing.T) {
+	cfg := &struct {
+		Name   string
+		Dst    string   `arg:"1" desc:"destination"`
+		Src    string   `arg:"0 source,required" desc:"source"`
+		Files  []string `arg:"rest"`
+		Nested struct {
+			Ignored string `arg:"2"`
+		}
+	}{}
+	args, err := ParseArgs(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []*Arg{
+		{
+			Name:     "source",
+			Usage:    "source",
+			Value:    &sourceValue{Value: newStringValue(&cfg.Src)},
+			Required: true,
+		},
+		{
+			Name:  "dst",
+			Usage: "destination",
+			Value: &sourceValue{Value: newStringValue(&cfg.Dst)},
+		},
+		{
+			Name:  "files",
+			Value: &sourceValue{Value: newStringSliceValue(&cfg.Files)},
+			Rest:  true,
+		},
+	}, args)
+
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(flags))
+	assert.Equal(t, "name", flags[0].Name)
+}
+
+func TestParseArgs_Default(t *testing.T) {
+	cfg := &struct {
+		Src string `arg:"0" default:"."`
+		Dst string `arg:"1" default:"/tmp"`
+	}{Dst: "/var"}
+	args, err := ParseArgs(cfg)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(args))
+	assert.Equal(t, ".", cfg.Src)
+	assert.Equal(t, "/var", cfg.Dst)
+
+	_, err = ParseArgs(&struct {
+		Count int `arg:"0" default:"abc"`
+	}{})
+	assert.EqualError(t, err,
+		`invalid default value "abc" for field Count: strconv.ParseInt: parsing "abc": invalid syntax`)
+}
+
+func TestParseArgs_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    interface{}
+		expErr error
+	}{
+		{
+			name: "Bad index",
+			cfg: &struct {
+				Src string `arg:"first"`
+			}{},
+			expErr: errors.New(`invalid arg tag "first" for field Src`),
+		},
+		{
+			name: "Gap in indexes",
+			cfg: &struct {
+				Src string `arg:"0"`
+				Dst string `arg:"2"`
+			}{},
+			expErr: errors.New("argument dst has index 2, expected 1"),
+		},
+		{
+			name: "Two rest arguments",
+			cfg: &struct {
+				Files  []string `arg:"rest"`
+				Files2 []string `arg:"rest"`
+			}{},
+			expErr: errors.New("only one argument can take the rest of arguments"),
+		},
+		{
+			name: "Rest is not a slice",
+			cfg: &struct {
+				Files string `arg:"rest"`
+			}{},
+			expErr: errors.New("field Files should be a slice to take the rest of arguments"),
+		},
+		{
+			name: "Required after optional",
+			cfg: &struct {
+				Src string `arg:"0"`
+				Dst string `arg:"1,required"`
+			}{},
+			expErr: errors.New("required argument dst can't follow optional argument src"),
+		},
+		{
+			name: "Unsupported type",
+			cfg: &struct {
+				Func func() `arg:"0"`
+			}{},
+			expErr: errors.New("field Func has unsupported type func() for argument"),
+		},
+		{
+			name:   "Not a pointer",
+			cfg:    struct{}{},
+			expErr: errors.New("object must be a pointer to struct or interface"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseArgs(test.cfg)
+			assert.Equal(t, test.expErr, err)
+		})
+	}
+}
+
+func TestSetArgs(t *testing.T) {
+	type config struct {
+		Src   string   `arg:"0,required"`
+		Dst   string   `arg:"1"`
+		Port  int      `arg:"2"`
+		Files []string `arg:"rest"`
+	}
+	tests := []struct {
+		name   string
+		values []string
+		expCfg config
+		expErr string
+	}{
+		{
+			name:   "All arguments",
+			values: []string{"src", "dst", "80", "one", "two"},
+			expCfg: config{Src: "src", Dst: "dst", Port: 80, Files: []string{"one", "two"}},
+		},
+		{
+			name:   "Only required",
+			values: []string{"src"},
+			expCfg: config{Src: "src"},
+		},
+		{
+			name:   "Missing required",
+			values: []string{},
+			expErr: `required argument(s) "src" not provided`,
+		},
+		{
+			name:   "Invalid value",
+			values: []string{"src", "dst", "port"},
+			expCfg: config{Src: "src", Dst: "dst"},
+			expErr: `invalid value "port" for argument port: strconv.ParseInt: parsing "port": invalid syntax`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &config{}
+			args, err := ParseArgs(cfg)
+			require.NoError(t, err)
+			err = SetArgs(args, test.values)
+			if test.expErr != "" {
+				assert.EqualError(t, err, test.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expCfg, *cfg)
+		})
+	}
+}
+
+func TestSetArgs_RestDefault(t *testing.T) {
+	cfg := &struct {
+		Files []string `arg:"rest" default:"a,b"`
+	}{}
+	args, err := ParseArgs(cfg)
+	require.NoError(t, err)
+	assert.IsType(t, &stringSliceValue{}, args[0].Unwrap())
+	require.NoError(t, SetArgs(args, []string{}))
+	assert.Equal(t, []string{"a", "b"}, cfg.Files)
+
+	require.NoError(t, SetArgs(args, []string{"x", "y"}))
+	assert.Equal(t, []string{"x", "y"}, cfg.Files)
+
+	appendCfg := &struct {
+		Files []string `arg:"rest" default:"a,b" merge:"append"`
+	}{}
+	args, err = ParseArgs(appendCfg)
+	require.NoError(t, err)
+	require.NoError(t, SetArgs(args, []string{"x", "y"}))
+	assert.Equal(t, []string{"a", "b", "x", "y"}, appendCfg.Files)
+}
+
+func TestSetArgs_Unexpected(t *testing.T) {
+	cfg := &struct {
+		Src   string `arg:"0"`
+		Files []int  `arg:"rest,required"`
+	}{}
+	args, err := ParseArgs(cfg)
+	require.NoError(t, err)
+	assert.EqualError(t, SetArgs(args, []string{"src"}), `required argument(s) "files" not provided`)
+	assert.EqualError(t, SetArgs(args, []string{"src", "a"}),
+		`invalid value "a" for argument files: strconv.ParseInt: parsing "a": invalid syntax`)
+
+	cfg2 := &struct {
+		Src string `arg:"0"`
+	}{}
+	args, err = ParseArgs(cfg2)
+	require.NoError(t, err)
+	assert.EqualError(t, SetArgs(args, []string{"src", "a", "b"}), "unexpected argument(s): a b")
+}
+
+func TestParseArgs_WithValidator(t *testing.T) {
+	cfg := &struct {
+		Src string `arg:"0"`
+	}{}
+	testErr := errors.New("validator test error")
+	args, err := ParseArgs(cfg, Validator(func(string, reflect.StructField, interface{}) error {
+		return testErr
+	}))
+	require.NoError(t, err)
+	assert.EqualError(t, SetArgs(args, []string{"src"}),
+		`invalid value "src" for argument src: validator test error`)
+}
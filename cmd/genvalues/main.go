@@ -78,6 +78,9 @@ func parseGeneratedMap(value interface{}) Value {
 // -- {{.Type}} Value
 type {{.|ValueName}} struct {
 	value *{{.Type}}
+	{{if .Layout}}\nn
+	layout string
+	{{end}}\nn
 }
 
 var _ Value = (*{{.|ValueName}})(nil)
@@ -122,6 +125,9 @@ func (v *{{.|ValueName}}) String() string {
 }
 
 func (v *{{.|ValueName}}) Type() string { return "{{.|Type}}" }
+{{if .Layout}}
+func (v *{{.|ValueName}}) setLayout(layout string) { v.layout = layout }
+{{end}}\nn
 
 {{ if not .NoSlice }}
 // -- {{.Type}}Slice Value
@@ -129,6 +135,10 @@ func (v *{{.|ValueName}}) Type() string { return "{{.|Type}}" }
 type {{.|SliceValueName}} struct{
 	value   *[]{{.Type}}
 	changed bool
+	sep     string
+	{{if .Layout}}\nn
+	layout string
+	{{end}}\nn
 }
 
 var _ RepeatableFlag = (*{{.|SliceValueName}})(nil)
@@ -143,7 +153,10 @@ func new{{.|Name}}SliceValue(slice *[]{{.Type}}) *{{.|SliceValueName}}  {
 }
 
 func (v *{{.|SliceValueName}}) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 	{{if .Parser }}
 	out := make([]{{.Type}}, len(ss))
 	for i, s := range ss {
@@ -182,7 +195,11 @@ func (v *{{.|SliceValueName}}) String() string {
 	}
 	out := make([]string, 0, len(*v.value))
 	for _, elem := range *v.value {
+		{{if .Layout}}\nn
+		out = append(out, (&{{.|ValueName}}{value: &elem, layout: v.layout}).String())
+		{{else}}\nn
 		out = append(out, new{{.|Name}}Value(&elem).String())
+		{{end}}\nn
 	}
 	return "[" + strings.Join(out, ",") + "]"
 }
@@ -193,6 +210,20 @@ func (v *{{.|SliceValueName}}) IsCumulative() bool {
 	return true
 }
 
+func (v *{{.|SliceValueName}}) sliceSeparator() string { return v.sep }
+
+func (v *{{.|SliceValueName}}) clear() {
+	*v.value = []{{.Type}}{}
+	v.changed = true
+}
+
+func (v *{{.|SliceValueName}}) appendNext() { v.changed = true }
+
+func (v *{{.|SliceValueName}}) setSliceSeparator(sep string) { v.sep = sep }
+{{if .Layout}}
+func (v *{{.|SliceValueName}}) setLayout(layout string) { v.layout = layout }
+{{end}}\nn
+
 {{end}}
 
 {{ if not .NoMap }}
@@ -201,6 +232,10 @@ func (v *{{.|SliceValueName}}) IsCumulative() bool {
 // -- {{ MapValueName $value . }}
 type {{ MapValueName $value . }} struct {
 	value *map[{{.}}]{{$value.Type}}
+	sep   string
+	{{if $value.Layout}}\nn
+	layout string
+	{{end}}\nn
 }
 
 var _ RepeatableFlag = (*{{MapValueName $value .}})(nil)
@@ -215,14 +250,14 @@ func new{{MapValueName $value . | Title}}(m *map[{{.}}]{{$value.Type}}) *{{MapVa
 }
 
 func (v *{{MapValueName $value .}}) Set(s string) error {
-	ss := strings.Split(s, ":")
-    if len(ss) < 2 {
-        return errors.New("invalid map flag syntax, use -map=key1:val1")
-    }
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
 
 	{{ $kindVal := KindValue . }}
 
-	s = ss[0]
+	s = rawKey
 
 	{{if $kindVal.Parser }}\nn
 	parsedKey, err := {{$kindVal.Parser}}
@@ -241,8 +276,8 @@ func (v *{{MapValueName $value .}}) Set(s string) error {
 	{{end}}\nn
 
 
-	s = ss[1]
- 
+	s = rawVal
+
 	{{if $value.Parser }}\nn
 	parsedVal, err := {{$value.Parser}}
 	if err != nil {
@@ -275,13 +310,32 @@ func (v *{{MapValueName $value .}}) Get() interface{} {
 func (v *{{MapValueName $value .}}) String() string {
 	if v != nil && v.value != nil && len(*v.value) > 0 {
 {{/* flag package create zero Value and compares it to actual Value */}}\nn
+		{{if $value.Layout}}\nn
+		out := make(map[{{.}}]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&{{$value|ValueName}}{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
+		{{else}}\nn
 		return fmt.Sprintf("%v", *v.value)
+		{{end}}\nn
 	}
 	return ""
 }
 
 func (v *{{MapValueName $value .}}) Type() string { return "map[{{.}}]{{$value.Type}}" }
 
+func (v *{{MapValueName $value .}}) mapSeparator() string { return v.sep }
+
+func (v *{{MapValueName $value .}}) clear() { *v.value = make(map[{{.}}]{{$value.Type}}) }
+
+func (v *{{MapValueName $value .}}) appendNext() {}
+
+func (v *{{MapValueName $value .}}) setMapSeparator(sep string) { v.sep = sep }
+{{if $value.Layout}}
+func (v *{{MapValueName $value .}}) setLayout(layout string) { v.layout = layout }
+{{end}}\nn
+
 func (v *{{MapValueName $value .}}) IsCumulative() bool {
 	return true
 }
@@ -413,8 +467,10 @@ func Test{{MapValueName $value $keyType | Title}}(t *testing.T) {
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
 		{{range .In}}\nn
+		{{if not (HasMapSep .)}}\nn
 		err = v.Set("{{$keyType | KindTest}}{{.}}")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		{{end}}\nn
 		{{if ne $keyType "string"}}\nn
 		err = v.Set(":{{.}}")
 		assert.NotNil(t, err)
@@ -500,6 +556,7 @@ type value struct {
 	NoSlice       bool        `json:"no_slice"`
 	SliceTests    []sliceTest `json:"slice_tests"`
 	NoMap         bool        `json:"no_map"`
+	Layout        bool        `json:"layout"`
 	MapTests      []mapTest   `json:"map_tests"`
 }
 
@@ -536,6 +593,9 @@ func main() {
 
 	baseT := template.New("genvalues").Funcs(template.FuncMap{
 		"Lower": strings.ToLower,
+		"HasMapSep": func(s string) bool {
+			return strings.Contains(s, ":")
+		},
 		"Title": strings.Title,
 		"Format": func(v *value) string {
 			if v.Format != "" {
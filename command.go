@@ -0,0 +1,171 @@
+package sflags
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Command structure might be used by cli/flag libraries for command tree generation.
+// Root command has an empty name and describes the application itself.
+type Command struct {
+	Name     string // name as it appears on command line
+	Usage    string // help message
+	Flags    []*Flag
+	Args     []*Arg
+	Commands []*Command // subcommands
+
+	parent   *Command
+	selected bool
+	cfg      interface{}
+	optFuncs []OptFunc
+}
+
+// Select marks the command and all its parents as selected.
+// It's called by generators, when the command is chosen on command line.
+func (c *Command) Select() {
+	for cmd := c; cmd != nil; cmd = cmd.parent {
+		cmd.selected = true
+	}
+}
+
+// Selected returns the deepest selected subcommand of c,
+// or c itself if none of subcommands was selected.
+func (c *Command) Selected() *Command {
+	for _, cmd := range c.Commands {
+		if cmd.selected {
+			return cmd.Selected()
+		}
+	}
+	return c
+}
+
+// Path returns names of all commands from the root to c, excluding the root.
+func (c *Command) Path() []string {
+	var path []string
+	for cmd := c; cmd != nil && cmd.parent != nil; cmd = cmd.parent {
+		path = append([]string{cmd.Name}, path...)
+	}
+	return path
+}
+
+// Validate calls ValidateStruct for structures of c and all its parents, starting from the root.
+// Errors of subcommands are prefixed by the command path.
+// Generators call it for the selected command after parsing.
+func (c *Command) Validate() error {
+	var cmds []*Command
+	for cmd := c; cmd != nil; cmd = cmd.parent {
+		cmds = append([]*Command{cmd}, cmds...)
+	}
+	for _, cmd := range cmds {
+		if cmd.cfg == nil {
+			continue
+		}
+		if err := ValidateStruct(cmd.cfg, cmd.optFuncs...); err != nil {
+			if cmd.parent != nil {
+				return fmt.Errorf("command %s: %v", strings.Join(cmd.Path(), " "), err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateRequired calls ValidateRequired for flags of c and all its parents,
+// because flags of parents might be used with subcommands.
+// Generators call it for the selected command after parsing.
+func (c *Command) ValidateRequired() error {
+	var flags []*Flag
+	for cmd := c; cmd != nil; cmd = cmd.parent {
+		flags = append(append([]*Flag{}, cmd.Flags...), flags...)
+	}
+	return ValidateRequired(flags)
+}
+
+// ParseCommand parses structure and returns a tree of commands.
+// Fields with `cmd:"name"` tag should be structures (or pointers to them),
+// they become subcommands with their own flags, positional arguments and subcommands.
+// Flags and environment variables of subcommands aren't prefixed by the command name,
+// unless cmd tag has prefix option: `cmd:"name,prefix"` prefixes them by the command name
+// and a flag divider, e.g. "remote-url" and "REMOTE_URL", `cmd:"name,prefix=val"` prefixes them by val.
+// Prefixes of nested subcommands are added to prefixes of their parents.
+// Only fields of the top level structure are used as subcommands.
+func ParseCommand(cfg interface{}, optFuncs ...OptFunc) (*Command, error) {
+	v, err := structValue(cfg)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &Command{}
+	if err := parseCommand(cmd, v, optFuncs...); err != nil {
+		return nil, err
+	}
+	return cmd, nil
+}
+
+func parseCommand(cmd *Command, v reflect.Value, optFuncs ...OptFunc) error {
+	var err error
+	cfg := v.Addr().Interface()
+	cmd.cfg, cmd.optFuncs = cfg, optFuncs
+	if cmd.Flags, err = ParseStruct(cfg, optFuncs...); err != nil {
+		return err
+	}
+	if cmd.Args, err = ParseArgs(cfg, optFuncs...); err != nil {
+		return err
+	}
+	opt := defOpts().apply(optFuncs...)
+
+	valueType := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		field := valueType.Field(i)
+		cmdTag, isCmd := field.Tag.Lookup(defaultCmdTag)
+		if !isCmd || field.PkgPath != "" {
+			continue
+		}
+		fieldValue := v.Field(i)
+		if fieldValue.Kind() == reflect.Ptr && fieldValue.Type().Elem().Kind() == reflect.Struct {
+			if fieldValue.IsNil() {
+				fieldValue.Set(reflect.New(fieldValue.Type().Elem()))
+			}
+			fieldValue = fieldValue.Elem()
+		}
+		if fieldValue.Kind() != reflect.Struct {
+			return fmt.Errorf("field %s should be a structure to be a command", field.Name)
+		}
+		sub := &Command{
+			Name:   strings.Split(cmdTag, ",")[0],
+			Usage:  field.Tag.Get(opt.descTag),
+			parent: cmd,
+		}
+		if sub.Name == "" {
+			sub.Name = camelToFlag(field.Name, opt.flagDivider)
+		}
+		for _, existed := range cmd.Commands {
+			if existed.Name == sub.Name {
+				return fmt.Errorf("duplicate command %s", sub.Name)
+			}
+		}
+		subOptFuncs := optFuncs
+		if prefix, found := commandPrefix(strings.Split(cmdTag, ",")[1:], sub.Name, opt); found {
+			subOptFuncs = append(append([]OptFunc{}, optFuncs...), Prefix(opt.prefix+prefix))
+		}
+		if err := parseCommand(sub, fieldValue, subOptFuncs...); err != nil {
+			return err
+		}
+		cmd.Commands = append(cmd.Commands, sub)
+	}
+	return nil
+}
+
+// commandPrefix returns prefix of flags of the subcommand from options of cmd tag,
+// "prefix" option uses the command name, "prefix=val" option sets it explicitly.
+func commandPrefix(options []string, name string, opt opts) (string, bool) {
+	for _, option := range options {
+		switch {
+		case option == "prefix":
+			return name + opt.flagDivider, true
+		case strings.HasPrefix(option, "prefix="):
+			return strings.TrimPrefix(option, "prefix="), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,168 @@
+package sflags
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type remoteAddCmd struct {
+	Fetch bool
+	Name  string `arg:"0,required"`
+	URL   string `arg:"1 url,required"`
+}
+
+type remoteCmd struct {
+	Verbose bool
+	Add     remoteAddCmd `cmd:"add" desc:"add remote"`
+	Remove  *struct {
+		Name string `arg:"0"`
+	} `cmd:"" desc:"remove remote"`
+}
+
+type gitCmd struct {
+	Debug  bool
+	Remote remoteCmd `cmd:"remote" desc:"manage remotes"`
+}
+
+func TestParseCommand(t *testing.T) {
+	cfg := &gitCmd{}
+	root, err := ParseCommand(cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, "", root.Name)
+	require.Len(t, root.Flags, 1)
+	assert.Equal(t, "debug", root.Flags[0].Name)
+	require.Len(t, root.Commands, 1)
+
+	remote := root.Commands[0]
+	assert.Equal(t, "remote", remote.Name)
+	assert.Equal(t, "manage remotes", remote.Usage)
+	require.Len(t, remote.Flags, 1)
+	assert.Equal(t, "verbose", remote.Flags[0].Name)
+	require.Len(t, remote.Commands, 2)
+
+	add := remote.Commands[0]
+	assert.Equal(t, "add", add.Name)
+	require.Len(t, add.Flags, 1)
+	assert.Equal(t, "fetch", add.Flags[0].Name)
+	require.Len(t, add.Args, 2)
+	assert.Equal(t, "url", add.Args[1].Name)
+	assert.Equal(t, []string{"remote", "add"}, add.Path())
+
+	remove := remote.Commands[1]
+	assert.Equal(t, "remove", remove.Name)
+	require.NotNil(t, cfg.Remote.Remove)
+	require.Len(t, remove.Args, 1)
+	require.NoError(t, remove.Args[0].Value.Set("origin"))
+	assert.Equal(t, "origin", cfg.Remote.Remove.Name)
+}
+
+func TestCommand_Selected(t *testing.T) {
+	root, err := ParseCommand(&gitCmd{})
+	require.NoError(t, err)
+	assert.Equal(t, root, root.Selected())
+
+	add := root.Commands[0].Commands[0]
+	add.Select()
+	assert.Equal(t, add, root.Selected())
+	assert.Equal(t, add, root.Commands[0].Selected())
+}
+
+func TestCommand_ValidateRequired(t *testing.T) {
+	cfg := &struct {
+		Token string `flag:",required"`
+		Push  struct {
+			Remote string `flag:",required"`
+			Force  bool
+		} `cmd:"push"`
+	}{}
+	root, err := ParseCommand(cfg)
+	require.NoError(t, err)
+	push := root.Commands[0]
+
+	assert.EqualError(t, push.ValidateRequired(), `required flag(s) "token", "remote" not set`)
+	assert.EqualError(t, root.ValidateRequired(), `required flag(s) "token" not set`)
+
+	require.NoError(t, root.Flags[0].Value.Set("secret"))
+	require.NoError(t, push.Flags[0].Value.Set("origin"))
+	assert.NoError(t, push.ValidateRequired())
+}
+
+func TestParseCommand_Prefix(t *testing.T) {
+	type pushCmd struct {
+		Force bool
+	}
+	cfg := &struct {
+		Force  bool
+		Push   pushCmd `cmd:"push,prefix"`
+		Remote struct {
+			URL string `env:"ADDR"`
+			Add struct {
+				Name string
+			} `cmd:"add,prefix"`
+			Pull pushCmd `cmd:"pull"`
+		} `cmd:"remote,prefix=rmt-"`
+	}{}
+	root, err := ParseCommand(cfg, EnvPrefix("APP_"))
+	require.NoError(t, err)
+	assert.Equal(t, "force", root.Flags[0].Name)
+	assert.Equal(t, "APP_FORCE", root.Flags[0].EnvName)
+
+	push := root.Commands[0]
+	assert.Equal(t, "push-force", push.Flags[0].Name)
+	assert.Equal(t, "APP_PUSH_FORCE", push.Flags[0].EnvName)
+
+	remote := root.Commands[1]
+	assert.Equal(t, "rmt-url", remote.Flags[0].Name)
+	assert.Equal(t, "APP_RMT_ADDR", remote.Flags[0].EnvName)
+	assert.Equal(t, "rmt-add-name", remote.Commands[0].Flags[0].Name)
+	assert.Equal(t, "APP_RMT_ADD_NAME", remote.Commands[0].Flags[0].EnvName)
+	assert.Equal(t, "rmt-force", remote.Commands[1].Flags[0].Name)
+}
+
+func TestParseCommand_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    interface{}
+		expErr error
+	}{
+		{
+			name:   "Test bad cfg value",
+			cfg:    "bad config",
+			expErr: errors.New("object must be a pointer to struct or interface"),
+		},
+		{
+			name: "Test not a struct",
+			cfg: &struct {
+				Sub string `cmd:"sub"`
+			}{},
+			expErr: errors.New("field Sub should be a structure to be a command"),
+		},
+		{
+			name: "Test duplicate command",
+			cfg: &struct {
+				Sub1 struct{} `cmd:"sub"`
+				Sub2 struct{} `cmd:"sub"`
+			}{},
+			expErr: errors.New("duplicate command sub"),
+		},
+		{
+			name: "Test bad args of subcommand",
+			cfg: &struct {
+				Sub struct {
+					Src string `arg:"1"`
+				} `cmd:"sub"`
+			}{},
+			expErr: errors.New("argument src has index 1, expected 0"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseCommand(test.cfg)
+			assert.Equal(t, test.expErr, err)
+		})
+	}
+}
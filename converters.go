@@ -1,6 +1,7 @@
 package sflags
 
 import (
+	"reflect"
 	"strings"
 )
 
@@ -14,3 +15,14 @@ func camelToFlag(s, flagDivider string) string {
 func flagToEnv(s, flagDivider, envDivider string) string {
 	return strings.ToUpper(strings.Replace(s, flagDivider, envDivider, -1))
 }
+
+// transform name of Go type to lowerCamelCase, e.g. IPAddr to ipAddr,
+// it's used as Type() of values, that aren't generated.
+func typeName(typ reflect.Type) string {
+	if typ.Name() == "" {
+		return typ.String()
+	}
+	splitted := split(typ.Name())
+	splitted[0] = strings.ToLower(splitted[0])
+	return strings.Join(splitted, "")
+}
@@ -1,6 +1,7 @@
 package sflags
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -36,3 +37,18 @@ func TestFlagToEnv(t *testing.T) {
 		assert.Equal(t, d.Exp, flagToEnv(d.Src, defaultFlagDivider, defaultEnvDivider))
 	}
 }
+
+func TestTypeName(t *testing.T) {
+	type IPAddr string
+	data := []struct {
+		Src reflect.Type
+		Exp string
+	}{
+		{reflect.TypeOf(logLevel(0)), "logLevel"},
+		{reflect.TypeOf(IPAddr("")), "ipAddr"},
+		{reflect.TypeOf(struct{}{}), "struct {}"},
+	}
+	for _, d := range data {
+		assert.Equal(t, d.Exp, typeName(d.Src))
+	}
+}
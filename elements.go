@@ -0,0 +1,219 @@
+package sflags
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var valueInterfaceType = reflect.TypeOf((*Value)(nil)).Elem()
+
+// isStructElems returns true for slices and maps (with string or integer keys)
+// of structures or pointers to structures, that are parsed as families of nested flags,
+// e.g. "backends-0-host" for []Backend or "upstreams-eu-timeout" for map[string]Upstream.
+func isStructElems(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Slice:
+	case reflect.Map:
+		if !anyOf(MapAllowedKinds, typ.Key().Kind()) {
+			return false
+		}
+	default:
+		return false
+	}
+	elem := typ.Elem()
+	if elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return false
+	}
+	// structures, that are parsed as a single value, are elements of slice and map values
+	return lookupType(elem) == nil &&
+		newTextElemFunc(elem) == nil &&
+		!reflect.PtrTo(elem).Implements(valueInterfaceType)
+}
+
+// maxSliceGrowth is the maximum number of elements, that are added to a slice of structures.
+const maxSliceGrowth = 1000
+
+// parseStructSlice parses every element of the slice as a nested structure,
+// flags of the element are prefixed by its index.
+// The slice grows to the largest index, that is found in command line, loaders or environment.
+// It can't grow by more than maxSliceGrowth elements, so a typo like --backends-100000000-host
+// doesn't allocate a huge slice.
+func parseStructSlice(value reflect.Value, opt opts) ([]*Flag, error) {
+	names, envNames, err := elemNames(value.Type().Elem(), opt)
+	if err != nil {
+		return nil, err
+	}
+	size, limit := value.Len(), value.Len()+maxSliceGrowth
+	for _, key := range discoverKeys(names, envNames, opt) {
+		i, err := strconv.Atoi(key)
+		if err != nil || i < size {
+			continue
+		}
+		if i >= limit {
+			return nil, fmt.Errorf("index %d of field %s is out of range, max is %d", i, opt.path, limit-1)
+		}
+		size = i + 1
+	}
+	if size > value.Len() {
+		grown := reflect.MakeSlice(value.Type(), size, size)
+		reflect.Copy(grown, value)
+		value.Set(grown)
+	}
+
+	flags := []*Flag{}
+	for i := 0; i < value.Len(); i++ {
+		elemFlags, err := parseElem(value.Index(i), strconv.Itoa(i), opt)
+		if err != nil {
+			return nil, err
+		}
+		flags = append(flags, elemFlags...)
+	}
+	return flags, nil
+}
+
+// parseStructMap parses every element of the map as a nested structure,
+// flags of the element are prefixed by its key.
+// Elements are added for keys, that are found in command line, loaders or environment.
+func parseStructMap(value reflect.Value, opt opts) ([]*Flag, error) {
+	mapType := value.Type()
+	names, envNames, err := elemNames(mapType.Elem(), opt)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range discoverKeys(names, envNames, opt) {
+		key, err := parseMapKey(s, mapType.Key())
+		if err != nil {
+			continue
+		}
+		if !value.MapIndex(key).IsValid() {
+			value.SetMapIndex(key, reflect.Zero(mapType.Elem()))
+		}
+	}
+
+	keys := value.MapKeys()
+	sortMapKeys(keys)
+	flags := []*Flag{}
+	for _, key := range keys {
+		// map elements aren't addressable, so a copy is parsed
+		// and it's stored back to the map after every change.
+		ptr := reflect.New(mapType.Elem())
+		ptr.Elem().Set(value.MapIndex(key))
+		elemFlags, err := parseElem(ptr.Elem(), fmt.Sprint(key.Interface()), opt)
+		if err != nil {
+			return nil, err
+		}
+		value.SetMapIndex(key, ptr.Elem())
+		if mapType.Elem().Kind() != reflect.Ptr {
+			store := func(key reflect.Value) func() {
+				return func() { value.SetMapIndex(key, ptr.Elem()) }
+			}(key)
+			for _, flag := range elemFlags {
+				if v, casted := sourceValueOf(flag.Value); casted {
+					v.afterSet = store
+				}
+			}
+		}
+		flags = append(flags, elemFlags...)
+	}
+	return flags, nil
+}
+
+// parseElem parses the element of a slice or map with the key as a part of flag names and path.
+func parseElem(elem reflect.Value, key string, opt opts) ([]*Flag, error) {
+	flags, _, err := parseVal(elem,
+		copyOpts(opt),
+		Prefix(opt.prefix+key+opt.flagDivider),
+		fieldPath(opt.path+"["+key+"]"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	// short names can't be shared by elements
+	for _, flag := range flags {
+		flag.Short = ""
+	}
+	return flags, nil
+}
+
+// elemNames returns flag and env names of an element without prefixes,
+// they are sorted from the longest to the shortest to find keys unambiguously.
+func elemNames(typ reflect.Type, opt opts) (names, envNames []string, err error) {
+	flags, _, err := parseVal(reflect.New(typ).Elem(),
+		copyOpts(opt),
+		Prefix(""),
+		EnvPrefix(""),
+		OnSkip(nil),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, flag := range flags {
+		names = append(names, flag.Name)
+		if flag.EnvName != "" {
+			envNames = append(envNames, flag.EnvName)
+		}
+	}
+	byLen := func(s []string) func(i, j int) bool {
+		return func(i, j int) bool { return len(s[i]) > len(s[j]) }
+	}
+	sort.SliceStable(names, byLen(names))
+	sort.SliceStable(envNames, byLen(envNames))
+	return names, envNames, nil
+}
+
+// discoverKeys returns keys of elements, that are used in command line arguments, names of flags
+// set by loaders or names of environment variables, e.g. "0" for "--backends-0-host" or "BACKENDS_0_HOST".
+// Keys from environment are converted to flag-case, e.g. "EU_WEST" to "eu-west".
+func discoverKeys(names, envNames []string, opt opts) []string {
+	keys := []string{}
+	seen := map[string]bool{}
+	add := func(key string) {
+		if key != "" && !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	for _, arg := range opt.commandLine() {
+		if arg == "--" {
+			break
+		}
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+		name := strings.SplitN(strings.TrimLeft(arg, "-"), "=", 2)[0]
+		if strings.HasPrefix(name, opt.prefix) {
+			add(elemKey(name[len(opt.prefix):], opt.flagDivider, names))
+		}
+	}
+	for _, name := range opt.loaded {
+		if strings.HasPrefix(name, opt.prefix) {
+			add(elemKey(name[len(opt.prefix):], opt.flagDivider, names))
+		}
+	}
+	envPrefix := opt.envPrefix + flagToEnv(opt.prefix, opt.flagDivider, opt.envDivider)
+	for _, env := range os.Environ() {
+		name := strings.SplitN(env, "=", 2)[0]
+		if strings.HasPrefix(name, envPrefix) {
+			key := elemKey(name[len(envPrefix):], opt.envDivider, envNames)
+			add(strings.ToLower(strings.Replace(key, opt.envDivider, opt.flagDivider, -1)))
+		}
+	}
+	return keys
+}
+
+// elemKey returns key from s, that is key, divider and one of names, or empty string.
+func elemKey(s, divider string, names []string) string {
+	for _, name := range names {
+		if strings.HasSuffix(s, divider+name) {
+			return strings.TrimSuffix(s, divider+name)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,202 @@
+package sflags
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type backendCfg struct {
+	Host      string `flag:"host h" desc:"backend host"`
+	Port      int    `default:"80"`
+	Timeout   time.Duration
+	ReadLimit int
+}
+
+type upstreamCfg struct {
+	Timeout     time.Duration
+	ReadTimeout time.Duration
+}
+
+func flagNames(flags []*Flag) []string {
+	names := make([]string, 0, len(flags))
+	for _, flag := range flags {
+		names = append(names, flag.Name)
+	}
+	return names
+}
+
+func TestParseStruct_SliceOfStructs(t *testing.T) {
+	cfg := &struct {
+		Backends []backendCfg
+	}{
+		Backends: []backendCfg{{Host: "localhost"}},
+	}
+	flags, err := ParseStruct(cfg, CommandLine([]string{
+		"--backends-2-host=remote", "-backends-1-port", "8080", "--unknown-3-host", "--", "--backends-5-host",
+	}))
+	require.NoError(t, err)
+	require.Len(t, cfg.Backends, 3)
+	assert.Equal(t, []string{
+		"backends-0-host", "backends-0-port", "backends-0-timeout", "backends-0-read-limit",
+		"backends-1-host", "backends-1-port", "backends-1-timeout", "backends-1-read-limit",
+		"backends-2-host", "backends-2-port", "backends-2-timeout", "backends-2-read-limit",
+	}, flagNames(flags))
+
+	assert.Equal(t, "Backends[2].Host", flags[8].Path)
+	assert.Equal(t, "BACKENDS_2_HOST", flags[8].EnvName)
+	assert.Equal(t, "", flags[8].Short)
+	assert.Equal(t, "backend host", flags[8].Usage)
+	assert.Equal(t, "localhost", flags[0].DefValue)
+	assert.Equal(t, 80, cfg.Backends[2].Port)
+
+	require.NoError(t, flags[8].Value.Set("remote"))
+	require.NoError(t, flags[5].Value.Set("8080"))
+	assert.Equal(t, []backendCfg{
+		{Host: "localhost", Port: 80},
+		{Port: 8080},
+		{Host: "remote", Port: 80},
+	}, cfg.Backends)
+}
+
+func TestParseStruct_SliceOfStructsOutOfRange(t *testing.T) {
+	cfg := &struct {
+		Backends []backendCfg
+	}{
+		Backends: []backendCfg{{Host: "localhost"}},
+	}
+	_, err := ParseStruct(cfg, CommandLine([]string{"--backends-100000000-host=remote"}))
+	assert.EqualError(t, err, "index 100000000 of field Backends is out of range, max is 1000")
+	assert.Len(t, cfg.Backends, 1)
+
+	_, err = ParseStruct(cfg, CommandLine([]string{"--backends-1000-host=remote"}))
+	require.NoError(t, err)
+	assert.Len(t, cfg.Backends, 1001)
+}
+
+func TestParseStruct_SliceOfStructsFromEnv(t *testing.T) {
+	cfg := &struct {
+		Backends []*backendCfg
+	}{}
+	defer os.Unsetenv("SFLAGS_TEST_BACKENDS_1_READ_LIMIT")
+	defer os.Unsetenv("SFLAGS_TEST_BACKENDS_X_HOST")
+	os.Setenv("SFLAGS_TEST_BACKENDS_1_READ_LIMIT", "10")
+	os.Setenv("SFLAGS_TEST_BACKENDS_X_HOST", "ignored")
+
+	flags, err := ParseStruct(cfg, EnvPrefix("SFLAGS_TEST_"), FromEnv(true), CommandLine([]string{}))
+	require.NoError(t, err)
+	require.Len(t, flags, 8)
+	require.Len(t, cfg.Backends, 2)
+	assert.Equal(t, &backendCfg{Port: 80}, cfg.Backends[0])
+	assert.Equal(t, &backendCfg{Port: 80, ReadLimit: 10}, cfg.Backends[1])
+	assert.Equal(t, SourceEnv, flags[7].Source())
+}
+
+func TestParseStruct_MapOfStructs(t *testing.T) {
+	defer os.Unsetenv("UPSTREAMS_US_EAST_READ_TIMEOUT")
+	os.Setenv("UPSTREAMS_US_EAST_READ_TIMEOUT", "3s")
+
+	cfg := &struct {
+		Upstreams map[string]upstreamCfg
+		Indexed   map[int]*upstreamCfg
+	}{
+		Upstreams: map[string]upstreamCfg{"local": {Timeout: time.Second}},
+	}
+	flags, err := ParseStruct(cfg, FromEnv(true), CommandLine([]string{
+		"--upstreams-eu-west-timeout=5s", "--indexed-10-timeout", "1s", "--indexed-x-timeout",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"upstreams-eu-west-timeout", "upstreams-eu-west-read-timeout",
+		"upstreams-local-timeout", "upstreams-local-read-timeout",
+		"upstreams-us-east-timeout", "upstreams-us-east-read-timeout",
+		"indexed-10-timeout", "indexed-10-read-timeout",
+	}, flagNames(flags))
+	assert.Equal(t, "Upstreams[eu-west].Timeout", flags[0].Path)
+	assert.Equal(t, "UPSTREAMS_EU_WEST_TIMEOUT", flags[0].EnvName)
+
+	require.NoError(t, flags[0].Value.Set("5s"))
+	require.NoError(t, flags[6].Value.Set("1s"))
+	assert.Equal(t, map[string]upstreamCfg{
+		"eu-west": {Timeout: 5 * time.Second},
+		"local":   {Timeout: time.Second},
+		"us-east": {ReadTimeout: 3 * time.Second},
+	}, cfg.Upstreams)
+	assert.Equal(t, map[int]*upstreamCfg{10: {Timeout: time.Second}}, cfg.Indexed)
+}
+
+func TestParseStruct_EmptyStructElems(t *testing.T) {
+	cfg := &struct {
+		Backends  []backendCfg
+		Upstreams map[string]upstreamCfg
+	}{}
+	flags, err := ParseStruct(cfg, Strict(true), CommandLine([]string{}))
+	require.NoError(t, err)
+	assert.Empty(t, flags)
+	assert.Equal(t, map[string]upstreamCfg{}, cfg.Upstreams)
+}
+
+func TestValidateStruct_StructElems(t *testing.T) {
+	cfg := &struct {
+		Ranges []rangeCfg
+		Limits map[string]*rangeCfg
+	}{
+		Ranges: []rangeCfg{{Min: 1, Max: 2}},
+		Limits: map[string]*rangeCfg{"a": {Min: 1, Max: 2}, "b": nil},
+	}
+	require.NoError(t, ValidateStruct(cfg))
+
+	cfg.Limits["c"] = &rangeCfg{Min: 3, Max: 2}
+	assert.EqualError(t, ValidateStruct(cfg), "flag group Limits[c]: min should be less than max")
+
+	cfg.Ranges = append(cfg.Ranges, rangeCfg{Min: 3, Max: 2})
+	assert.EqualError(t, ValidateStruct(cfg), "flag group Ranges[1]: min should be less than max")
+}
+
+func TestSetFromMap_SliceOfStructs(t *testing.T) {
+	cfg := &struct {
+		Backends []backendCfg
+	}{
+		Backends: make([]backendCfg, 2),
+	}
+	flags, err := ParseStruct(cfg, CommandLine([]string{}))
+	require.NoError(t, err)
+
+	err = SetFromMap(flags, map[string]interface{}{
+		"backends": []interface{}{
+			map[string]interface{}{"host": "one"},
+			map[string]interface{}{"port": 81},
+			map[string]interface{}{"host": "ignored"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []backendCfg{{Host: "one", Port: 80}, {Port: 81}}, cfg.Backends)
+}
+
+func TestParseStruct_LoadedElems(t *testing.T) {
+	cfg := &struct {
+		Backends  []backendCfg
+		Upstreams map[string]upstreamCfg
+	}{}
+	calls := 0
+	loader := func(flags []*Flag, optFuncs ...OptFunc) error {
+		calls++
+		return SetFromMap(flags, map[string]interface{}{
+			"backends": []interface{}{
+				map[string]interface{}{"host": "first"},
+				map[string]interface{}{"readLimit": 10},
+			},
+			"upstreams": map[string]interface{}{
+				"euWest": map[string]interface{}{"timeout": "1s"},
+			},
+		}, optFuncs...)
+	}
+	_, err := ParseStruct(cfg, Loader(loader), CommandLine([]string{}))
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []backendCfg{{Host: "first", Port: 80}, {Port: 80, ReadLimit: 10}}, cfg.Backends)
+	assert.Equal(t, map[string]upstreamCfg{"eu-west": {Timeout: time.Second}}, cfg.Upstreams)
+}
@@ -0,0 +1,32 @@
+package sflags
+
+import (
+	"os"
+)
+
+// SetFromEnv sets values of flags from environment variables.
+// Flags without EnvName and flags with unset environment variables are skipped,
+// as well as flags, that are already set from environment or command line,
+// so generators can call it again for flags parsed with FromEnv.
+// It should be called before command line arguments are parsed,
+// so values from command line take precedence.
+// Invalid values are reported as FlagError, all of them are reported in CollectErrors mode.
+func SetFromEnv(flags []*Flag, optFuncs ...OptFunc) error {
+	opt := defOpts().apply(optFuncs...)
+	errs := &collector{collect: opt.collectErrs}
+	for _, flag := range flags {
+		if flag.EnvName == "" || flag.Source() >= SourceEnv {
+			continue
+		}
+		val, ok := os.LookupEnv(flag.EnvName)
+		if !ok {
+			continue
+		}
+		if err := flag.SetFrom(SourceEnv, val); err != nil {
+			if errs.add(&FlagError{Flag: flag, Value: val, Source: SourceEnv, Err: err}) {
+				break
+			}
+		}
+	}
+	return errs.err()
+}
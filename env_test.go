@@ -0,0 +1,84 @@
+package sflags
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetFromEnv(t *testing.T) {
+	cfg := &struct {
+		Name    string
+		Port    int `env:"~SFLAGS_TEST_PORT"`
+		Hosts   []string
+		NoEnv   string `env:"-"`
+		Missing string
+	}{
+		Name:    "default",
+		Hosts:   []string{"localhost"},
+		NoEnv:   "no_env",
+		Missing: "missing",
+	}
+	defer os.Unsetenv("SFLAGS_TEST_NAME")
+	defer os.Unsetenv("SFLAGS_TEST_PORT")
+	defer os.Unsetenv("SFLAGS_TEST_HOSTS")
+	defer os.Unsetenv("SFLAGS_TEST_NO_ENV")
+	os.Setenv("SFLAGS_TEST_NAME", "from_env")
+	os.Setenv("SFLAGS_TEST_PORT", "8080")
+	os.Setenv("SFLAGS_TEST_HOSTS", "one,two")
+	os.Setenv("SFLAGS_TEST_NO_ENV", "from_env")
+
+	flags, err := ParseStruct(cfg, EnvPrefix("SFLAGS_TEST_"))
+	require.NoError(t, err)
+	assert.Equal(t, "default", cfg.Name)
+
+	err = SetFromEnv(flags)
+	require.NoError(t, err)
+	assert.Equal(t, "from_env", cfg.Name)
+	assert.Equal(t, 8080, cfg.Port)
+	assert.Equal(t, []string{"one", "two"}, cfg.Hosts)
+	assert.Equal(t, "no_env", cfg.NoEnv)
+	assert.Equal(t, "missing", cfg.Missing)
+
+	// flags set from environment are skipped
+	os.Setenv("SFLAGS_TEST_NAME", "changed")
+	err = SetFromEnv(flags)
+	require.NoError(t, err)
+	assert.Equal(t, "from_env", cfg.Name)
+}
+
+func TestSetFromEnv_Error(t *testing.T) {
+	cfg := &struct {
+		Port int
+	}{}
+	defer os.Unsetenv("SFLAGS_TEST_PORT")
+	os.Setenv("SFLAGS_TEST_PORT", "bad")
+
+	flags, err := ParseStruct(cfg, EnvPrefix("SFLAGS_TEST_"))
+	require.NoError(t, err)
+	err = SetFromEnv(flags)
+	assert.EqualError(t, err,
+		`invalid value "bad" for env SFLAGS_TEST_PORT: strconv.ParseInt: parsing "bad": invalid syntax`)
+}
+
+func TestParseStruct_FromEnv(t *testing.T) {
+	cfg := &struct {
+		Name string
+	}{
+		Name: "default",
+	}
+	defer os.Unsetenv("SFLAGS_TEST_NAME")
+	os.Setenv("SFLAGS_TEST_NAME", "from_env")
+
+	flags, err := ParseStruct(cfg, EnvPrefix("SFLAGS_TEST_"), FromEnv(true))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(flags))
+	assert.Equal(t, "from_env", cfg.Name)
+	assert.Equal(t, "default", flags[0].DefValue)
+
+	os.Setenv("SFLAGS_TEST_NAME", "bad\xff")
+	_, err = ParseStruct(&struct{ Name int }{}, EnvPrefix("SFLAGS_TEST_"), FromEnv(true))
+	assert.Error(t, err)
+}
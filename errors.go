@@ -0,0 +1,81 @@
+package sflags
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrNilObject is returned, when config is nil or a nil pointer.
+	ErrNilObject = errors.New("object cannot be nil")
+	// ErrNotPointerToStruct is returned, when config isn't a pointer to structure.
+	ErrNotPointerToStruct = errors.New("object must be a pointer to struct or interface")
+)
+
+// FlagError describes a value, that can't be set to a flag
+// from environment variable or other source.
+type FlagError struct {
+	Flag   *Flag
+	Value  string // raw input
+	Source Source
+	Err    error
+}
+
+func (e *FlagError) Error() string {
+	if e.Source == SourceEnv {
+		return fmt.Sprintf("invalid value %q for env %s: %v", e.Value, e.Flag.EnvName, e.Err)
+	}
+	return fmt.Sprintf("invalid value %q for flag %s: %v", e.Value, e.Flag.Name, e.Err)
+}
+
+// Unwrap returns the error, that was returned by the flag value.
+func (e *FlagError) Unwrap() error { return e.Err }
+
+// Errors is a list of errors, that are collected in CollectErrors mode.
+type Errors []error
+
+func (e Errors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns all collected errors, so they can be checked by errors.As.
+func (e Errors) Unwrap() []error { return e }
+
+// collector collects errors in CollectErrors mode,
+// otherwise it keeps only the first one.
+type collector struct {
+	collect bool
+	errs    Errors
+}
+
+// add adds err to the list, Errors (even wrapped ones) are flattened.
+// It returns true if processing should be stopped.
+func (c *collector) add(err error) bool {
+	if err == nil {
+		return false
+	}
+	var errs Errors
+	if errors.As(err, &errs) {
+		c.errs = append(c.errs, errs...)
+	} else {
+		c.errs = append(c.errs, err)
+	}
+	return !c.collect
+}
+
+// err returns nil, a single error or Errors.
+func (c *collector) err() error {
+	switch len(c.errs) {
+	case 0:
+		return nil
+	case 1:
+		return c.errs[0]
+	default:
+		return c.errs
+	}
+}
@@ -0,0 +1,94 @@
+package sflags
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStruct_NotPointerToStruct(t *testing.T) {
+	_, err := ParseStruct(struct{}{})
+	assert.True(t, errors.Is(err, ErrNotPointerToStruct))
+
+	_, err = ParseStruct((*struct{})(nil))
+	assert.True(t, errors.Is(err, ErrNilObject))
+}
+
+func TestParseStruct_CollectErrors(t *testing.T) {
+	cfg := &struct {
+		Port    int
+		Timeout int
+		Debug   bool
+		Name    string
+	}{}
+	for name, val := range map[string]string{
+		"SFLAGS_TEST_PORT":    "bad",
+		"SFLAGS_TEST_TIMEOUT": "1s",
+		"SFLAGS_TEST_DEBUG":   "maybe",
+		"SFLAGS_TEST_NAME":    "name",
+	} {
+		os.Setenv(name, val)
+		defer os.Unsetenv(name)
+	}
+
+	_, err := ParseStruct(cfg, EnvPrefix("SFLAGS_TEST_"), FromEnv(true))
+	var flagErr *FlagError
+	require.True(t, errors.As(err, &flagErr))
+	assert.Equal(t, "port", flagErr.Flag.Name)
+	_, casted := err.(Errors)
+	assert.False(t, casted)
+
+	_, err = ParseStruct(cfg, EnvPrefix("SFLAGS_TEST_"), FromEnv(true), CollectErrors(true))
+	require.Error(t, err)
+	var errs Errors
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs, 3)
+	expected := []struct {
+		flag, env, value string
+	}{
+		{"port", "SFLAGS_TEST_PORT", "bad"},
+		{"timeout", "SFLAGS_TEST_TIMEOUT", "1s"},
+		{"debug", "SFLAGS_TEST_DEBUG", "maybe"},
+	}
+	for i, exp := range expected {
+		require.True(t, errors.As(errs[i], &flagErr))
+		assert.Equal(t, exp.flag, flagErr.Flag.Name)
+		assert.Equal(t, exp.env, flagErr.Flag.EnvName)
+		assert.Equal(t, exp.value, flagErr.Value)
+		assert.Equal(t, SourceEnv, flagErr.Source)
+		assert.Error(t, flagErr.Err)
+	}
+	assert.EqualError(t, err, `invalid value "bad" for env SFLAGS_TEST_PORT: strconv.ParseInt: parsing "bad": invalid syntax; `+
+		`invalid value "1s" for env SFLAGS_TEST_TIMEOUT: strconv.ParseInt: parsing "1s": invalid syntax; `+
+		`invalid value "maybe" for env SFLAGS_TEST_DEBUG: strconv.ParseBool: parsing "maybe": invalid syntax`)
+	assert.Equal(t, "name", cfg.Name)
+}
+
+func TestParseStruct_CollectErrorsFromLoaders(t *testing.T) {
+	cfg := &struct {
+		Port int
+		Name string
+	}{}
+	loader := func(flags []*Flag, optFuncs ...OptFunc) error {
+		return SetFromMap(flags, map[string]interface{}{
+			"port": "bad",
+			"name": struct{}{},
+		}, optFuncs...)
+	}
+
+	_, err := ParseStruct(cfg, Loader(loader), CollectErrors(true))
+	var errs Errors
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs, 2)
+	var flagErr *FlagError
+	require.True(t, errors.As(errs[0], &flagErr))
+	assert.Equal(t, "name", flagErr.Flag.Name)
+	assert.Equal(t, "{}", flagErr.Value)
+	assert.Equal(t, SourceFile, flagErr.Source)
+	require.True(t, errors.As(errs[1], &flagErr))
+	assert.Equal(t, "port", flagErr.Flag.Name)
+	assert.Equal(t, "bad", flagErr.Value)
+}
@@ -4,6 +4,7 @@ package sflags
 // Flag structure might be used by cli/flag libraries for their flag generation.
 type Flag struct {
 	Name       string // name as it appears on command line
+	Path       string // path of the structure field, e.g. "HTTP.Host"
 	Short      string // optional short name
 	EnvName    string
 	Usage      string // help message
@@ -11,4 +12,78 @@ type Flag struct {
 	DefValue   string // default value (as text); for usage message
 	Hidden     bool
 	Deprecated bool
+	Required   bool     // flag must be set by command line, environment or default value
+	Negatable  bool     // boolean flag has --no-<name> counterpart, that sets it to false
+	Choices    []string // allowed values, if not empty
+	Complete   string   // completion of values: "file" or "dir", if not empty
+	Group      *Group   // group of the nested structure, nil for top level fields
+}
+
+// Unwrap returns the value of the field without wrappers.
+// ParseStruct wraps every value to track its source (and to validate it, if Validator option is used),
+// so use Unwrap instead of type assertions of Value, e.g. flag.Unwrap().(*sflags.Counter).
+func (f *Flag) Unwrap() Value {
+	return unwrapValue(f.Value)
+}
+
+// SetFrom sets value of the flag and remembers its source.
+func (f *Flag) SetFrom(source Source, val string) error {
+	if v, casted := sourceValueOf(f.Value); casted {
+		return v.setFrom(source, val)
+	}
+	return f.Value.Set(val)
+}
+
+// Negation returns a hidden --no-<name> flag, that sets false to the negatable flag,
+// or nil if the flag isn't negatable. It's registered by generators next to the flag.
+// flag library can't hide it, so it's skipped by gflag.Usage instead.
+func (f *Flag) Negation() *Flag {
+	if !f.Negatable {
+		return nil
+	}
+	return &Flag{
+		Name:       negationPrefix + f.Name,
+		Path:       f.Path,
+		Usage:      "negates --" + f.Name,
+		Value:      &negatedValue{flag: f},
+		DefValue:   "false",
+		Hidden:     true,
+		Deprecated: f.Deprecated,
+		Group:      f.Group,
+	}
+}
+
+// setAllFrom sets values, that came from the same source at once, e.g. elements of a list from config file.
+func (f *Flag) setAllFrom(source Source, vals []string) error {
+	if v, casted := sourceValueOf(f.Value); casted {
+		return v.setAllFrom(source, vals)
+	}
+	for _, val := range vals {
+		if err := f.Value.Set(val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Source returns where the value of the flag came from.
+func (f *Flag) Source() Source {
+	if v, casted := sourceValueOf(f.Value); casted {
+		return v.source
+	}
+	return SourceDefault
+}
+
+// Changed returns true if the value of the flag was set
+// by config file, environment variable or command line.
+func (f *Flag) Changed() bool {
+	return f.Source() != SourceDefault
+}
+
+// Group describes a nested structure, that flags came from.
+// Flags of the same structure share the same Group.
+type Group struct {
+	Path        string // path of the structure field, e.g. "HTTP"
+	Title       string // title from group tag, Path by default
+	Description string // description from desc tag of the structure field
 }
@@ -1,30 +1,69 @@
 package gcli
 
 import (
+	"flag"
+
 	"github.com/octago/sflags"
 	"github.com/urfave/cli"
 )
 
 // GenerateTo takes a list of sflag.Flag,
 // that are parsed from some config structure, and put it to dst.
+// Environment variables aren't passed to urfave/cli, values from them are set
+// by sflags.SetFromEnv when flags are applied, so their source is sflags.SourceEnv.
 func GenerateTo(src []*sflags.Flag, dst *[]cli.Flag) {
 	for _, srcFlag := range src {
 		name := srcFlag.Name
 		if srcFlag.Short != "" {
 			name += ", " + srcFlag.Short
 		}
-		*dst = append(*dst, &cli.GenericFlag{
+		genericFlag := cli.GenericFlag{
 			Name:   name,
-			EnvVar: srcFlag.EnvName,
 			Hidden: srcFlag.Hidden,
 			Usage:  srcFlag.Usage,
 			Value:  srcFlag.Value,
-		})
+		}
+		if srcFlag.EnvName != "" {
+			// EnvVar isn't used, but help shows it the same way
+			genericFlag.Usage += " [$" + srcFlag.EnvName + "]"
+			*dst = append(*dst, &envFlag{GenericFlag: genericFlag, flag: srcFlag})
+		} else {
+			*dst = append(*dst, &genericFlag)
+		}
+		if neg := srcFlag.Negation(); neg != nil {
+			*dst = append(*dst, &cli.GenericFlag{
+				Name:   neg.Name,
+				Hidden: neg.Hidden,
+				Usage:  neg.Usage,
+				Value:  neg.Value,
+			})
+		}
+	}
+}
+
+// envFlag is cli.GenericFlag, that sets its value from environment variable,
+// before it's applied to a flag set.
+type envFlag struct {
+	cli.GenericFlag
+	flag *sflags.Flag
+}
+
+// ApplyWithError sets the value from environment variable and applies the flag to set.
+func (f *envFlag) ApplyWithError(set *flag.FlagSet) error {
+	if err := sflags.SetFromEnv([]*sflags.Flag{f.flag}); err != nil {
+		return err
 	}
+	return f.GenericFlag.ApplyWithError(set)
+}
+
+// Apply is ApplyWithError, that ignores errors.
+func (f *envFlag) Apply(set *flag.FlagSet) {
+	_ = f.ApplyWithError(set)
 }
 
 // ParseTo parses cfg, that is a pointer to some structure,
 // and puts it to dst.
+// Required flags aren't checked and cfg isn't validated, use ParseToApp for that.
 func ParseTo(cfg interface{}, dst *[]cli.Flag, optFuncs ...sflags.OptFunc) error {
 	flags, err := sflags.ParseStruct(cfg, optFuncs...)
 	if err != nil {
@@ -34,6 +73,127 @@ func ParseTo(cfg interface{}, dst *[]cli.Flag, optFuncs ...sflags.OptFunc) error
 	return nil
 }
 
+// ParseToApp parses cfg, that is a pointer to some structure,
+// and puts its flags to app.
+// Required flags are checked by sflags.ValidateRequired and cfg is validated
+// by sflags.ValidateStruct in app.Before.
+func ParseToApp(cfg interface{}, app *cli.App, optFuncs ...sflags.OptFunc) error {
+	flags, err := sflags.ParseStruct(cfg, optFuncs...)
+	if err != nil {
+		return err
+	}
+	GenerateTo(flags, &app.Flags)
+	addBefore(app, func() error {
+		if err := sflags.ValidateRequired(flags); err != nil {
+			return err
+		}
+		return sflags.ValidateStruct(cfg, optFuncs...)
+	})
+	return nil
+}
+
+// GenerateCommandTo takes a sflags.Command,
+// that is parsed from some config structure, and puts its flags and subcommands to dst.
+// Generated commands without subcommands set positional arguments when run,
+// use src.Selected() after running dst to find out which one was selected.
+// Positional arguments of the root command are set before dst.Action,
+// when no subcommand is selected.
+// Required flags are checked by sflags.Command.ValidateRequired and structures
+// are validated by sflags.Command.Validate in dst.Before for the root command
+// (before dst.Action, if it has positional arguments),
+// in Before of commands with subcommands and before actions of commands without them.
+func GenerateCommandTo(src *sflags.Command, dst *cli.App) {
+	GenerateTo(src.Flags, &dst.Flags)
+	dst.Commands = append(dst.Commands, generateCommands(src.Commands)...)
+	if len(src.Args) == 0 {
+		addBefore(dst, func() error {
+			return validate(src)
+		})
+		return
+	}
+	// structure is validated after positional arguments are set,
+	// selected subcommands validate it themselves
+	addBefore(dst, src.ValidateRequired)
+	action := dst.Action
+	dst.Action = func(c *cli.Context) error {
+		if err := sflags.SetArgs(src.Args, c.Args()); err != nil {
+			return err
+		}
+		if err := src.Validate(); err != nil {
+			return err
+		}
+		if action == nil {
+			return nil
+		}
+		return cli.HandleAction(action, c)
+	}
+}
+
+func generateCommands(src []*sflags.Command) []cli.Command {
+	cmds := make([]cli.Command, 0, len(src))
+	for _, srcCmd := range src {
+		srcCmd := srcCmd
+		cmd := cli.Command{
+			Name:  srcCmd.Name,
+			Usage: srcCmd.Usage,
+		}
+		GenerateTo(srcCmd.Flags, &cmd.Flags)
+		cmd.Subcommands = generateCommands(srcCmd.Commands)
+		if len(srcCmd.Commands) > 0 {
+			cmd.Before = func(*cli.Context) error {
+				return validate(srcCmd)
+			}
+		} else {
+			cmd.Action = func(c *cli.Context) error {
+				srcCmd.Select()
+				if err := srcCmd.ValidateRequired(); err != nil {
+					return err
+				}
+				if err := sflags.SetArgs(srcCmd.Args, c.Args()); err != nil {
+					return err
+				}
+				return srcCmd.Validate()
+			}
+		}
+		cmds = append(cmds, cmd)
+	}
+	return cmds
+}
+
+// validate checks required flags and validates structures of cmd and its parents.
+func validate(cmd *sflags.Command) error {
+	if err := cmd.ValidateRequired(); err != nil {
+		return err
+	}
+	return cmd.Validate()
+}
+
+// addBefore makes app call check before subcommands and actions are run,
+// Before of app, that was set before, is called after check.
+func addBefore(app *cli.App, check func() error) {
+	before := app.Before
+	app.Before = func(c *cli.Context) error {
+		if err := check(); err != nil {
+			return err
+		}
+		if before != nil {
+			return before(c)
+		}
+		return nil
+	}
+}
+
+// ParseCommandTo parses cfg, that is a pointer to some structure,
+// puts flags and subcommands to dst and returns the root command.
+func ParseCommandTo(cfg interface{}, dst *cli.App, optFuncs ...sflags.OptFunc) (*sflags.Command, error) {
+	cmd, err := sflags.ParseCommand(cfg, optFuncs...)
+	if err != nil {
+		return nil, err
+	}
+	GenerateCommandTo(cmd, dst)
+	return cmd, nil
+}
+
 // Parse parses cfg, that is a pointer to some structure,
 // puts it to the new flag.FlagSet and returns it.
 func Parse(cfg interface{}, optFuncs ...sflags.OptFunc) ([]cli.Flag, error) {
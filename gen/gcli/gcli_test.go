@@ -3,6 +3,7 @@ package gcli
 import (
 	"errors"
 	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/octago/sflags"
@@ -152,3 +153,228 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateTo_Changed(t *testing.T) {
+	cfg := &cfg1{StringValue1: "value1", StringValue2: "value2"}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+	cliFlags := make([]cli.Flag, 0)
+	GenerateTo(flags, &cliFlags)
+
+	cliApp := cli.NewApp()
+	cliApp.Action = func(c *cli.Context) error {
+		return nil
+	}
+	cliApp.Flags = cliFlags
+	err = cliApp.Run([]string{"cliApp", "-s", "value2"})
+	require.NoError(t, err)
+	assert.False(t, flags[0].Changed())
+	assert.True(t, flags[1].Changed())
+	assert.Equal(t, sflags.SourceFlag, flags[1].Source())
+}
+
+func TestParse_Negatable(t *testing.T) {
+	cfg := &struct {
+		TLS   bool `flag:"tls,negatable"`
+		Color bool `flag:",negatable"`
+	}{TLS: true}
+	cliFlags, err := Parse(cfg)
+	require.NoError(t, err)
+
+	cliApp := cli.NewApp()
+	cliApp.Action = func(c *cli.Context) error {
+		return nil
+	}
+	cliApp.Flags = cliFlags
+	err = cliApp.Run([]string{"cliApp", "--no-tls", "--color", "--no-color=false"})
+	require.NoError(t, err)
+	assert.False(t, cfg.TLS)
+	assert.True(t, cfg.Color)
+}
+
+func TestParseTo_FromEnv(t *testing.T) {
+	defer os.Unsetenv("GCLI_STRING_VALUE1")
+	os.Setenv("GCLI_STRING_VALUE1", "env_value")
+
+	// GenerateTo sets values from environment itself, when flags are applied
+	cfg := &cfg1{}
+	flags, err := sflags.ParseStruct(cfg, sflags.EnvPrefix("GCLI_"))
+	require.NoError(t, err)
+	cliFlags := make([]cli.Flag, 0)
+	GenerateTo(flags, &cliFlags)
+	cliApp := cli.NewApp()
+	cliApp.Action = func(c *cli.Context) error {
+		return nil
+	}
+	cliApp.Flags = cliFlags
+	err = cliApp.Run([]string{"cliApp"})
+	require.NoError(t, err)
+	assert.Equal(t, "env_value", cfg.StringValue1)
+	assert.Equal(t, sflags.SourceEnv, flags[0].Source())
+
+	cfg = &cfg1{}
+	flags, err = sflags.ParseStruct(cfg, sflags.EnvPrefix("GCLI_"), sflags.FromEnv(true))
+	require.NoError(t, err)
+	cliFlags = make([]cli.Flag, 0)
+	GenerateTo(flags, &cliFlags)
+	assert.Equal(t, "--string-value1 value\t[$GCLI_STRING_VALUE1] (default: env_value)", cliFlags[0].String())
+
+	cliApp = cli.NewApp()
+	cliApp.Action = func(c *cli.Context) error {
+		return nil
+	}
+	cliApp.Flags = cliFlags
+	err = cliApp.Run([]string{"cliApp", "-s", "cli_value"})
+	require.NoError(t, err)
+	assert.Equal(t, "env_value", cfg.StringValue1)
+	assert.Equal(t, sflags.SourceEnv, flags[0].Source())
+	assert.Equal(t, sflags.SourceFlag, flags[1].Source())
+}
+
+func TestParseCommandTo(t *testing.T) {
+	cfg := &struct {
+		Debug  bool
+		Remote struct {
+			Add struct {
+				Fetch bool
+				Name  string `arg:"0,required"`
+			} `cmd:"add"`
+			Remove struct{} `cmd:"remove"`
+		} `cmd:"remote"`
+	}{}
+	cliApp := cli.NewApp()
+	cmd, err := ParseCommandTo(cfg, cliApp)
+	require.NoError(t, err)
+
+	err = cliApp.Run([]string{"cliApp", "--debug", "remote", "add", "--fetch", "origin"})
+	require.NoError(t, err)
+	assert.True(t, cfg.Debug)
+	assert.True(t, cfg.Remote.Add.Fetch)
+	assert.Equal(t, "origin", cfg.Remote.Add.Name)
+	assert.Equal(t, []string{"remote", "add"}, cmd.Selected().Path())
+
+	_, err = ParseCommandTo("bad config", cliApp)
+	assert.Error(t, err)
+}
+
+func TestParseCommandTo_RootArgs(t *testing.T) {
+	cfg := &struct {
+		Src  string `arg:"0,required"`
+		List struct {
+			All bool
+		} `cmd:"list"`
+	}{}
+	cliApp := cli.NewApp()
+	cliApp.Writer = ioutil.Discard
+	called := false
+	cliApp.Action = func(c *cli.Context) error {
+		called = true
+		return nil
+	}
+	cmd, err := ParseCommandTo(cfg, cliApp)
+	require.NoError(t, err)
+
+	err = cliApp.Run([]string{"cliApp", "file.txt"})
+	require.NoError(t, err)
+	assert.Equal(t, "file.txt", cfg.Src)
+	assert.True(t, called)
+	assert.Equal(t, cmd, cmd.Selected())
+
+	err = cliApp.Run([]string{"cliApp"})
+	assert.EqualError(t, err, `required argument(s) "src" not provided`)
+
+	called = false
+	err = cliApp.Run([]string{"cliApp", "list", "--all"})
+	require.NoError(t, err)
+	assert.True(t, cfg.List.All)
+	assert.False(t, called)
+}
+
+func TestParseCommandTo_Required(t *testing.T) {
+	cfg := &struct {
+		Token  string `flag:",required"`
+		Remote struct {
+			Name string `flag:",required" default:"origin"`
+			Push struct {
+				Branch string `flag:",required"`
+			} `cmd:"push"`
+		} `cmd:"remote"`
+	}{}
+	cliApp := cli.NewApp()
+	cliApp.Writer = ioutil.Discard
+	before := false
+	cliApp.Before = func(*cli.Context) error {
+		before = true
+		return nil
+	}
+	_, err := ParseCommandTo(cfg, cliApp)
+	require.NoError(t, err)
+
+	err = cliApp.Run([]string{"cliApp", "remote", "push"})
+	assert.EqualError(t, err, `required flag(s) "token" not set`)
+	assert.False(t, before)
+
+	err = cliApp.Run([]string{"cliApp", "--token", "secret", "remote", "push"})
+	assert.EqualError(t, err, `required flag(s) "branch" not set`)
+	assert.True(t, before)
+
+	err = cliApp.Run([]string{"cliApp", "--token", "secret", "remote", "push", "--branch", "main"})
+	assert.NoError(t, err)
+}
+
+// nameCfg checks, that generators call Validate, validation itself is tested by sflags.
+type nameCfg struct {
+	Name string
+}
+
+func (c *nameCfg) Validate() error {
+	if c.Name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func TestParseToApp(t *testing.T) {
+	cfg := &struct {
+		Token string `flag:",required"`
+		User  nameCfg
+	}{}
+	cliApp := cli.NewApp()
+	cliApp.Writer = ioutil.Discard
+	err := ParseToApp(cfg, cliApp)
+	require.NoError(t, err)
+
+	err = cliApp.Run([]string{"cliApp", "--user-name", "user"})
+	assert.EqualError(t, err, `required flag(s) "token" not set`)
+
+	err = cliApp.Run([]string{"cliApp", "--token", "secret", "--user-name", ""})
+	assert.EqualError(t, err, "flag group User: name is empty")
+
+	err = cliApp.Run([]string{"cliApp", "--token", "secret", "--user-name", "user"})
+	require.NoError(t, err)
+
+	err = ParseToApp("bad config", cliApp)
+	assert.Error(t, err)
+}
+
+func TestParseCommandTo_Validate(t *testing.T) {
+	cfg := &struct {
+		Remote struct {
+			nameCfg
+			Push nameCfg `cmd:"push"`
+		} `cmd:"remote"`
+	}{}
+	cliApp := cli.NewApp()
+	cliApp.Writer = ioutil.Discard
+	_, err := ParseCommandTo(cfg, cliApp)
+	require.NoError(t, err)
+
+	err = cliApp.Run([]string{"cliApp", "remote"})
+	assert.EqualError(t, err, "command remote: name is empty")
+
+	err = cliApp.Run([]string{"cliApp", "remote", "--name", "origin", "push"})
+	assert.EqualError(t, err, "command remote push: name is empty")
+
+	err = cliApp.Run([]string{"cliApp", "remote", "--name", "origin", "push", "--name", "main"})
+	require.NoError(t, err)
+}
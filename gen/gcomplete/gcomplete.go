@@ -0,0 +1,293 @@
+// Package gcomplete generates shell completion scripts for flags parsed by sflags.
+// It's useful for libraries without built-in completion, e.g. flag or pflag.
+package gcomplete
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/octago/sflags"
+)
+
+// Shell is a name of supported shell.
+type Shell string
+
+// Supported shells.
+const (
+	Bash Shell = "bash"
+	Zsh  Shell = "zsh"
+	Fish Shell = "fish"
+)
+
+// Target is a generator, that flags are registered by.
+// It defines which names of flags are completed.
+type Target string
+
+// Supported targets.
+const (
+	GFlag    Target = "gflag" // flag has no short names
+	GPFlag   Target = "gpflag"
+	GKingpin Target = "gkingpin"
+	GCli     Target = "gcli"
+)
+
+// completion describes how value of a flag is completed.
+type completion int
+
+const (
+	completeNone  completion = iota // any value, nothing to suggest
+	completeBool                    // flag doesn't take value, but accepts true or false after =
+	completeWords                   // one of words
+	completeFile
+	completeDir
+)
+
+// completeTags maps values of complete tag to path completions.
+var completeTags = map[string]completion{
+	"file": completeFile,
+	"dir":  completeDir,
+}
+
+// pathTypes maps Type() of values to path completions, if complete tag isn't set.
+// Custom Value types might return one of these types to get file or directory completion.
+var pathTypes = map[string]completion{
+	"file": completeFile,
+	"path": completeFile,
+	"dir":  completeDir,
+}
+
+type compFlag struct {
+	long       string
+	short      string
+	usage      string
+	typ        string
+	completion completion
+	words      []string
+	repeatable bool
+}
+
+func newCompFlag(flag *sflags.Flag, shorts bool) *compFlag {
+	f := &compFlag{
+		long:  flag.Name,
+		usage: strings.SplitN(flag.Usage, "\n", 2)[0],
+		typ:   flag.Value.Type(),
+	}
+	if shorts {
+		f.short = flag.Short
+	}
+	if repeatable, casted := flag.Value.(sflags.RepeatableFlag); casted {
+		f.repeatable = repeatable.IsCumulative()
+	}
+	if boolFlag, casted := flag.Value.(sflags.BoolFlag); casted && boolFlag.IsBoolFlag() {
+		f.completion = completeBool
+		f.words = []string{"true", "false"}
+		return f
+	}
+	if len(flag.Choices) > 0 {
+		f.completion = completeWords
+		f.words = flag.Choices
+		return f
+	}
+	if flag.Complete != "" {
+		f.completion = completeTags[flag.Complete]
+		return f
+	}
+	f.completion = pathTypes[f.typ]
+	return f
+}
+
+// GenerateTo takes a list of sflag.Flag,
+// that are parsed from some config structure,
+// and writes completion script of prog for shell to dst.
+// target is the generator, that registers flags of prog, e.g. short names aren't completed for GFlag.
+// Hidden and deprecated flags aren't completed, negations of negatable flags are.
+func GenerateTo(src []*sflags.Flag, dst io.Writer, prog string, shell Shell, target Target) error {
+	var shorts bool
+	switch target {
+	case GFlag:
+	case GPFlag, GKingpin, GCli:
+		shorts = true
+	default:
+		return fmt.Errorf("unsupported target %q", target)
+	}
+	var flags []*compFlag
+	for _, srcFlag := range src {
+		if srcFlag.Hidden || srcFlag.Deprecated {
+			continue
+		}
+		flags = append(flags, newCompFlag(srcFlag, shorts))
+		if neg := srcFlag.Negation(); neg != nil {
+			flags = append(flags, newCompFlag(neg, shorts))
+		}
+	}
+	buf := &bytes.Buffer{}
+	switch shell {
+	case Bash:
+		writeBash(buf, flags, prog)
+	case Zsh:
+		writeZsh(buf, flags, prog)
+	case Fish:
+		writeFish(buf, flags, prog)
+	default:
+		return fmt.Errorf("unsupported shell %q", shell)
+	}
+	_, err := buf.WriteTo(dst)
+	return err
+}
+
+// ParseTo parses cfg, that is a pointer to some structure,
+// and writes completion script of prog for shell to dst.
+func ParseTo(cfg interface{}, dst io.Writer, prog string, shell Shell, target Target, optFuncs ...sflags.OptFunc) error {
+	flags, err := sflags.ParseStruct(cfg, optFuncs...)
+	if err != nil {
+		return err
+	}
+	return GenerateTo(flags, dst, prog, shell, target)
+}
+
+var notIdent = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+func writeBash(buf *bytes.Buffer, flags []*compFlag, prog string) {
+	funcName := "_" + notIdent.ReplaceAllString(prog, "_") + "_completion"
+	var names []string
+	for _, flag := range flags {
+		names = append(names, "--"+flag.long)
+		if flag.short != "" {
+			names = append(names, "-"+flag.short)
+		}
+	}
+
+	fmt.Fprintf(buf, "# bash completion for %s, generated by sflags\n", prog)
+	fmt.Fprintf(buf, "%s() {\n", funcName)
+	buf.WriteString(`    local cur prev eq
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    prev="${COMP_WORDS[COMP_CWORD-1]}"
+    # "=" is a word breaker, so --flag=value is split to three words
+    if [[ "$cur" == "=" ]]; then
+        cur=""
+        eq=1
+    elif [[ "$prev" == "=" && $COMP_CWORD -gt 1 ]]; then
+        prev="${COMP_WORDS[COMP_CWORD-2]}"
+        eq=1
+    fi
+    case "$prev" in
+`)
+	for _, flag := range flags {
+		pattern := "--" + flag.long
+		if flag.short != "" {
+			pattern += "|-" + flag.short
+		}
+		fmt.Fprintf(buf, "        %s)\n", pattern)
+		switch flag.completion {
+		case completeBool:
+			fmt.Fprintf(buf, "            if [[ -n \"$eq\" ]]; then\n")
+			fmt.Fprintf(buf, "                COMPREPLY=( $(compgen -W %s -- \"$cur\") )\n", bashQuote(strings.Join(flag.words, " ")))
+			fmt.Fprintf(buf, "                return\n")
+			fmt.Fprintf(buf, "            fi\n")
+			fmt.Fprintf(buf, "            ;;\n")
+			continue
+		case completeWords:
+			fmt.Fprintf(buf, "            COMPREPLY=( $(compgen -W %s -- \"$cur\") )\n", bashQuote(strings.Join(flag.words, " ")))
+		case completeFile:
+			fmt.Fprintf(buf, "            COMPREPLY=( $(compgen -f -- \"$cur\") )\n")
+		case completeDir:
+			fmt.Fprintf(buf, "            COMPREPLY=( $(compgen -d -- \"$cur\") )\n")
+		}
+		fmt.Fprintf(buf, "            return\n")
+		fmt.Fprintf(buf, "            ;;\n")
+	}
+	buf.WriteString("    esac\n")
+	fmt.Fprintf(buf, "    if [[ \"$cur\" == -* ]]; then\n")
+	fmt.Fprintf(buf, "        COMPREPLY=( $(compgen -W %s -- \"$cur\") )\n", bashQuote(strings.Join(names, " ")))
+	buf.WriteString("    fi\n")
+	buf.WriteString("}\n")
+	fmt.Fprintf(buf, "complete -o default -F %s %s\n", funcName, prog)
+}
+
+func bashQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+var zshEscaper = strings.NewReplacer(`'`, `'\''`, `[`, `\[`, `]`, `\]`, `:`, `\:`)
+
+func writeZsh(buf *bytes.Buffer, flags []*compFlag, prog string) {
+	fmt.Fprintf(buf, "#compdef %s\n", prog)
+	fmt.Fprintf(buf, "# zsh completion for %s, generated by sflags\n", prog)
+	buf.WriteString("_arguments \\\n")
+	for _, flag := range flags {
+		// long flag takes value as --flag=value or --flag value,
+		// short flag takes value as -fvalue or -f value.
+		long, short := "--"+flag.long+"=", "-"+flag.short+"+"
+		action := ":" + zshEscaper.Replace(flag.typ) + ":"
+		switch flag.completion {
+		case completeBool:
+			// value is optional and can be set only as --flag=value
+			long, short = "--"+flag.long+"=-", "-"+flag.short
+		case completeWords:
+			words := make([]string, 0, len(flag.words))
+			for _, word := range flag.words {
+				words = append(words, strings.Replace(zshEscaper.Replace(word), " ", `\ `, -1))
+			}
+			action += "(" + strings.Join(words, " ") + ")"
+		case completeFile:
+			action += "_files"
+		case completeDir:
+			action += "_files -/"
+		default:
+			action += " "
+		}
+		desc := "[" + zshEscaper.Replace(flag.usage) + "]"
+		prefix := ""
+		switch {
+		case flag.repeatable:
+			prefix = "*"
+		case flag.short != "":
+			prefix = "(-" + flag.short + " --" + flag.long + ")"
+		}
+		if flag.completion == completeBool {
+			fmt.Fprintf(buf, "  '%s%s%s:%s(%s)' \\\n", prefix, long, desc, action, strings.Join(flag.words, " "))
+		} else {
+			fmt.Fprintf(buf, "  '%s%s%s%s' \\\n", prefix, long, desc, action)
+		}
+		if flag.short == "" {
+			continue
+		}
+		if flag.completion == completeBool {
+			fmt.Fprintf(buf, "  '%s%s%s' \\\n", prefix, short, desc)
+		} else {
+			fmt.Fprintf(buf, "  '%s%s%s%s' \\\n", prefix, short, desc, action)
+		}
+	}
+	buf.WriteString("  '*:arg:_files'\n")
+}
+
+var fishEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+func writeFish(buf *bytes.Buffer, flags []*compFlag, prog string) {
+	fmt.Fprintf(buf, "# fish completion for %s, generated by sflags\n", prog)
+	for _, flag := range flags {
+		fmt.Fprintf(buf, "complete -c %s -l %s", prog, flag.long)
+		if flag.short != "" {
+			fmt.Fprintf(buf, " -s %s", flag.short)
+		}
+		if flag.usage != "" {
+			fmt.Fprintf(buf, " -d '%s'", fishEscaper.Replace(flag.usage))
+		}
+		switch flag.completion {
+		case completeBool:
+			// fish can't complete optional values, so bool flags are completed without them
+		case completeWords:
+			fmt.Fprintf(buf, " -x -a '%s'", fishEscaper.Replace(strings.Join(flag.words, " ")))
+		case completeFile:
+			buf.WriteString(" -r")
+		case completeDir:
+			buf.WriteString(" -x -a '(__fish_complete_directories)'")
+		default:
+			buf.WriteString(" -x")
+		}
+		buf.WriteString("\n")
+	}
+}
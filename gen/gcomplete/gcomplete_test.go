@@ -0,0 +1,197 @@
+package gcomplete
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type filePath string
+
+func (p *filePath) String() string     { return string(*p) }
+func (p *filePath) Set(v string) error { *p = filePath(v); return nil }
+func (p *filePath) Type() string       { return "file" }
+
+type cfg1 struct {
+	Format  string   `choices:"json,text" desc:"output format"`
+	Config  filePath `flag:"config c" desc:"config file"`
+	Verbose bool     `flag:"verbose v" desc:"verbose [output]"`
+	Tags    []string `desc:"it's tags"`
+	Secret  string   `flag:",hidden"`
+	Old     string   `flag:",deprecated"`
+}
+
+type cfg2 struct {
+	Config  string `flag:"config c" complete:"file"`
+	WorkDir string `flag:"work-dir w" complete:"dir"`
+}
+
+func TestParseTo(t *testing.T) {
+	tests := []struct {
+		name string
+
+		cfg    interface{}
+		shell  Shell
+		target Target
+		expOut string
+		expErr error
+	}{
+		{
+			name:  "Test bash",
+			cfg:   &cfg1{},
+			shell: Bash,
+			expOut: `# bash completion for my-app, generated by sflags
+_my_app_completion() {
+    local cur prev eq
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    prev="${COMP_WORDS[COMP_CWORD-1]}"
+    # "=" is a word breaker, so --flag=value is split to three words
+    if [[ "$cur" == "=" ]]; then
+        cur=""
+        eq=1
+    elif [[ "$prev" == "=" && $COMP_CWORD -gt 1 ]]; then
+        prev="${COMP_WORDS[COMP_CWORD-2]}"
+        eq=1
+    fi
+    case "$prev" in
+        --format)
+            COMPREPLY=( $(compgen -W 'json text' -- "$cur") )
+            return
+            ;;
+        --config|-c)
+            COMPREPLY=( $(compgen -f -- "$cur") )
+            return
+            ;;
+        --verbose|-v)
+            if [[ -n "$eq" ]]; then
+                COMPREPLY=( $(compgen -W 'true false' -- "$cur") )
+                return
+            fi
+            ;;
+        --tags)
+            return
+            ;;
+    esac
+    if [[ "$cur" == -* ]]; then
+        COMPREPLY=( $(compgen -W '--format --config -c --verbose -v --tags' -- "$cur") )
+    fi
+}
+complete -o default -F _my_app_completion my-app
+`,
+		},
+		{
+			name:  "Test zsh",
+			cfg:   &cfg1{},
+			shell: Zsh,
+			expOut: `#compdef my-app
+# zsh completion for my-app, generated by sflags
+_arguments \
+  '--format=[output format]:string:(json text)' \
+  '(-c --config)--config=[config file]:file:_files' \
+  '(-c --config)-c+[config file]:file:_files' \
+  '(-v --verbose)--verbose=-[verbose \[output\]]::bool:(true false)' \
+  '(-v --verbose)-v[verbose \[output\]]' \
+  '*--tags=[it'\''s tags]:stringSlice: ' \
+  '*:arg:_files'
+`,
+		},
+		{
+			name:  "Test fish",
+			cfg:   &cfg1{},
+			shell: Fish,
+			expOut: `# fish completion for my-app, generated by sflags
+complete -c my-app -l format -d 'output format' -x -a 'json text'
+complete -c my-app -l config -s c -d 'config file' -r
+complete -c my-app -l verbose -s v -d 'verbose [output]'
+complete -c my-app -l tags -d 'it\'s tags' -x
+`,
+		},
+		{
+			name:   "Test bash with complete tags for gflag",
+			cfg:    &cfg2{},
+			shell:  Bash,
+			target: GFlag,
+			expOut: `# bash completion for my-app, generated by sflags
+_my_app_completion() {
+    local cur prev eq
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    prev="${COMP_WORDS[COMP_CWORD-1]}"
+    # "=" is a word breaker, so --flag=value is split to three words
+    if [[ "$cur" == "=" ]]; then
+        cur=""
+        eq=1
+    elif [[ "$prev" == "=" && $COMP_CWORD -gt 1 ]]; then
+        prev="${COMP_WORDS[COMP_CWORD-2]}"
+        eq=1
+    fi
+    case "$prev" in
+        --config)
+            COMPREPLY=( $(compgen -f -- "$cur") )
+            return
+            ;;
+        --work-dir)
+            COMPREPLY=( $(compgen -d -- "$cur") )
+            return
+            ;;
+    esac
+    if [[ "$cur" == -* ]]; then
+        COMPREPLY=( $(compgen -W '--config --work-dir' -- "$cur") )
+    fi
+}
+complete -o default -F _my_app_completion my-app
+`,
+		},
+		{
+			name:   "Test zsh with complete tags for gkingpin",
+			cfg:    &cfg2{},
+			shell:  Zsh,
+			target: GKingpin,
+			expOut: `#compdef my-app
+# zsh completion for my-app, generated by sflags
+_arguments \
+  '(-c --config)--config=[]:string:_files' \
+  '(-c --config)-c+[]:string:_files' \
+  '(-w --work-dir)--work-dir=[]:string:_files -/' \
+  '(-w --work-dir)-w+[]:string:_files -/' \
+  '*:arg:_files'
+`,
+		},
+		{
+			name:   "Test unsupported target",
+			cfg:    &cfg1{},
+			shell:  Bash,
+			target: Target("cobra"),
+			expErr: errors.New(`unsupported target "cobra"`),
+		},
+		{
+			name:   "Test unsupported shell",
+			cfg:    &cfg1{},
+			shell:  Shell("powershell"),
+			expErr: errors.New(`unsupported shell "powershell"`),
+		},
+		{
+			name:   "Test bad cfg value",
+			cfg:    "bad config",
+			shell:  Bash,
+			expErr: errors.New("object must be a pointer to struct or interface"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			target := test.target
+			if target == "" {
+				target = GPFlag
+			}
+			err := ParseTo(test.cfg, buf, "my-app", test.shell, target)
+			require.Equal(t, test.expErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, test.expOut, buf.String())
+		})
+	}
+}
@@ -0,0 +1,233 @@
+// Package gdoc generates reference documentation for flags parsed by sflags.
+// Flags are grouped by nested structures they came from (see sflags.Group).
+package gdoc
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/octago/sflags"
+)
+
+// Format is a format of generated documentation.
+type Format string
+
+// Supported formats.
+const (
+	Markdown Format = "markdown"
+	HTML     Format = "html"
+	Man      Format = "man"
+)
+
+// group is a list of flags from the same structure.
+type group struct {
+	title       string // empty for top level fields
+	description string
+	flags       []*sflags.Flag
+}
+
+// groupFlags groups flags by sflags.Group,
+// groups are ordered by first appearance of their flags.
+// Hidden flags are skipped.
+func groupFlags(src []*sflags.Flag) []*group {
+	var groups []*group
+	bySrc := map[*sflags.Group]*group{}
+	for _, flag := range src {
+		if flag.Hidden {
+			continue
+		}
+		g, found := bySrc[flag.Group]
+		if !found {
+			g = &group{}
+			if flag.Group != nil {
+				g.title = flag.Group.Title
+				g.description = flag.Group.Description
+			}
+			bySrc[flag.Group] = g
+			groups = append(groups, g)
+		}
+		g.flags = append(g.flags, flag)
+	}
+	return groups
+}
+
+// names returns flag names as they are used on command line,
+// negatable flags are documented once as --[no-]name.
+func names(flag *sflags.Flag) []string {
+	names := []string{"--" + flag.Name}
+	if flag.Negatable {
+		names[0] = "--[no-]" + flag.Name
+	}
+	if flag.Short != "" {
+		names = append(names, "-"+flag.Short)
+	}
+	return names
+}
+
+func description(flag *sflags.Flag) string {
+	usage := flag.Usage
+	if flag.Deprecated {
+		usage = strings.TrimSpace("(deprecated) " + usage)
+	}
+	return usage
+}
+
+// GenerateTo takes a list of sflag.Flag,
+// that are parsed from some config structure,
+// and writes documentation of prog in format to dst.
+// Hidden flags aren't documented.
+func GenerateTo(src []*sflags.Flag, dst io.Writer, prog string, format Format) error {
+	groups := groupFlags(src)
+	buf := &bytes.Buffer{}
+	switch format {
+	case Markdown:
+		writeMarkdown(buf, groups, prog)
+	case HTML:
+		writeHTML(buf, groups, prog)
+	case Man:
+		writeMan(buf, groups, prog)
+	default:
+		return fmt.Errorf("unsupported format %q", format)
+	}
+	_, err := buf.WriteTo(dst)
+	return err
+}
+
+// ParseTo parses cfg, that is a pointer to some structure,
+// and writes documentation of prog in format to dst.
+func ParseTo(cfg interface{}, dst io.Writer, prog string, format Format, optFuncs ...sflags.OptFunc) error {
+	flags, err := sflags.ParseStruct(cfg, optFuncs...)
+	if err != nil {
+		return err
+	}
+	return GenerateTo(flags, dst, prog, format)
+}
+
+var mdEscaper = strings.NewReplacer(`|`, `\|`, "\n", " ")
+
+func mdCode(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "`" + mdEscaper.Replace(s) + "`"
+}
+
+func writeMarkdown(buf *bytes.Buffer, groups []*group, prog string) {
+	fmt.Fprintf(buf, "# %s\n", prog)
+	for _, g := range groups {
+		if g.title == "" {
+			buf.WriteString("\n## Flags\n\n")
+		} else {
+			fmt.Fprintf(buf, "\n## %s\n\n", g.title)
+		}
+		if g.description != "" {
+			fmt.Fprintf(buf, "%s\n\n", g.description)
+		}
+		buf.WriteString("| Flag | Env | Type | Default | Description |\n")
+		buf.WriteString("|------|-----|------|---------|-------------|\n")
+		for _, flag := range g.flags {
+			flagNames := names(flag)
+			for i, name := range flagNames {
+				flagNames[i] = mdCode(name)
+			}
+			fmt.Fprintf(buf, "| %s | %s | %s | %s | %s |\n",
+				strings.Join(flagNames, ", "),
+				mdCode(flag.EnvName),
+				mdEscaper.Replace(flag.Value.Type()),
+				mdCode(flag.DefValue),
+				mdEscaper.Replace(description(flag)),
+			)
+		}
+	}
+}
+
+func writeHTML(buf *bytes.Buffer, groups []*group, prog string) {
+	fmt.Fprintf(buf, "<h1>%s</h1>\n", html.EscapeString(prog))
+	for _, g := range groups {
+		title := "Flags"
+		if g.title != "" {
+			title = g.title
+		}
+		fmt.Fprintf(buf, "<h2>%s</h2>\n", html.EscapeString(title))
+		if g.description != "" {
+			fmt.Fprintf(buf, "<p>%s</p>\n", html.EscapeString(g.description))
+		}
+		buf.WriteString("<table>\n")
+		buf.WriteString("<tr><th>Flag</th><th>Env</th><th>Type</th><th>Default</th><th>Description</th></tr>\n")
+		for _, flag := range g.flags {
+			flagNames := names(flag)
+			for i, name := range flagNames {
+				flagNames[i] = "<code>" + html.EscapeString(name) + "</code>"
+			}
+			env := ""
+			if flag.EnvName != "" {
+				env = "<code>" + html.EscapeString(flag.EnvName) + "</code>"
+			}
+			defValue := ""
+			if flag.DefValue != "" {
+				defValue = "<code>" + html.EscapeString(flag.DefValue) + "</code>"
+			}
+			fmt.Fprintf(buf, "<tr><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td></tr>\n",
+				strings.Join(flagNames, ", "),
+				env,
+				html.EscapeString(flag.Value.Type()),
+				defValue,
+				html.EscapeString(description(flag)),
+			)
+		}
+		buf.WriteString("</table>\n")
+	}
+}
+
+var manEscaper = strings.NewReplacer(`\`, `\e`, `-`, `\-`)
+
+// manText escapes s for roff, lines can't start with control characters.
+func manText(s string) string {
+	lines := strings.Split(manEscaper.Replace(s), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, ".") || strings.HasPrefix(line, "'") {
+			lines[i] = `\&` + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func writeMan(buf *bytes.Buffer, groups []*group, prog string) {
+	fmt.Fprintf(buf, ".TH \"%s\" \"1\"\n", manText(strings.ToUpper(prog)))
+	buf.WriteString(".SH NAME\n")
+	fmt.Fprintf(buf, "%s\n", manText(prog))
+	buf.WriteString(".SH SYNOPSIS\n")
+	fmt.Fprintf(buf, "\\fB%s\\fR [\\fIOPTIONS\\fR]\n", manText(prog))
+	buf.WriteString(".SH OPTIONS\n")
+	for _, g := range groups {
+		if g.title != "" {
+			fmt.Fprintf(buf, ".SS %s\n", manText(g.title))
+		}
+		if g.description != "" {
+			fmt.Fprintf(buf, "%s\n", manText(g.description))
+		}
+		for _, flag := range g.flags {
+			flagNames := names(flag)
+			for i, name := range flagNames {
+				flagNames[i] = `\fB` + manText(name) + `\fR`
+			}
+			buf.WriteString(".TP\n")
+			fmt.Fprintf(buf, "%s \\fI%s\\fR\n", strings.Join(flagNames, ", "), manText(flag.Value.Type()))
+			var details []string
+			if flag.DefValue != "" {
+				details = append(details, "default: "+flag.DefValue)
+			}
+			if flag.EnvName != "" {
+				details = append(details, "env: "+flag.EnvName)
+			}
+			text := description(flag)
+			if len(details) > 0 {
+				text = strings.TrimSpace(text + " (" + strings.Join(details, ", ") + ")")
+			}
+			fmt.Fprintf(buf, "%s\n", manText(text))
+		}
+	}
+}
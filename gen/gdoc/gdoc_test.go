@@ -0,0 +1,157 @@
+package gdoc
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type httpConfig struct {
+	Host string `desc:"HTTP host"`
+	Port int    `flag:"port p" desc:"HTTP port"`
+	TLS  struct {
+		Cert string `desc:"path to <cert> file"`
+	}
+}
+
+type cfg1 struct {
+	Debug  bool       `flag:",negatable" desc:"enable debug | trace"`
+	Old    string     `flag:",deprecated" desc:"use new"`
+	Secret string     `flag:",hidden"`
+	HTTP   httpConfig `group:"HTTP options" desc:"HTTP server settings"`
+	Name   string     `env:"~APP_NAME"`
+}
+
+func newCfg() *cfg1 {
+	cfg := &cfg1{Name: "app"}
+	cfg.HTTP.Host = "127.0.0.1"
+	cfg.HTTP.Port = 8080
+	return cfg
+}
+
+func TestParseTo(t *testing.T) {
+	tests := []struct {
+		name string
+
+		cfg    interface{}
+		format Format
+		expOut string
+		expErr error
+	}{
+		{
+			name:   "Test markdown",
+			cfg:    newCfg(),
+			format: Markdown,
+			expOut: `# my-app
+
+## Flags
+
+| Flag | Env | Type | Default | Description |
+|------|-----|------|---------|-------------|
+| ` + "`" + `--[no-]debug` + "`" + ` | ` + "`" + `DEBUG` + "`" + ` | bool | ` + "`" + `false` + "`" + ` | enable debug \| trace |
+| ` + "`" + `--old` + "`" + ` | ` + "`" + `OLD` + "`" + ` | string |  | (deprecated) use new |
+| ` + "`" + `--name` + "`" + ` | ` + "`" + `APP_NAME` + "`" + ` | string | ` + "`" + `app` + "`" + ` |  |
+
+## HTTP options
+
+HTTP server settings
+
+| Flag | Env | Type | Default | Description |
+|------|-----|------|---------|-------------|
+| ` + "`" + `--http-host` + "`" + ` | ` + "`" + `HTTP_HOST` + "`" + ` | string | ` + "`" + `127.0.0.1` + "`" + ` | HTTP host |
+| ` + "`" + `--http-port` + "`" + `, ` + "`" + `-p` + "`" + ` | ` + "`" + `HTTP_PORT` + "`" + ` | int | ` + "`" + `8080` + "`" + ` | HTTP port |
+
+## HTTP.TLS
+
+| Flag | Env | Type | Default | Description |
+|------|-----|------|---------|-------------|
+| ` + "`" + `--http-tls-cert` + "`" + ` | ` + "`" + `HTTP_TLS_CERT` + "`" + ` | string |  | path to <cert> file |
+`,
+		},
+		{
+			name:   "Test html",
+			cfg:    newCfg(),
+			format: HTML,
+			expOut: `<h1>my-app</h1>
+<h2>Flags</h2>
+<table>
+<tr><th>Flag</th><th>Env</th><th>Type</th><th>Default</th><th>Description</th></tr>
+<tr><td><code>--[no-]debug</code></td><td><code>DEBUG</code></td><td>bool</td><td><code>false</code></td><td>enable debug | trace</td></tr>
+<tr><td><code>--old</code></td><td><code>OLD</code></td><td>string</td><td></td><td>(deprecated) use new</td></tr>
+<tr><td><code>--name</code></td><td><code>APP_NAME</code></td><td>string</td><td><code>app</code></td><td></td></tr>
+</table>
+<h2>HTTP options</h2>
+<p>HTTP server settings</p>
+<table>
+<tr><th>Flag</th><th>Env</th><th>Type</th><th>Default</th><th>Description</th></tr>
+<tr><td><code>--http-host</code></td><td><code>HTTP_HOST</code></td><td>string</td><td><code>127.0.0.1</code></td><td>HTTP host</td></tr>
+<tr><td><code>--http-port</code>, <code>-p</code></td><td><code>HTTP_PORT</code></td><td>int</td><td><code>8080</code></td><td>HTTP port</td></tr>
+</table>
+<h2>HTTP.TLS</h2>
+<table>
+<tr><th>Flag</th><th>Env</th><th>Type</th><th>Default</th><th>Description</th></tr>
+<tr><td><code>--http-tls-cert</code></td><td><code>HTTP_TLS_CERT</code></td><td>string</td><td></td><td>path to &lt;cert&gt; file</td></tr>
+</table>
+`,
+		},
+		{
+			name:   "Test man",
+			cfg:    newCfg(),
+			format: Man,
+			expOut: `.TH "MY\-APP" "1"
+.SH NAME
+my\-app
+.SH SYNOPSIS
+\fBmy\-app\fR [\fIOPTIONS\fR]
+.SH OPTIONS
+.TP
+\fB\-\-[no\-]debug\fR \fIbool\fR
+enable debug | trace (default: false, env: DEBUG)
+.TP
+\fB\-\-old\fR \fIstring\fR
+(deprecated) use new (env: OLD)
+.TP
+\fB\-\-name\fR \fIstring\fR
+(default: app, env: APP_NAME)
+.SS HTTP options
+HTTP server settings
+.TP
+\fB\-\-http\-host\fR \fIstring\fR
+HTTP host (default: 127.0.0.1, env: HTTP_HOST)
+.TP
+\fB\-\-http\-port\fR, \fB\-p\fR \fIint\fR
+HTTP port (default: 8080, env: HTTP_PORT)
+.SS HTTP.TLS
+.TP
+\fB\-\-http\-tls\-cert\fR \fIstring\fR
+path to <cert> file (env: HTTP_TLS_CERT)
+`,
+		},
+		{
+			name:   "Test unsupported format",
+			cfg:    newCfg(),
+			format: Format("pdf"),
+			expErr: errors.New(`unsupported format "pdf"`),
+		},
+		{
+			name:   "Test bad cfg value",
+			cfg:    "bad config",
+			format: Markdown,
+			expErr: errors.New("object must be a pointer to struct or interface"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := ParseTo(test.cfg, buf, "my-app", test.format)
+			require.Equal(t, test.expErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, test.expOut, buf.String())
+		})
+	}
+}
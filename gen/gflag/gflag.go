@@ -2,6 +2,7 @@ package gflag
 
 import (
 	"flag"
+	"fmt"
 	"os"
 
 	"github.com/octago/sflags"
@@ -17,9 +18,14 @@ var _ flagSet = (*flag.FlagSet)(nil)
 
 // GenerateTo takes a list of sflag.Flag,
 // that are parsed from some config structure, and put it to dst.
+// flag can't hide flags, so hidden flags and negations of negatable flags
+// are printed by dst.PrintDefaults, use Usage to print them properly.
 func GenerateTo(src []*sflags.Flag, dst flagSet) {
 	for _, srcFlag := range src {
 		dst.Var(srcFlag.Value, srcFlag.Name, srcFlag.Usage)
+		if neg := srcFlag.Negation(); neg != nil {
+			dst.Var(neg.Value, neg.Name, neg.Usage)
+		}
 	}
 }
 
@@ -36,15 +42,52 @@ func ParseTo(cfg interface{}, dst flagSet, optFuncs ...sflags.OptFunc) error {
 
 // Parse parses cfg, that is a pointer to some structure,
 // puts it to the new flag.FlagSet and returns it.
+// Usage of the flag.FlagSet is set by Usage.
 func Parse(cfg interface{}, optFuncs ...sflags.OptFunc) (*flag.FlagSet, error) {
-	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
-	err := ParseTo(cfg, fs, optFuncs...)
+	flags, err := sflags.ParseStruct(cfg, optFuncs...)
 	if err != nil {
 		return nil, err
 	}
+	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	GenerateTo(flags, fs)
+	fs.Usage = Usage(flags, fs)
 	return fs, nil
 }
 
+// Usage returns a function, that prints usage message of dst like flag does,
+// but hidden flags of src are skipped and negatable flags are printed once as -[no-]name.
+// Set it as Usage of dst, e.g. flag.Usage = gflag.Usage(flags, flag.CommandLine).
+func Usage(src []*sflags.Flag, dst *flag.FlagSet) func() {
+	return func() {
+		if dst.Name() == "" {
+			fmt.Fprintf(dst.Output(), "Usage:\n")
+		} else {
+			fmt.Fprintf(dst.Output(), "Usage of %s:\n", dst.Name())
+		}
+		PrintDefaults(src, dst)
+	}
+}
+
+// PrintDefaults prints flags of src to output of dst like dst.PrintDefaults does,
+// but hidden flags are skipped and negatable flags are printed once as -[no-]name.
+func PrintDefaults(src []*sflags.Flag, dst *flag.FlagSet) {
+	visible := flag.NewFlagSet(dst.Name(), flag.ContinueOnError)
+	visible.SetOutput(dst.Output())
+	for _, srcFlag := range src {
+		if srcFlag.Hidden {
+			continue
+		}
+		name := srcFlag.Name
+		if srcFlag.Negatable {
+			name = "[no-]" + name
+		}
+		visible.Var(srcFlag.Value, name, srcFlag.Usage)
+		// Var records the current value, that might be already parsed
+		visible.Lookup(name).DefValue = srcFlag.DefValue
+	}
+	visible.PrintDefaults()
+}
+
 // ParseToDef parses cfg, that is a pointer to some structure and
 // puts it to the default flag.CommandLine.
 func ParseToDef(cfg interface{}, optFuncs ...sflags.OptFunc) error {
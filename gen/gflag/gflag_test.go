@@ -1,6 +1,7 @@
 package gflag
 
 import (
+	"bytes"
 	"errors"
 	"flag"
 	"os"
@@ -123,3 +124,99 @@ func TestParseToDef(t *testing.T) {
 	err = ParseToDef("bad string")
 	assert.Error(t, err)
 }
+
+func TestParse_FromEnv(t *testing.T) {
+	defer os.Unsetenv("GFLAG_STRING_VALUE1")
+	os.Setenv("GFLAG_STRING_VALUE1", "env_value")
+
+	cfg := &cfg1{StringValue1: "value1", StringValue2: "value2"}
+	fs, err := Parse(cfg, sflags.EnvPrefix("GFLAG_"), sflags.FromEnv(true))
+	require.NoError(t, err)
+	assert.Equal(t, "env_value", cfg.StringValue1)
+
+	err = fs.Parse([]string{"-string-value-two", "cli_value"})
+	require.NoError(t, err)
+	assert.Equal(t, "env_value", cfg.StringValue1)
+	assert.Equal(t, "cli_value", cfg.StringValue2)
+
+	err = fs.Parse([]string{"-string-value1", "cli_value"})
+	require.NoError(t, err)
+	assert.Equal(t, "cli_value", cfg.StringValue1)
+}
+
+func TestGenerateTo_Changed(t *testing.T) {
+	cfg := &cfg1{StringValue1: "value1", StringValue2: "value2"}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	GenerateTo(flags, fs)
+
+	err = fs.Parse([]string{"-string-value-two", "value2"})
+	require.NoError(t, err)
+	assert.False(t, flags[0].Changed())
+	assert.True(t, flags[1].Changed())
+	assert.Equal(t, sflags.SourceFlag, flags[1].Source())
+}
+
+func TestParse_Negatable(t *testing.T) {
+	cfg := &struct {
+		TLS   bool `flag:"tls,negatable"`
+		Color bool `flag:",negatable"`
+	}{TLS: true}
+	fs, err := Parse(cfg)
+	require.NoError(t, err)
+	fs.Init("test", flag.ContinueOnError)
+
+	err = fs.Parse([]string{"-no-tls", "-color", "-no-color=false"})
+	require.NoError(t, err)
+	assert.False(t, cfg.TLS)
+	assert.True(t, cfg.Color)
+}
+
+func TestParse_Usage(t *testing.T) {
+	cfg := &struct {
+		TLS    bool   `flag:"tls,negatable" desc:"use TLS"`
+		Name   string `desc:"name" default:"test"`
+		Secret string `flag:",hidden"`
+	}{}
+	fs, err := Parse(cfg)
+	require.NoError(t, err)
+	fs.Init("test", flag.ContinueOnError)
+	buf := &bytes.Buffer{}
+	fs.SetOutput(buf)
+
+	fs.Usage()
+	assert.Equal(t, `Usage of test:
+  -[no-]tls
+    	use TLS (default false)
+  -name value
+    	name (default test)
+`, buf.String())
+
+	err = fs.Parse([]string{"-no-tls", "-secret", "value", "-name", "parsed"})
+	require.NoError(t, err)
+	assert.Equal(t, "value", cfg.Secret)
+
+	// defaults aren't replaced by parsed values
+	buf.Reset()
+	fs.Usage()
+	assert.Contains(t, buf.String(), "name (default test)\n")
+}
+
+func TestParse_Args(t *testing.T) {
+	cfg := &struct {
+		Name string
+		Src  string `arg:"0,required"`
+	}{}
+	fs, err := Parse(cfg)
+	require.NoError(t, err)
+	args, err := sflags.ParseArgs(cfg)
+	require.NoError(t, err)
+
+	err = fs.Parse([]string{"-name", "value", "src"})
+	require.NoError(t, err)
+	err = sflags.SetArgs(args, fs.Args())
+	require.NoError(t, err)
+	assert.Equal(t, "value", cfg.Name)
+	assert.Equal(t, "src", cfg.Src)
+}
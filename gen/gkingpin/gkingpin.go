@@ -1,6 +1,10 @@
 package gkingpin
 
 import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/alecthomas/kingpin"
@@ -11,39 +15,201 @@ type flagger interface {
 	Flag(name, help string) *kingpin.FlagClause
 }
 
+// argger is implemented by kingpin.Application and kingpin.CmdClause.
+type argger interface {
+	Arg(name, help string) *kingpin.ArgClause
+}
+
+// commander is implemented by kingpin.Application and kingpin.CmdClause.
+type commander interface {
+	flagger
+	argger
+	Command(name, help string) *kingpin.CmdClause
+}
+
 // GenerateTo takes a list of sflag.Flag,
 // that are parsed from some config structure, and put it to dst.
+// Negations of negatable flags aren't generated, because kingpin
+// has --no-<name> counterpart for every boolean flag.
+// Environment variables aren't passed to kingpin, values from them are set
+// by sflags.SetFromEnv right away, so their source is sflags.SourceEnv.
+// If dst is kingpin.Application or kingpin.CmdClause, invalid values
+// of environment variables are reported and required flags are checked
+// by sflags.ValidateRequired after parsing.
 func GenerateTo(src []*sflags.Flag, dst flagger) {
+	envErr := sflags.SetFromEnv(src)
 	for _, srcFlag := range src {
 		name := srcFlag.Name
 		if srcFlag.Short != "" {
 			name += ", " + srcFlag.Short
 		}
-		flag := dst.Flag(srcFlag.Name, srcFlag.Usage)
-		flag.SetValue(srcFlag.Value)
+		usage := srcFlag.Usage
 		if srcFlag.EnvName != "" {
-			flag.Envar(srcFlag.EnvName)
+			// Envar isn't used, but help shows it
+			usage += " ($" + srcFlag.EnvName + ")"
 		}
+		flag := dst.Flag(srcFlag.Name, usage)
+		flag.SetValue(srcFlag.Value)
 		if srcFlag.Hidden {
 			flag.Hidden()
 		}
+		if len(srcFlag.Choices) > 0 {
+			// value checks choices itself, so kingpin's Enum isn't used
+			flag.HintOptions(srcFlag.Choices...)
+		}
 		if srcFlag.Short != "" {
 			r, _ := utf8.DecodeRuneInString(srcFlag.Short)
 			if r != utf8.RuneError {
 				flag.Short(r)
 			}
 		}
+	}
+	addAction(dst, func() error {
+		if envErr != nil {
+			return envErr
+		}
+		return sflags.ValidateRequired(src)
+	})
+}
 
+// addAction makes dst call check after parsing, if dst is kingpin.Application
+// or kingpin.CmdClause. Actions are called after help and kingpin's validators,
+// unlike validators they don't replace each other.
+// kingpin checks required flags only on command line, so values from environment,
+// default tags and config files are checked this way.
+func addAction(dst interface{}, check func() error) {
+	action := func(*kingpin.ParseContext) error {
+		return check()
+	}
+	switch casted := dst.(type) {
+	case *kingpin.Application:
+		casted.Action(action)
+	case *kingpin.CmdClause:
+		casted.Action(action)
+	}
+}
+
+// GenerateArgsTo takes a list of sflag.Arg,
+// that are parsed from some config structure, and put it to dst.
+func GenerateArgsTo(src []*sflags.Arg, dst argger) {
+	for _, srcArg := range src {
+		arg := dst.Arg(srcArg.Name, srcArg.Usage)
+		arg.SetValue(srcArg.Value)
+		if srcArg.Required {
+			arg.Required()
+		}
 	}
 }
 
 // ParseTo parses cfg, that is a pointer to some structure,
 // and puts it to dst.
+// Positional arguments are also generated if dst supports them.
+// If dst is kingpin.Application or kingpin.CmdClause, cfg is validated by sflags.ValidateStruct
+// after parsing, validators set by app.Validate or cmd.Validate are called before it.
 func ParseTo(cfg interface{}, dst flagger, optFuncs ...sflags.OptFunc) error {
 	flags, err := sflags.ParseStruct(cfg, optFuncs...)
 	if err != nil {
 		return err
 	}
 	GenerateTo(flags, dst)
+	if argDst, casted := dst.(argger); casted {
+		args, err := sflags.ParseArgs(cfg, optFuncs...)
+		if err != nil {
+			return err
+		}
+		GenerateArgsTo(args, argDst)
+	}
+	addAction(dst, func() error {
+		return sflags.ValidateStruct(cfg, optFuncs...)
+	})
 	return nil
 }
+
+// GenerateCommandTo takes a sflags.Command,
+// that is parsed from some config structure, and puts its flags, positional arguments
+// and subcommands to dst.
+// Use src.Selected() after parsing to find out which command was selected.
+// If dst is kingpin.Application, structures of the selected command and its parents
+// are validated by sflags.Command.Validate after parsing,
+// validators set by app.Validate are called before it.
+func GenerateCommandTo(src *sflags.Command, dst commander) {
+	GenerateTo(src.Flags, dst)
+	GenerateArgsTo(src.Args, dst)
+	if app, casted := dst.(*kingpin.Application); casted {
+		addAction(app, func() error {
+			return src.Selected().Validate()
+		})
+	}
+	for _, srcCmd := range src.Commands {
+		srcCmd := srcCmd
+		cmd := dst.Command(srcCmd.Name, srcCmd.Usage)
+		// pre actions are applied before validation
+		cmd.PreAction(func(*kingpin.ParseContext) error {
+			srcCmd.Select()
+			return nil
+		})
+		GenerateCommandTo(srcCmd, cmd)
+	}
+}
+
+// ParseCommandTo parses cfg, that is a pointer to some structure,
+// puts flags, positional arguments and subcommands to dst and returns the root command.
+func ParseCommandTo(cfg interface{}, dst commander, optFuncs ...sflags.OptFunc) (*sflags.Command, error) {
+	cmd, err := sflags.ParseCommand(cfg, optFuncs...)
+	if err != nil {
+		return nil, err
+	}
+	GenerateCommandTo(cmd, dst)
+	return cmd, nil
+}
+
+// flagsTemplate is a part of kingpin.DefaultUsageTemplate, that prints flags.
+const flagsTemplate = "{{.Context.Flags|FlagsToTwoColumns|FormatTwoColumns}}"
+
+// UsageTemplate returns kingpin.DefaultUsageTemplate,
+// where flags from src with sflags.Group are printed in sections with group titles.
+// Use it as app.UsageTemplate(gkingpin.UsageTemplate(flags)).
+func UsageTemplate(src []*sflags.Flag) string {
+	var titles []string
+	groups := map[string][]*sflags.Flag{}
+	for _, flag := range src {
+		if flag.Group == nil {
+			continue
+		}
+		title := flag.Group.Title
+		if _, found := groups[title]; !found {
+			titles = append(titles, title)
+		}
+		groups[title] = append(groups[title], flag)
+	}
+	if len(titles) == 0 {
+		return kingpin.DefaultUsageTemplate
+	}
+
+	var grouped []string
+	buf := &bytes.Buffer{}
+	for _, title := range titles {
+		var conds []string
+		for _, flag := range groups[title] {
+			conds = append(conds, "(eq .Name "+strconv.Quote(flag.Name)+")")
+			grouped = append(grouped, "(eq .Name "+strconv.Quote(flag.Name)+")")
+		}
+		fmt.Fprintf(buf, "\n%s:\n", title)
+		if description := groups[title][0].Group.Description; description != "" {
+			fmt.Fprintf(buf, "{{Indent 1}}%s\n", description)
+		}
+		writeFlagsTemplate(buf, "(or "+strings.Join(conds, " ")+")")
+	}
+
+	flags := &bytes.Buffer{}
+	writeFlagsTemplate(flags, "(not (or "+strings.Join(grouped, " ")+"))")
+	flags.WriteString(buf.String())
+	return strings.Replace(kingpin.DefaultUsageTemplate, flagsTemplate, strings.TrimSuffix(flags.String(), "\n"), 1)
+}
+
+// writeFlagsTemplate writes template, that prints visible flags matching cond.
+func writeFlagsTemplate(buf *bytes.Buffer, cond string) {
+	fmt.Fprintf(buf, "{{range .Context.Flags}}{{if and (not .Hidden) %s}}", cond)
+	buf.WriteString("{{Indent 1}}{{FormatFlag false .}}\n{{.Help|Wrap 6}}")
+	buf.WriteString("{{end}}{{end}}")
+}
@@ -1,7 +1,9 @@
 package gkingpin
 
 import (
+	"bytes"
 	"errors"
+	"os"
 	"testing"
 
 	"github.com/alecthomas/kingpin"
@@ -144,3 +146,303 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTo_Required(t *testing.T) {
+	defer os.Unsetenv("GKINGPIN_NAME")
+	os.Setenv("GKINGPIN_NAME", "env")
+
+	tests := []struct {
+		name     string
+		cfg      interface{}
+		args     []string
+		optFuncs []sflags.OptFunc
+		expErr   string
+	}{
+		{
+			name: "Not set",
+			cfg: &struct {
+				Name  string `flag:",required"`
+				Name2 string
+			}{},
+			args:   []string{"--name2", "value2"},
+			expErr: `required flag(s) "name" not set`,
+		},
+		{
+			name: "Command line",
+			cfg: &struct {
+				Name string `flag:",required"`
+			}{},
+			args: []string{"--name", "value"},
+		},
+		{
+			name: "Env",
+			cfg: &struct {
+				Name string `flag:",required"`
+			}{},
+			optFuncs: []sflags.OptFunc{sflags.EnvPrefix("GKINGPIN_"), sflags.FromEnv(true)},
+		},
+		{
+			name: "Default tag",
+			cfg: &struct {
+				Level string `flag:",required" default:"info"`
+			}{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := kingpin.New("testApp", "")
+			app.Terminate(nil)
+			err := ParseTo(test.cfg, app, test.optFuncs...)
+			require.NoError(t, err)
+
+			_, err = app.Parse(test.args)
+			if test.expErr != "" {
+				assert.EqualError(t, err, test.expErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGenerateTo_Changed(t *testing.T) {
+	cfg := &cfg1{StringValue1: "value1", StringValue2: "value2"}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+	app := kingpin.New("testApp", "")
+	app.Terminate(nil)
+	GenerateTo(flags, app)
+
+	_, err = app.Parse([]string{"-s", "value2"})
+	require.NoError(t, err)
+	assert.False(t, flags[0].Changed())
+	assert.True(t, flags[1].Changed())
+	assert.Equal(t, sflags.SourceFlag, flags[1].Source())
+}
+
+func TestParseTo_FromEnv(t *testing.T) {
+	defer os.Unsetenv("GKINGPIN_STRING_VALUE1")
+	os.Setenv("GKINGPIN_STRING_VALUE1", "env_value")
+
+	cfg := &cfg1{}
+	app := kingpin.New("testApp", "")
+	app.Terminate(nil)
+	err := ParseTo(cfg, app, sflags.EnvPrefix("GKINGPIN_"))
+	require.NoError(t, err)
+	assert.Equal(t, "env_value", cfg.StringValue1)
+
+	// GenerateTo sets values from environment itself
+	cfg = &cfg1{}
+	flags, err := sflags.ParseStruct(cfg, sflags.EnvPrefix("GKINGPIN_"))
+	require.NoError(t, err)
+	app = kingpin.New("testApp", "")
+	app.Terminate(nil)
+	GenerateTo(flags, app)
+	_, err = app.Parse([]string{})
+	require.NoError(t, err)
+	assert.Equal(t, "env_value", cfg.StringValue1)
+	assert.Equal(t, sflags.SourceEnv, flags[0].Source())
+
+	cfg = &cfg1{}
+	flags, err = sflags.ParseStruct(cfg, sflags.EnvPrefix("GKINGPIN_"), sflags.FromEnv(true))
+	require.NoError(t, err)
+	app = kingpin.New("testApp", "")
+	app.Terminate(nil)
+	GenerateTo(flags, app)
+	_, err = app.Parse([]string{"-s", "cli_value"})
+	require.NoError(t, err)
+	assert.Equal(t, "env_value", cfg.StringValue1)
+	assert.Equal(t, sflags.SourceEnv, flags[0].Source())
+	assert.Equal(t, sflags.SourceFlag, flags[1].Source())
+}
+
+func TestParseTo_Args(t *testing.T) {
+	cfg := &struct {
+		Name  string
+		Src   string   `arg:"0,required"`
+		Files []string `arg:"rest"`
+	}{}
+	app := kingpin.New("testApp", "")
+	app.Terminate(nil)
+	err := ParseTo(cfg, app)
+	require.NoError(t, err)
+
+	_, err = app.Parse([]string{"--name", "value"})
+	assert.EqualError(t, err, "required argument 'src' not provided")
+
+	_, err = app.Parse([]string{"--name", "value", "src", "one", "two"})
+	require.NoError(t, err)
+	assert.Equal(t, "value", cfg.Name)
+	assert.Equal(t, "src", cfg.Src)
+	assert.Equal(t, []string{"one", "two"}, cfg.Files)
+}
+
+// nameCfg checks, that generators call Validate, validation itself is tested by sflags.
+type nameCfg struct {
+	Name string
+}
+
+func (c *nameCfg) Validate() error {
+	if c.Name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func TestParseTo_Validate(t *testing.T) {
+	cfg := &struct {
+		User nameCfg
+	}{}
+	app := kingpin.New("testApp", "")
+	app.Terminate(nil)
+	calls := 0
+	app.Validate(func(*kingpin.Application) error {
+		calls++
+		return nil
+	})
+	err := ParseTo(cfg, app)
+	require.NoError(t, err)
+
+	_, err = app.Parse([]string{})
+	assert.EqualError(t, err, "flag group User: name is empty")
+
+	_, err = app.Parse([]string{"--user-name", "user"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestParseCommandTo(t *testing.T) {
+	cfg := &struct {
+		Debug  bool
+		Remote struct {
+			Add struct {
+				Fetch bool
+				Name  string `arg:"0,required"`
+			} `cmd:"add"`
+			Remove struct{} `cmd:"remove"`
+		} `cmd:"remote"`
+	}{}
+	app := kingpin.New("testApp", "")
+	app.Terminate(nil)
+	cmd, err := ParseCommandTo(cfg, app)
+	require.NoError(t, err)
+
+	selected, err := app.Parse([]string{"--debug", "remote", "add", "--fetch", "origin"})
+	require.NoError(t, err)
+	assert.Equal(t, "remote add", selected)
+	assert.True(t, cfg.Debug)
+	assert.True(t, cfg.Remote.Add.Fetch)
+	assert.Equal(t, "origin", cfg.Remote.Add.Name)
+	assert.Equal(t, []string{"remote", "add"}, cmd.Selected().Path())
+
+	_, err = ParseCommandTo("bad config", app)
+	assert.Error(t, err)
+}
+
+func TestParseCommandTo_Required(t *testing.T) {
+	cfg := &struct {
+		Token  string `flag:",required"`
+		Remote struct {
+			Name string `flag:",required" default:"origin"`
+			Push struct {
+				Branch string `flag:",required"`
+			} `cmd:"push"`
+		} `cmd:"remote"`
+	}{}
+	app := kingpin.New("testApp", "")
+	app.Terminate(nil)
+	_, err := ParseCommandTo(cfg, app)
+	require.NoError(t, err)
+
+	_, err = app.Parse([]string{"remote", "push"})
+	assert.EqualError(t, err, `required flag(s) "token" not set`)
+
+	_, err = app.Parse([]string{"--token", "secret", "remote", "push"})
+	assert.EqualError(t, err, `required flag(s) "branch" not set`)
+
+	_, err = app.Parse([]string{"--token", "secret", "remote", "push", "--branch", "main"})
+	assert.NoError(t, err)
+}
+
+func TestParseCommandTo_Validate(t *testing.T) {
+	cfg := &struct {
+		Remote struct {
+			nameCfg
+			Push struct {
+				Branch nameCfg
+			} `cmd:"push"`
+		} `cmd:"remote"`
+	}{}
+	app := kingpin.New("testApp", "")
+	app.Terminate(nil)
+	_, err := ParseCommandTo(cfg, app)
+	require.NoError(t, err)
+
+	_, err = app.Parse([]string{"remote", "push"})
+	assert.EqualError(t, err, "command remote: name is empty")
+
+	_, err = app.Parse([]string{"remote", "--name", "origin", "push"})
+	assert.EqualError(t, err, "command remote push: flag group Branch: name is empty")
+
+	_, err = app.Parse([]string{"remote", "push", "--branch-name", "main"})
+	require.NoError(t, err)
+}
+
+func TestParseTo_Choices(t *testing.T) {
+	cfg := &struct {
+		Format string `choices:"json,text"`
+	}{}
+	app := kingpin.New("testApp", "")
+	app.Terminate(nil)
+	err := ParseTo(cfg, app)
+	require.NoError(t, err)
+
+	_, err = app.Parse([]string{"--format", "xml"})
+	assert.EqualError(t, err, `invalid value "xml", allowed values: json, text`)
+
+	_, err = app.Parse([]string{"--format", "json"})
+	require.NoError(t, err)
+	assert.Equal(t, "json", cfg.Format)
+}
+
+func TestUsageTemplate(t *testing.T) {
+	cfg := &struct {
+		Debug bool `desc:"debug mode"`
+		HTTP  struct {
+			Host string `flag:"host h" desc:"HTTP host"`
+		} `group:"HTTP options" desc:"HTTP server settings"`
+		DB struct {
+			URL string `desc:"database url"`
+		}
+	}{}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+	app := kingpin.New("testApp", "test app")
+	buf := &bytes.Buffer{}
+	app.UsageWriter(buf)
+	GenerateTo(flags, app)
+	app.UsageTemplate(UsageTemplate(flags))
+	app.Usage(nil)
+	assert.Equal(t, `usage: testApp [<flags>]
+
+test app
+
+Flags:
+  --help
+      Show context-sensitive help (also try --help-long and --help-man).
+  --debug
+      debug mode ($DEBUG)
+
+HTTP options:
+  HTTP server settings
+  -h, --http-host=HTTP-HOST
+      HTTP host ($HTTP_HOST)
+
+DB:
+  --db-url=DB-URL
+      database url ($DB_URL)
+
+`, buf.String())
+
+	assert.Equal(t, kingpin.DefaultUsageTemplate, UsageTemplate(flags[:1]))
+}
@@ -1,12 +1,26 @@
 package gpflag
 
 import (
+	"bytes"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/octago/sflags"
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
 
+// ChoicesAnnotation is a pflag annotation with allowed values of a flag,
+// it might be used for shell completion.
+// Flags with choices also have cobra.BashCompCustom annotation, that completes them
+// in cobra's bash completion (see AddChoicesCompletion).
+const ChoicesAnnotation = "sflags_annotation_choices"
+
+// GroupAnnotation is a pflag annotation with title and description of the flag group,
+// it's used by GroupedUsages.
+const GroupAnnotation = "sflags_annotation_group"
+
 // flagSet describes interface,
 // that's implemented by pflag library and required by sflags.
 type flagSet interface {
@@ -33,6 +47,48 @@ func GenerateTo(src []*sflags.Flag, dst flagSet) {
 				flag.Deprecated = "Deprecated"
 			}
 		}
+		if len(srcFlag.Choices) > 0 {
+			if flag.Annotations == nil {
+				flag.Annotations = make(map[string][]string)
+			}
+			flag.Annotations[ChoicesAnnotation] = srcFlag.Choices
+			flag.Annotations[cobra.BashCompCustom] = []string{choicesCompletion(srcFlag.Choices)}
+		}
+		if srcFlag.Group != nil {
+			if flag.Annotations == nil {
+				flag.Annotations = make(map[string][]string)
+			}
+			flag.Annotations[GroupAnnotation] = []string{srcFlag.Group.Title, srcFlag.Group.Description}
+		}
+		if neg := srcFlag.Negation(); neg != nil {
+			negFlag := dst.VarPF(neg.Value, neg.Name, "", neg.Usage)
+			negFlag.NoOptDefVal = "true"
+			negFlag.Hidden = neg.Hidden
+		}
+	}
+}
+
+// choicesFunction is a bash function, that completes choices passed as its arguments.
+const choicesFunction = `
+__sflags_choices()
+{
+    COMPREPLY=( $(compgen -W "$*" -- "$cur") )
+}
+`
+
+// choicesCompletion returns a bash command, that completes choices,
+// for cobra.BashCompCustom annotation.
+func choicesCompletion(choices []string) string {
+	return "__sflags_choices " + strings.Join(choices, " ")
+}
+
+// AddChoicesCompletion adds the bash function, that completes choices of flags,
+// to BashCompletionFunction of cmd. cobra puts it to bash completion script
+// of the root command only, so cmd should be the root command.
+// ParseToCommand and GenerateCommandTo call it for the root of their command.
+func AddChoicesCompletion(cmd *cobra.Command) {
+	if !strings.Contains(cmd.BashCompletionFunction, choicesFunction) {
+		cmd.BashCompletionFunction += choicesFunction
 	}
 }
 
@@ -47,6 +103,161 @@ func ParseTo(cfg interface{}, dst flagSet, optFuncs ...sflags.OptFunc) error {
 	return nil
 }
 
+// GenerateArgsTo takes a list of sflag.Arg,
+// that are parsed from some config structure, and sets positional arguments validation for cmd.
+// Values of arguments are set during validation, before cmd is run.
+func GenerateArgsTo(src []*sflags.Arg, cmd *cobra.Command) {
+	cmd.Args = func(cmd *cobra.Command, args []string) error {
+		return sflags.SetArgs(src, args)
+	}
+}
+
+// ParseToCommand parses cfg, that is a pointer to some structure,
+// and puts flags and positional arguments to cmd.
+// Required flags are checked by sflags.ValidateRequired before cmd is run,
+// then cfg is validated by sflags.ValidateStruct.
+func ParseToCommand(cfg interface{}, cmd *cobra.Command, optFuncs ...sflags.OptFunc) error {
+	flags, err := sflags.ParseStruct(cfg, optFuncs...)
+	if err != nil {
+		return err
+	}
+	GenerateTo(flags, cmd.Flags())
+	AddChoicesCompletion(cmd.Root())
+	addPreRunCheck(cmd, func() error {
+		if err := sflags.ValidateRequired(flags); err != nil {
+			return err
+		}
+		return sflags.ValidateStruct(cfg, optFuncs...)
+	})
+	args, err := sflags.ParseArgs(cfg, optFuncs...)
+	if err != nil {
+		return err
+	}
+	GenerateArgsTo(args, cmd)
+	return nil
+}
+
+// GenerateCommandTo takes a sflags.Command,
+// that is parsed from some config structure, and puts its flags, positional arguments
+// and subcommands to dst.
+// Flags of commands with subcommands are persistent, so they can be used with subcommands too.
+// Generated commands without subcommands do nothing when run,
+// use src.Selected() after execution to find out which one was selected.
+// Required flags of the selected command and its parents are checked by
+// sflags.Command.ValidateRequired before it's run, then their structures
+// are validated by sflags.Command.Validate.
+func GenerateCommandTo(src *sflags.Command, dst *cobra.Command) {
+	flags := dst.Flags()
+	if len(src.Commands) > 0 {
+		flags = dst.PersistentFlags()
+	}
+	GenerateTo(src.Flags, flags)
+	AddChoicesCompletion(dst.Root())
+	addPreRunCheck(dst, func() error {
+		if err := src.ValidateRequired(); err != nil {
+			return err
+		}
+		return src.Validate()
+	})
+	dst.Args = func(cmd *cobra.Command, args []string) error {
+		src.Select()
+		return sflags.SetArgs(src.Args, args)
+	}
+	for _, srcCmd := range src.Commands {
+		cmd := &cobra.Command{
+			Use:   srcCmd.Name,
+			Short: srcCmd.Usage,
+		}
+		if len(srcCmd.Commands) == 0 {
+			// cobra doesn't run commands without Run
+			cmd.Run = func(*cobra.Command, []string) {}
+		}
+		GenerateCommandTo(srcCmd, cmd)
+		dst.AddCommand(cmd)
+	}
+}
+
+// addPreRunCheck makes cmd call check before it's run.
+// cobra checks required flags only on command line, so values from environment,
+// default tags and config files are checked this way.
+// PreRunE or PreRun of cmd, that was set before, is called after check.
+func addPreRunCheck(cmd *cobra.Command, check func() error) {
+	preRunE, preRun := cmd.PreRunE, cmd.PreRun
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := check(); err != nil {
+			return err
+		}
+		if preRunE != nil {
+			return preRunE(cmd, args)
+		}
+		if preRun != nil {
+			preRun(cmd, args)
+		}
+		return nil
+	}
+}
+
+// ParseCommandTo parses cfg, that is a pointer to some structure,
+// puts flags, positional arguments and subcommands to dst and returns the root command.
+func ParseCommandTo(cfg interface{}, dst *cobra.Command, optFuncs ...sflags.OptFunc) (*sflags.Command, error) {
+	cmd, err := sflags.ParseCommand(cfg, optFuncs...)
+	if err != nil {
+		return nil, err
+	}
+	GenerateCommandTo(cmd, dst)
+	return cmd, nil
+}
+
+// GroupedUsages returns usage of flags in fs like pflag.FlagSet.FlagUsages,
+// but flags with GroupAnnotation are printed in sections with group titles.
+// Flags without group go first.
+func GroupedUsages(fs *pflag.FlagSet) string {
+	ungrouped := pflag.NewFlagSet("", pflag.ContinueOnError)
+	ungrouped.SortFlags = fs.SortFlags
+	var titles []string
+	groups := map[string]*pflag.FlagSet{}
+	descriptions := map[string]string{}
+	fs.VisitAll(func(flag *pflag.Flag) {
+		group := flag.Annotations[GroupAnnotation]
+		if len(group) == 0 {
+			ungrouped.AddFlag(flag)
+			return
+		}
+		title := group[0]
+		groupFs, found := groups[title]
+		if !found {
+			groupFs = pflag.NewFlagSet(title, pflag.ContinueOnError)
+			groupFs.SortFlags = fs.SortFlags
+			groups[title] = groupFs
+			titles = append(titles, title)
+			if len(group) > 1 {
+				descriptions[title] = group[1]
+			}
+		}
+		groupFs.AddFlag(flag)
+	})
+
+	buf := &bytes.Buffer{}
+	buf.WriteString(ungrouped.FlagUsages())
+	for _, title := range titles {
+		fmt.Fprintf(buf, "\n%s:\n", title)
+		if descriptions[title] != "" {
+			fmt.Fprintf(buf, "  %s\n", descriptions[title])
+		}
+		buf.WriteString(groups[title].FlagUsages())
+	}
+	return buf.String()
+}
+
+// SetGroupedUsage changes usage template of cmd to print flags with GroupedUsages.
+func SetGroupedUsage(cmd *cobra.Command) {
+	cobra.AddTemplateFunc("sflagsGroupedUsages", GroupedUsages)
+	tmpl := cmd.UsageTemplate()
+	tmpl = strings.Replace(tmpl, "{{.LocalFlags.FlagUsages", "{{sflagsGroupedUsages .LocalFlags", -1)
+	tmpl = strings.Replace(tmpl, "{{.InheritedFlags.FlagUsages", "{{sflagsGroupedUsages .InheritedFlags", -1)
+	cmd.SetUsageTemplate(tmpl)
+}
+
 // Parse parses cfg, that is a pointer to some structure,
 // puts it to the new pflag.FlagSet and returns it.
 func Parse(cfg interface{}, optFuncs ...sflags.OptFunc) (*pflag.FlagSet, error) {
@@ -1,14 +1,17 @@
 package gpflag
 
 import (
+	"bytes"
 	"errors"
 	"io/ioutil"
 	"net"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/octago/sflags"
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -297,3 +300,383 @@ func TestPFlagGetters(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, []int{10, 20}, intSliceValue)
 }
+
+func TestParse_FromEnv(t *testing.T) {
+	defer os.Unsetenv("GPFLAG_STRING_VALUE1")
+	os.Setenv("GPFLAG_STRING_VALUE1", "env_value")
+
+	cfg := &cfg1{StringValue1: "value1", StringValue2: "value2"}
+	fs, err := Parse(cfg, sflags.EnvPrefix("GPFLAG_"), sflags.FromEnv(true))
+	require.NoError(t, err)
+	assert.Equal(t, "env_value", cfg.StringValue1)
+
+	err = fs.Parse([]string{"--string-value-two", "cli_value"})
+	require.NoError(t, err)
+	assert.Equal(t, "env_value", cfg.StringValue1)
+	assert.Equal(t, "cli_value", cfg.StringValue2)
+
+	err = fs.Parse([]string{"--string-value1", "cli_value"})
+	require.NoError(t, err)
+	assert.Equal(t, "cli_value", cfg.StringValue1)
+}
+
+func TestParse_StructElems(t *testing.T) {
+	type upstream struct {
+		Host    string
+		Timeout time.Duration
+	}
+	cfg := &struct {
+		Upstreams map[string]upstream
+	}{}
+	args := []string{"--upstreams-eu-host", "eu.local", "--upstreams-us-timeout=2s"}
+	fs, err := Parse(cfg, sflags.CommandLine(args))
+	require.NoError(t, err)
+
+	err = fs.Parse(args)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]upstream{
+		"eu": {Host: "eu.local"},
+		"us": {Timeout: 2 * time.Second},
+	}, cfg.Upstreams)
+}
+
+func TestParse_Merge(t *testing.T) {
+	defer os.Unsetenv("GPFLAG_TAGS")
+	os.Setenv("GPFLAG_TAGS", "env1,env2")
+
+	cfg := &struct {
+		Tags   []string
+		Labels map[string]string `merge:"reset"`
+	}{
+		Tags:   []string{"default"},
+		Labels: map[string]string{"a": "1"},
+	}
+	fs, err := Parse(cfg, sflags.EnvPrefix("GPFLAG_"), sflags.FromEnv(true))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"env1", "env2"}, cfg.Tags)
+
+	err = fs.Parse([]string{"--tags", "cli1", "--tags", "cli2", "--labels", "b:2", "--labels", "c:3"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cli1", "cli2"}, cfg.Tags)
+	assert.Equal(t, map[string]string{"c": "3"}, cfg.Labels)
+
+	err = fs.Parse([]string{"--tags=", "--labels="})
+	require.NoError(t, err)
+	assert.Empty(t, cfg.Tags)
+	assert.Empty(t, cfg.Labels)
+}
+
+func TestParse_Negatable(t *testing.T) {
+	cfg := &struct {
+		TLS   bool `flag:"tls,negatable"`
+		Color bool `flag:",negatable"`
+	}{TLS: true}
+	fs, err := Parse(cfg)
+	require.NoError(t, err)
+	assert.True(t, fs.Lookup("no-tls").Hidden)
+	assert.NotContains(t, fs.FlagUsages(), "no-tls")
+
+	err = fs.Parse([]string{"--no-tls", "--color", "--no-color=false"})
+	require.NoError(t, err)
+	assert.False(t, cfg.TLS)
+	assert.True(t, cfg.Color)
+}
+
+func TestFlagUsages(t *testing.T) {
+	cfg := &struct {
+		Name  string `desc:"name"`
+		Port  int    `desc:"port"`
+		Level string `desc:"level"`
+		Debug bool   `desc:"debug mode"`
+	}{Level: "info"}
+	fs, err := Parse(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "      --debug          debug mode\n"+
+		"      --level string   level (default \"info\")\n"+
+		"      --name string    name\n"+
+		"      --port int       port\n",
+		fs.FlagUsages())
+}
+
+func TestParseToCommand_Required(t *testing.T) {
+	defer os.Unsetenv("GPFLAG_NAME")
+	os.Setenv("GPFLAG_NAME", "env")
+
+	tests := []struct {
+		name     string
+		cfg      interface{}
+		args     []string
+		optFuncs []sflags.OptFunc
+		expErr   string
+	}{
+		{
+			name: "Not set",
+			cfg: &struct {
+				Name  string `flag:",required"`
+				Name2 string
+			}{},
+			args:   []string{"--name2", "value2"},
+			expErr: `required flag(s) "name" not set`,
+		},
+		{
+			name: "Command line",
+			cfg: &struct {
+				Name string `flag:",required"`
+			}{},
+			args: []string{"--name", "value"},
+		},
+		{
+			name: "Env",
+			cfg: &struct {
+				Name string `flag:",required"`
+			}{},
+			optFuncs: []sflags.OptFunc{sflags.EnvPrefix("GPFLAG_"), sflags.FromEnv(true)},
+		},
+		{
+			name: "Default tag",
+			cfg: &struct {
+				Level string `flag:",required" default:"info"`
+			}{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			preRun := false
+			cmd := &cobra.Command{
+				Use:    "test",
+				PreRun: func(cmd *cobra.Command, args []string) { preRun = true },
+				RunE:   func(cmd *cobra.Command, args []string) error { return nil },
+			}
+			cmd.SetOutput(ioutil.Discard)
+			err := ParseToCommand(test.cfg, cmd, test.optFuncs...)
+			require.NoError(t, err)
+
+			cmd.SetArgs(test.args)
+			err = cmd.Execute()
+			if test.expErr != "" {
+				assert.EqualError(t, err, test.expErr)
+				assert.False(t, preRun)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, preRun)
+		})
+	}
+}
+
+func TestGenerateTo_Changed(t *testing.T) {
+	cfg := &cfg1{StringValue1: "value1", StringValue2: "value2"}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	GenerateTo(flags, fs)
+
+	err = fs.Parse([]string{"-s", "value2", "--counter-value1"})
+	require.NoError(t, err)
+	assert.False(t, flags[0].Changed())
+	assert.True(t, flags[1].Changed())
+	assert.True(t, flags[2].Changed())
+	assert.Equal(t, sflags.SourceFlag, flags[1].Source())
+}
+
+func TestParseToCommand(t *testing.T) {
+	cfg := &struct {
+		Name  string
+		Src   string   `arg:"0,required"`
+		Files []string `arg:"rest"`
+	}{}
+	cmd := &cobra.Command{
+		Use:  "test",
+		RunE: func(cmd *cobra.Command, args []string) error { return nil },
+	}
+	cmd.SetOutput(ioutil.Discard)
+	err := ParseToCommand(cfg, cmd)
+	require.NoError(t, err)
+
+	cmd.SetArgs([]string{"--name", "value"})
+	err = cmd.Execute()
+	assert.EqualError(t, err, `required argument(s) "src" not provided`)
+
+	cmd.SetArgs([]string{"--name", "value", "src", "one", "two"})
+	err = cmd.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "value", cfg.Name)
+	assert.Equal(t, "src", cfg.Src)
+	assert.Equal(t, []string{"one", "two"}, cfg.Files)
+
+	err = ParseToCommand("bad config", cmd)
+	assert.Error(t, err)
+}
+
+// nameCfg checks, that generators call Validate, validation itself is tested by sflags.
+type nameCfg struct {
+	Name string
+}
+
+func (c *nameCfg) Validate() error {
+	if c.Name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func TestParseToCommand_Validate(t *testing.T) {
+	cfg := &struct {
+		User  nameCfg
+		Level string `flag:",required"`
+	}{}
+	cmd := &cobra.Command{
+		Use:  "test",
+		RunE: func(cmd *cobra.Command, args []string) error { return nil },
+	}
+	cmd.SetOutput(ioutil.Discard)
+	err := ParseToCommand(cfg, cmd)
+	require.NoError(t, err)
+
+	// required flags are checked first
+	cmd.SetArgs([]string{})
+	err = cmd.Execute()
+	assert.EqualError(t, err, `required flag(s) "level" not set`)
+
+	cmd.SetArgs([]string{"--level", "info"})
+	err = cmd.Execute()
+	assert.EqualError(t, err, "flag group User: name is empty")
+
+	cmd.SetArgs([]string{"--level", "info", "--user-name", "user"})
+	err = cmd.Execute()
+	require.NoError(t, err)
+}
+
+func TestParseCommandTo(t *testing.T) {
+	cfg := &struct {
+		Debug  bool
+		Token  string `flag:",required"`
+		Remote struct {
+			Add struct {
+				Fetch bool
+				Name  string `arg:"0,required"`
+			} `cmd:"add"`
+		} `cmd:"remote"`
+	}{}
+	root := &cobra.Command{Use: "test"}
+	root.SetOutput(ioutil.Discard)
+	cmd, err := ParseCommandTo(cfg, root)
+	require.NoError(t, err)
+
+	root.SetArgs([]string{"remote", "add", "--debug", "origin"})
+	err = root.Execute()
+	assert.EqualError(t, err, `required flag(s) "token" not set`)
+
+	root.SetArgs([]string{"remote", "add", "--token", "secret", "--fetch", "origin"})
+	err = root.Execute()
+	require.NoError(t, err)
+	assert.True(t, cfg.Debug)
+	assert.True(t, cfg.Remote.Add.Fetch)
+	assert.Equal(t, "origin", cfg.Remote.Add.Name)
+	assert.Equal(t, []string{"remote", "add"}, cmd.Selected().Path())
+
+	_, err = ParseCommandTo("bad config", root)
+	assert.Error(t, err)
+}
+
+func TestParseCommandTo_Validate(t *testing.T) {
+	cfg := &struct {
+		Remote struct {
+			nameCfg
+			Push struct {
+				Branch nameCfg
+				Force  string `flag:",required"`
+			} `cmd:"push"`
+		} `cmd:"remote"`
+	}{}
+	root := &cobra.Command{Use: "test"}
+	root.SetOutput(ioutil.Discard)
+	_, err := ParseCommandTo(cfg, root)
+	require.NoError(t, err)
+
+	// required flags are checked first
+	root.SetArgs([]string{"remote", "push"})
+	err = root.Execute()
+	assert.EqualError(t, err, `required flag(s) "force" not set`)
+
+	root.SetArgs([]string{"remote", "push", "--force", "yes"})
+	err = root.Execute()
+	assert.EqualError(t, err, "command remote: name is empty")
+
+	root.SetArgs([]string{"remote", "push", "--name", "origin"})
+	err = root.Execute()
+	assert.EqualError(t, err, "command remote push: flag group Branch: name is empty")
+
+	root.SetArgs([]string{"remote", "push", "--branch-name", "main"})
+	require.NoError(t, root.Execute())
+}
+
+func TestParseTo_Choices(t *testing.T) {
+	cfg := &struct {
+		Format string `choices:"json,text"`
+	}{}
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	err := ParseTo(cfg, fs)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"json", "text"}, fs.Lookup("format").Annotations[ChoicesAnnotation])
+
+	err = fs.Parse([]string{"--format", "xml"})
+	assert.EqualError(t, err,
+		`invalid argument "xml" for "--format" flag: invalid value "xml", allowed values: json, text`)
+}
+
+func TestParseToCommand_ChoicesCompletion(t *testing.T) {
+	cfg := &struct {
+		Format string `choices:"json,text"`
+	}{}
+	root := &cobra.Command{Use: "test"}
+	cmd := &cobra.Command{
+		Use: "sub",
+		Run: func(cmd *cobra.Command, args []string) {},
+	}
+	root.AddCommand(cmd)
+	err := ParseToCommand(cfg, cmd)
+	require.NoError(t, err)
+	err = ParseToCommand(cfg, root)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, root.GenBashCompletion(buf))
+	script := buf.String()
+	assert.Equal(t, 1, strings.Count(script, "__sflags_choices()"))
+	assert.Contains(t, script, `flags_with_completion+=("--format")`)
+	assert.Contains(t, script, `flags_completion+=("__sflags_choices json text")`)
+}
+
+func TestGroupedUsages(t *testing.T) {
+	cfg := &struct {
+		Debug bool `desc:"debug mode"`
+		HTTP  struct {
+			Host string `flag:"host h" desc:"HTTP host"`
+			Port int    `desc:"HTTP port"`
+		} `group:"HTTP options" desc:"HTTP server settings"`
+	}{}
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	err := ParseTo(cfg, fs)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"HTTP options", "HTTP server settings"},
+		fs.Lookup("http-port").Annotations[GroupAnnotation])
+	assert.Equal(t, "      --debug   debug mode\n"+
+		"\n"+
+		"HTTP options:\n"+
+		"  HTTP server settings\n"+
+		"  -h, --http-host string   HTTP host\n"+
+		"      --http-port int      HTTP port\n",
+		GroupedUsages(fs))
+
+	cmd := &cobra.Command{Use: "test"}
+	buf := &bytes.Buffer{}
+	cmd.SetOutput(buf)
+	err = ParseTo(cfg, cmd.Flags())
+	require.NoError(t, err)
+	SetGroupedUsage(cmd)
+	err = cmd.Usage()
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "\nHTTP options:\n  HTTP server settings\n")
+}
@@ -0,0 +1,224 @@
+package sflags
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LoadFunc loads values of parsed flags from some source, e.g. config file.
+// It receives the same options, that were passed to ParseStruct.
+type LoadFunc func(flags []*Flag, optFuncs ...OptFunc) error
+
+// SetFromMap sets values of flags from a (possibly nested) map,
+// that is usually decoded from JSON, YAML or TOML document.
+// Nested keys are joined the same way as nested structures,
+// e.g. {"http": {"host": "localhost"}} sets value of "http-host" flag.
+// Keys are converted to flag-case, so "readTimeout" and "ReadTimeout" match "read-timeout".
+// Lists set every element separately for repeatable flags and joined by comma for others.
+// Maps set key:val pairs for map flags. Merge modes of slices and maps are applied to the whole list or map,
+// empty lists and maps clear them. Unknown keys are ignored.
+// Lists of objects set elements of slices of structures,
+// e.g. {"backends": [{"host": "localhost"}]} sets value of "backends-0-host" flag.
+// Loaders of ParseStruct add elements to slices and maps of structures for lists and maps of objects.
+// Invalid values are reported as FlagError, all of them are reported in CollectErrors mode.
+func SetFromMap(flags []*Flag, data map[string]interface{}, optFuncs ...OptFunc) error {
+	opt := defOpts().apply(optFuncs...)
+	if opt.discovered != nil {
+		*opt.discovered = append(*opt.discovered, mapNames(opt.prefix, data, opt)...)
+		return nil
+	}
+	byName := make(map[string]*Flag, len(flags))
+	for _, flag := range flags {
+		byName[flag.Name] = flag
+	}
+	errs := &collector{collect: opt.collectErrs}
+	setFromMap(byName, opt.prefix, data, opt, errs)
+	return errs.err()
+}
+
+// setFromMap returns true if processing should be stopped because of an error.
+func setFromMap(flags map[string]*Flag, prefix string, data map[string]interface{}, opt opts, errs *collector) bool {
+	for _, key := range sortedKeys(data) {
+		name := prefix + camelToFlag(key, opt.flagDivider)
+		val := data[key]
+		if flag, found := flags[name]; found {
+			if err := setFromInterface(flag, val); err != nil {
+				if errs.add(&FlagError{Flag: flag, Value: rawValue(val), Source: SourceFile, Err: err}) {
+					return true
+				}
+			}
+			continue
+		}
+		if nested, casted := toStringMap(val); casted {
+			if setFromMap(flags, name+opt.flagDivider, nested, opt, errs) {
+				return true
+			}
+			continue
+		}
+		// lists of objects set elements of slices of structures, e.g. "backends-0-host"
+		if list, casted := val.([]interface{}); casted {
+			for i, item := range list {
+				nested, casted := toStringMap(item)
+				if !casted {
+					continue
+				}
+				if setFromMap(flags, name+opt.flagDivider+strconv.Itoa(i)+opt.flagDivider, nested, opt, errs) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// mapNames returns names of flags, that are set by values of data,
+// including elements of lists of objects, e.g. "backends-0-host".
+func mapNames(prefix string, data map[string]interface{}, opt opts) []string {
+	names := []string{}
+	for _, key := range sortedKeys(data) {
+		name := prefix + camelToFlag(key, opt.flagDivider)
+		if nested, casted := toStringMap(data[key]); casted {
+			names = append(names, mapNames(name+opt.flagDivider, nested, opt)...)
+			continue
+		}
+		if list, casted := data[key].([]interface{}); casted {
+			for i, item := range list {
+				if nested, casted := toStringMap(item); casted {
+					names = append(names, mapNames(name+opt.flagDivider+strconv.Itoa(i)+opt.flagDivider, nested, opt)...)
+				}
+			}
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// rawValue returns val as it's passed to flags, or as it's printed by fmt if it can't be passed.
+func rawValue(val interface{}) string {
+	if s, err := stringify(val); err == nil {
+		return s
+	}
+	return fmt.Sprint(val)
+}
+
+func setFromInterface(flag *Flag, val interface{}) error {
+	if val == nil {
+		return nil
+	}
+	if m, casted := toStringMap(val); casted {
+		sep := mapSeparatorOf(flag.Value)
+		elemSep := defaultSliceSeparator
+		if mapVal, casted := unwrapValue(flag.Value).(*elemMapValue); casted {
+			elemSep = mapVal.elemSliceSeparator()
+		}
+		vals := make([]string, 0, len(m))
+		for _, key := range sortedKeys(m) {
+			elem, err := stringifyElem(m[key], elemSep)
+			if err != nil {
+				return err
+			}
+			vals = append(vals, escapeMapKey(key, sep)+sep+elem)
+		}
+		return flag.setAllFrom(SourceFile, vals)
+	}
+	if list, casted := val.([]interface{}); casted {
+		elems := make([]string, 0, len(list))
+		for _, item := range list {
+			elem, err := stringify(item)
+			if err != nil {
+				return err
+			}
+			elems = append(elems, elem)
+		}
+		if repeatable, casted := flag.Value.(RepeatableFlag); casted && repeatable.IsCumulative() {
+			// elements of slices are quoted, so they aren't split by separator
+			if sep, isSlice := sliceSeparatorOf(flag.Value); isSlice {
+				for i, elem := range elems {
+					elems[i] = quoteSliceElem(elem, sep)
+				}
+			}
+			return flag.setAllFrom(SourceFile, elems)
+		}
+		return flag.SetFrom(SourceFile, strings.Join(elems, ","))
+	}
+	s, err := stringify(val)
+	if err != nil {
+		return err
+	}
+	return flag.SetFrom(SourceFile, s)
+}
+
+// stringifyElem converts an element of map, lists are joined by sep for maps of slices.
+func stringifyElem(val interface{}, sep string) (string, error) {
+	list, casted := val.([]interface{})
+	if !casted {
+		return stringify(val)
+	}
+	elems := make([]string, 0, len(list))
+	for _, item := range list {
+		elem, err := stringify(item)
+		if err != nil {
+			return "", err
+		}
+		elems = append(elems, elem)
+	}
+	if sep == SeparatorNone {
+		if len(elems) > 1 {
+			return "", fmt.Errorf("list of %d elements can't be set to a map of slices, that aren't split", len(elems))
+		}
+		return strings.Join(elems, ""), nil
+	}
+	return joinSlice(elems, sep), nil
+}
+
+func stringify(val interface{}) (string, error) {
+	switch v := val.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	case fmt.Stringer:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", val)
+	}
+}
+
+// toStringMap converts map[string]interface{} and map[interface{}]interface{},
+// that are produced by different decoders, to map[string]interface{}.
+func toStringMap(val interface{}) (map[string]interface{}, bool) {
+	switch m := val.(type) {
+	case map[string]interface{}:
+		return m, true
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			out[fmt.Sprint(k)] = v
+		}
+		return out, true
+	default:
+		return nil, false
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
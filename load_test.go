@@ -0,0 +1,110 @@
+package sflags
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetFromMap(t *testing.T) {
+	cfg := &struct {
+		Name string
+		HTTP struct {
+			Host        string
+			Port        int
+			ReadTimeout time.Duration
+		}
+		Hosts  []string
+		Ports  []int
+		IP     []string `flag:"~ip"`
+		Labels map[string]string
+		Debug  bool
+	}{
+		Name:  "default",
+		Hosts: []string{"localhost"},
+	}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+
+	err = SetFromMap(flags, map[string]interface{}{
+		"name": "from_map",
+		"http": map[string]interface{}{
+			"host":        "127.0.0.1",
+			"port":        8080,
+			"readTimeout": "10s",
+		},
+		"Hosts":   []interface{}{"one", "two"},
+		"ports":   []interface{}{int64(1), 2.0},
+		"ip":      []interface{}{"127.0.0.1"},
+		"labels":  map[interface{}]interface{}{"env": "prod"},
+		"debug":   true,
+		"unknown": "skipped",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "from_map", cfg.Name)
+	assert.Equal(t, "127.0.0.1", cfg.HTTP.Host)
+	assert.Equal(t, 8080, cfg.HTTP.Port)
+	assert.Equal(t, 10*time.Second, cfg.HTTP.ReadTimeout)
+	assert.Equal(t, []string{"one", "two"}, cfg.Hosts)
+	assert.Equal(t, []int{1, 2}, cfg.Ports)
+	assert.Equal(t, []string{"127.0.0.1"}, cfg.IP)
+	assert.Equal(t, map[string]string{"env": "prod"}, cfg.Labels)
+	assert.Equal(t, true, cfg.Debug)
+}
+
+func TestSetFromMap_Prefix(t *testing.T) {
+	cfg := &struct {
+		Name string
+	}{}
+	optFuncs := []OptFunc{Prefix("app-")}
+	flags, err := ParseStruct(cfg, optFuncs...)
+	require.NoError(t, err)
+
+	err = SetFromMap(flags, map[string]interface{}{"name": "value"}, optFuncs...)
+	require.NoError(t, err)
+	assert.Equal(t, "value", cfg.Name)
+}
+
+func TestSetFromMap_Error(t *testing.T) {
+	cfg := &struct {
+		Port int
+		Name string
+	}{}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+
+	err = SetFromMap(flags, map[string]interface{}{"port": "bad"})
+	assert.EqualError(t, err,
+		`invalid value "bad" for flag port: strconv.ParseInt: parsing "bad": invalid syntax`)
+
+	err = SetFromMap(flags, map[string]interface{}{"name": struct{}{}})
+	assert.EqualError(t, err, `invalid value "{}" for flag name: unsupported value type struct {}`)
+}
+
+func TestParseStruct_Loader(t *testing.T) {
+	cfg := &struct {
+		Name  string
+		Name2 string
+	}{}
+	defer os.Unsetenv("SFLAGS_TEST_NAME2")
+	os.Setenv("SFLAGS_TEST_NAME2", "from_env")
+
+	loader := func(flags []*Flag, optFuncs ...OptFunc) error {
+		return SetFromMap(flags, map[string]interface{}{
+			"name":  "from_loader",
+			"name2": "from_loader",
+		}, optFuncs...)
+	}
+	_, err := ParseStruct(cfg, EnvPrefix("SFLAGS_TEST_"), Loader(loader), FromEnv(true))
+	require.NoError(t, err)
+	assert.Equal(t, "from_loader", cfg.Name)
+	assert.Equal(t, "from_env", cfg.Name2)
+
+	testErr := errors.New("loader error")
+	_, err = ParseStruct(cfg, Loader(func([]*Flag, ...OptFunc) error { return testErr }))
+	assert.Equal(t, testErr, err)
+}
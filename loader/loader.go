@@ -0,0 +1,111 @@
+// Package loader loads values of flags from JSON, YAML and TOML config files.
+//
+// Config file values are applied through Value.Set,
+// so they are parsed exactly the same way as command line arguments.
+// Precedence is: defaults < config file < environment < command line.
+package loader
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/octago/sflags"
+	"gopkg.in/yaml.v3"
+)
+
+// Format describes config file format.
+type Format string
+
+// Supported config file formats.
+const (
+	JSON Format = "json"
+	YAML Format = "yaml"
+	TOML Format = "toml"
+)
+
+// FormatFromPath returns format based on file extension.
+func FormatFromPath(path string) (Format, error) {
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		return JSON, nil
+	case ".yaml", ".yml":
+		return YAML, nil
+	case ".toml":
+		return TOML, nil
+	default:
+		return "", fmt.Errorf("unknown config file format %q", ext)
+	}
+}
+
+// Load decodes document in format from r and sets values of flags.
+// optFuncs should be the same as ones passed to sflags.ParseStruct.
+func Load(flags []*sflags.Flag, r io.Reader, format Format, optFuncs ...sflags.OptFunc) error {
+	data := make(map[string]interface{})
+	var err error
+	switch format {
+	case JSON:
+		decoder := json.NewDecoder(r)
+		decoder.UseNumber()
+		err = decoder.Decode(&data)
+	case YAML:
+		err = yaml.NewDecoder(r).Decode(&data)
+		if err == io.EOF {
+			// empty document
+			err = nil
+		}
+	case TOML:
+		_, err = toml.NewDecoder(r).Decode(&data)
+	default:
+		return fmt.Errorf("unknown config file format %q", format)
+	}
+	if err != nil {
+		return err
+	}
+	return sflags.SetFromMap(flags, data, optFuncs...)
+}
+
+// LoadFile reads config file from path and sets values of flags.
+// Format is detected by file extension.
+func LoadFile(flags []*sflags.Flag, path string, optFuncs ...sflags.OptFunc) error {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	err = Load(flags, f, format, optFuncs...)
+	if errs, casted := err.(sflags.Errors); casted {
+		// every error is prefixed, so the list can be flattened
+		wrapped := make(sflags.Errors, 0, len(errs))
+		for _, err := range errs {
+			wrapped = append(wrapped, fmt.Errorf("%s: %w", path, err))
+		}
+		return wrapped
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// File returns an option for sflags.ParseStruct (and all generators),
+// that loads values from config file at path.
+// If optional is true, missing file is not an error.
+func File(path string, optional bool) sflags.OptFunc {
+	return sflags.Loader(func(flags []*sflags.Flag, optFuncs ...sflags.OptFunc) error {
+		err := LoadFile(flags, path, optFuncs...)
+		if optional && errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	})
+}
@@ -0,0 +1,223 @@
+package loader
+
+import (
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/octago/sflags"
+	"github.com/octago/sflags/gen/gflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type httpConfig struct {
+	Host    string
+	Port    int
+	Timeout time.Duration
+	Addr    net.TCPAddr
+}
+
+type config struct {
+	HTTP   httpConfig
+	Tags   []string
+	Labels map[string]int
+	Hex    sflags.HexBytes
+	Debug  bool
+}
+
+const (
+	jsonDoc = `{
+	"http": {"host": "json-host", "port": 8080, "timeout": "5s", "addr": "127.0.0.1:9000"},
+	"tags": ["one", "two"],
+	"labels": {"a": 1},
+	"hex": "0aff",
+	"debug": true
+}`
+	yamlDoc = `
+http:
+  host: yaml-host
+  port: 8080
+  timeout: 5s
+  addr: 127.0.0.1:9000
+tags: [one, two]
+labels:
+  a: 1
+hex: 0aff
+debug: true
+`
+	tomlDoc = `
+tags = ["one", "two"]
+hex = "0aff"
+debug = true
+
+[http]
+host = "toml-host"
+port = 8080
+timeout = "5s"
+addr = "127.0.0.1:9000"
+
+[labels]
+a = 1
+`
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		format  Format
+		doc     string
+		expHost string
+	}{
+		{JSON, jsonDoc, "json-host"},
+		{YAML, yamlDoc, "yaml-host"},
+		{TOML, tomlDoc, "toml-host"},
+	}
+	for _, test := range tests {
+		t.Run(string(test.format), func(t *testing.T) {
+			cfg := &config{Tags: []string{"default"}}
+			flags, err := sflags.ParseStruct(cfg)
+			require.NoError(t, err)
+
+			err = Load(flags, strings.NewReader(test.doc), test.format)
+			require.NoError(t, err)
+			assert.Equal(t, &config{
+				HTTP: httpConfig{
+					Host:    test.expHost,
+					Port:    8080,
+					Timeout: 5 * time.Second,
+					Addr:    net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 9000},
+				},
+				Tags:   []string{"one", "two"},
+				Labels: map[string]int{"a": 1},
+				Hex:    sflags.HexBytes{0x0a, 0xff},
+				Debug:  true,
+			}, cfg)
+		})
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	cfg := &config{}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+
+	err = Load(flags, strings.NewReader(`{"http": {"port": "bad"}}`), JSON)
+	assert.EqualError(t, err,
+		`invalid value "bad" for flag http-port: strconv.ParseInt: parsing "bad": invalid syntax`)
+
+	err = Load(flags, strings.NewReader(`{`), JSON)
+	assert.Error(t, err)
+
+	err = Load(flags, strings.NewReader(``), "xml")
+	assert.EqualError(t, err, `unknown config file format "xml"`)
+
+	err = Load(flags, strings.NewReader(``), YAML)
+	assert.NoError(t, err)
+}
+
+func TestFormatFromPath(t *testing.T) {
+	for path, exp := range map[string]Format{
+		"config.json": JSON,
+		"config.yaml": YAML,
+		"config.YML":  YAML,
+		"config.toml": TOML,
+	} {
+		format, err := FormatFromPath(path)
+		assert.NoError(t, err)
+		assert.Equal(t, exp, format)
+	}
+	_, err := FormatFromPath("config.ini")
+	assert.EqualError(t, err, `unknown config file format ".ini"`)
+}
+
+func TestFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sflags")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.yaml")
+	err = ioutil.WriteFile(path, []byte(yamlDoc), 0600)
+	require.NoError(t, err)
+
+	defer os.Unsetenv("HTTP_PORT")
+	os.Setenv("HTTP_PORT", "9090")
+
+	cfg := &config{}
+	fs, err := gflag.Parse(cfg, File(path, false), sflags.FromEnv(true))
+	require.NoError(t, err)
+	assert.Equal(t, "yaml-host", cfg.HTTP.Host)
+	assert.Equal(t, 9090, cfg.HTTP.Port)
+
+	err = fs.Parse([]string{"-http-host", "cli-host"})
+	require.NoError(t, err)
+	assert.Equal(t, "cli-host", cfg.HTTP.Host)
+
+	_, err = gflag.Parse(&config{}, File(filepath.Join(dir, "missing.yaml"), false))
+	assert.Error(t, err)
+	_, err = gflag.Parse(&config{}, File(filepath.Join(dir, "missing.yaml"), true))
+	assert.NoError(t, err)
+}
+
+func TestFile_Errors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sflags")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.json")
+	err = ioutil.WriteFile(path, []byte(`{"http": {"host": "host", "port": "bad", "timeout": "bad"}}`), 0600)
+	require.NoError(t, err)
+
+	_, err = sflags.ParseStruct(&config{}, File(path, false))
+	var flagErr *sflags.FlagError
+	require.True(t, errors.As(err, &flagErr))
+	assert.Equal(t, "http-port", flagErr.Flag.Name)
+	assert.Equal(t, sflags.SourceFile, flagErr.Source)
+
+	_, err = sflags.ParseStruct(&config{}, File(path, false), sflags.CollectErrors(true))
+	var errs sflags.Errors
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs, 2)
+	assert.True(t, strings.HasPrefix(errs[0].Error(), path+": "))
+	require.True(t, errors.As(errs[1], &flagErr))
+	assert.Equal(t, "http-timeout", flagErr.Flag.Name)
+}
+
+func TestFile_StructElems(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sflags")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.yaml")
+	err = ioutil.WriteFile(path, []byte(`
+backends:
+  - host: first
+  - host: second
+    port: 8081
+upstreams:
+  eu:
+    host: eu-host
+  usWest:
+    port: 9090
+`), 0600)
+	require.NoError(t, err)
+
+	cfg := &struct {
+		Backends  []httpConfig
+		Upstreams map[string]*httpConfig
+	}{
+		Backends: []httpConfig{{Host: "default", Port: 8080}},
+	}
+	fs, err := gflag.Parse(cfg, File(path, false), sflags.CommandLine([]string{}))
+	require.NoError(t, err)
+	assert.Equal(t, []httpConfig{{Host: "first", Port: 8080}, {Host: "second", Port: 8081}}, cfg.Backends)
+	require.Len(t, cfg.Upstreams, 2)
+	assert.Equal(t, httpConfig{Host: "eu-host"}, *cfg.Upstreams["eu"])
+	assert.Equal(t, httpConfig{Port: 9090}, *cfg.Upstreams["us-west"])
+
+	err = fs.Parse([]string{"-backends-1-host", "cli-host", "-upstreams-eu-port", "7070"})
+	require.NoError(t, err)
+	assert.Equal(t, "cli-host", cfg.Backends[1].Host)
+	assert.Equal(t, 7070, cfg.Upstreams["eu"].Port)
+}
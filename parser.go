@@ -1,7 +1,8 @@
 package sflags
 
 import (
-	"errors"
+	"fmt"
+	"os"
 	"reflect"
 	"strings"
 )
@@ -10,6 +11,16 @@ const (
 	defaultDescTag     = "desc"
 	defaultFlagTag     = "flag"
 	defaultEnvTag      = "env"
+	defaultArgTag      = "arg"
+	defaultCmdTag      = "cmd"
+	defaultDefaultTag  = "default"
+	defaultChoicesTag  = "choices"
+	defaultCompleteTag = "complete"
+	defaultGroupTag    = "group"
+	defaultMapSepTag   = "mapsep"
+	defaultSepTag      = "sep"
+	defaultMergeTag    = "merge"
+	defaultLayoutTag   = "layout"
 	defaultFlagDivider = "-"
 	defaultEnvDivider  = "_"
 	defaultFlatten     = true
@@ -21,14 +32,31 @@ const (
 // Should return error if validation fails.
 type ValidateFunc func(val string, field reflect.StructField, cfg interface{}) error
 
+// SkipFunc is called for fields, that are skipped because of unsupported type,
+// path is the path of the field in the structure, e.g. "HTTP.Headers".
+type SkipFunc func(path string, typ reflect.Type)
+
 type opts struct {
 	descTag     string
 	flagTag     string
 	prefix      string
+	path        string // path of the parent structure field
+	group       *Group // group of the parent structure
 	envPrefix   string
 	flagDivider string
 	envDivider  string
 	flatten     bool
+	fromEnv     bool
+	collectErrs bool
+	strict      bool
+	onSkip      SkipFunc
+	cmdLine     []string
+	mapSep      string
+	sliceSep    string
+	timeLayout  string
+	loaders     []LoadFunc
+	discovered  *[]string // SetFromMap collects names of flags to it instead of setting them
+	loaded      []string  // names of flags, that are set by loaders
 	validator   ValidateFunc
 }
 
@@ -69,8 +97,63 @@ func Validator(val ValidateFunc) OptFunc { return func(opt *opts) { opt.validato
 // Set to false if you don't want anonymous structure fields to be flatten.
 func Flatten(val bool) OptFunc { return func(opt *opts) { opt.flatten = val } }
 
+// FromEnv sets values of parsed flags from environment variables.
+// Set to true if your flag library doesn't read environment variables itself (e.g. flag or pflag).
+func FromEnv(val bool) OptFunc { return func(opt *opts) { opt.fromEnv = val } }
+
+// Strict sets strict mode.
+// Set to true to get an error for fields of unsupported types instead of skipping them.
+func Strict(val bool) OptFunc { return func(opt *opts) { opt.strict = val } }
+
+// OnSkip sets function, that is called for every field skipped because of unsupported type.
+// It isn't called in strict mode.
+func OnSkip(val SkipFunc) OptFunc { return func(opt *opts) { opt.onSkip = val } }
+
+// CollectErrors sets multi-error mode.
+// Set to true to get all invalid values from environment and loaders
+// as Errors instead of the first one.
+func CollectErrors(val bool) OptFunc { return func(opt *opts) { opt.collectErrs = val } }
+
+// Loader adds a function that loads values of parsed flags from some source, e.g. config file.
+// Loaders are called in order they were added and before environment variables are applied,
+// so precedence is: loaders < environment < command line.
+// Loaders are also called without flags before parsing, values passed to SetFromMap by this call
+// aren't set, but they add elements of slices and maps of structures.
+func Loader(val LoadFunc) OptFunc {
+	return func(opt *opts) { opt.loaders = append(opt.loaders, val) }
+}
+
+// CommandLine sets command line arguments, that are scanned for indexes and keys
+// of slices and maps of structures, e.g. "--backends-1-host". It is os.Args[1:] by default.
+func CommandLine(val []string) OptFunc { return func(opt *opts) { opt.cmdLine = val } }
+
+func (o opts) commandLine() []string {
+	if o.cmdLine == nil && len(os.Args) > 1 {
+		return os.Args[1:]
+	}
+	return o.cmdLine
+}
+
+// MapSeparator sets custom separator of key and value for map flags. It is colon by default,
+// e.g. "key:val". Separator can be set for a single field by mapsep tag.
+func MapSeparator(val string) OptFunc { return func(opt *opts) { opt.mapSep = val } }
+
+// SliceSeparator sets custom separator of elements for slice flags. It is comma by default,
+// e.g. "a,b". Use SeparatorNone to set a single element by every value.
+// Separator can be set for a single field by sep tag, it's used for values of maps of slices too.
+func SliceSeparator(val string) OptFunc { return func(opt *opts) { opt.sliceSep = val } }
+
+// TimeLayout sets layout of time.Time flags, that is used to parse and print them.
+// By default RFC 3339 and "2006-01-02" layouts are accepted and RFC 3339 is printed.
+// Layout can be set for a single field by layout tag.
+func TimeLayout(val string) OptFunc { return func(opt *opts) { opt.timeLayout = val } }
+
 func copyOpts(val opts) OptFunc { return func(opt *opts) { *opt = val } }
 
+func fieldPath(val string) OptFunc { return func(opt *opts) { opt.path = val } }
+
+func flagGroup(val *Group) OptFunc { return func(opt *opts) { opt.group = val } }
+
 func hasOption(options []string, option string) bool {
 	for _, opt := range options {
 		if opt == option {
@@ -114,6 +197,8 @@ func parseFlagTag(field reflect.StructField, opt opts) *Flag {
 		}
 		flag.Hidden = hasOption(flagTags[1:], "hidden")
 		flag.Deprecated = hasOption(flagTags[1:], "deprecated")
+		flag.Required = hasOption(flagTags[1:], "required")
+		flag.Negatable = hasOption(flagTags[1:], "negatable")
 
 	}
 
@@ -159,36 +244,117 @@ func parseEnv(flagName string, field reflect.StructField, opt opts) string {
 // ParseStruct parses structure and returns list of flags based on this structure.
 // This list of flags can be used by generators for flag, kingpin, cobra, pflag, urfave/cli.
 func ParseStruct(cfg interface{}, optFuncs ...OptFunc) ([]*Flag, error) {
+	e, err := structValue(cfg)
+	if err != nil {
+		return nil, err
+	}
+	opt := defOpts().apply(optFuncs...)
+	flags, err := parseStruct(e, append(append([]OptFunc{}, optFuncs...), loadedNames(opt, optFuncs))...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDuplicates(flags); err != nil {
+		return nil, err
+	}
+	errs := &collector{collect: opt.collectErrs}
+	for _, loader := range opt.loaders {
+		if errs.add(loader(flags, optFuncs...)) {
+			return nil, errs.err()
+		}
+	}
+	if opt.fromEnv {
+		errs.add(SetFromEnv(flags, optFuncs...))
+	}
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	return flags, nil
+}
+
+// loadedNames calls loaders without flags before parsing, SetFromMap collects names of flags,
+// that would be set, e.g. "backends-1-host", to discover elements of slices and maps of structures.
+// Errors are ignored, they are returned when loaders are called with flags.
+func loadedNames(opt opts, optFuncs []OptFunc) OptFunc {
+	var names []string
+	discover := func(opt *opts) { opt.discovered = &names }
+	for _, loader := range opt.loaders {
+		_ = loader(nil, append(append([]OptFunc{}, optFuncs...), discover)...)
+	}
+	return func(opt *opts) { opt.loaded = names }
+}
+
+// checkDuplicates returns an error if two flags have the same name, short name or env name.
+func checkDuplicates(flags []*Flag) error {
+	names := map[string]*Flag{}
+	shorts := map[string]*Flag{}
+	envs := map[string]*Flag{}
+	for _, flag := range flags {
+		for _, name := range []string{flag.Name, negationPrefix + flag.Name} {
+			if name != flag.Name && !flag.Negatable {
+				continue
+			}
+			if existed, found := names[name]; found {
+				return fmt.Errorf("duplicate flag name %q in fields %s and %s", name, existed.Path, flag.Path)
+			}
+			names[name] = flag
+		}
+		if flag.Short != "" {
+			if existed, found := shorts[flag.Short]; found {
+				return fmt.Errorf("duplicate short flag name %q in fields %s and %s", flag.Short, existed.Path, flag.Path)
+			}
+			shorts[flag.Short] = flag
+		}
+		if flag.EnvName != "" {
+			if existed, found := envs[flag.EnvName]; found {
+				return fmt.Errorf("duplicate env name %q in fields %s and %s", flag.EnvName, existed.Path, flag.Path)
+			}
+			envs[flag.EnvName] = flag
+		}
+	}
+	return nil
+}
+
+// structValue checks that cfg is a non nil pointer to structure
+// and returns this structure.
+func structValue(cfg interface{}) (reflect.Value, error) {
 	// what we want is Ptr to Structure
 	if cfg == nil {
-		return nil, errors.New("object cannot be nil")
+		return reflect.Value{}, ErrNilObject
 	}
 	v := reflect.ValueOf(cfg)
 	if v.Kind() != reflect.Ptr {
-		return nil, errors.New("object must be a pointer to struct or interface")
+		return reflect.Value{}, ErrNotPointerToStruct
 	}
 	if v.IsNil() {
-		return nil, errors.New("object cannot be nil")
+		return reflect.Value{}, ErrNilObject
 	}
 	switch e := v.Elem(); e.Kind() {
 	case reflect.Struct:
-		return parseStruct(e, optFuncs...), nil
+		return e, nil
 	default:
-		return nil, errors.New("object must be a pointer to struct or interface")
+		return reflect.Value{}, ErrNotPointerToStruct
 	}
 }
 
-func parseVal(value reflect.Value, optFuncs ...OptFunc) ([]*Flag, Value) {
+func parseVal(value reflect.Value, optFuncs ...OptFunc) ([]*Flag, Value, error) {
 	// value is addressable, let's check if we can parse it
 	if value.CanAddr() && value.Addr().CanInterface() {
+		// registered types take precedence over generated ones
+		if val := parseRegistered(value); val != nil {
+			return nil, val, nil
+		}
 		valueInterface := value.Addr().Interface()
 		val := parseGenerated(valueInterface)
 		if val != nil {
-			return nil, val
+			return nil, val, nil
 		}
 		// check if field implements Value interface
 		if val, casted := valueInterface.(Value); casted {
-			return nil, val
+			return nil, val, nil
+		}
+		// check if field implements encoding.TextUnmarshaler interface
+		if newElem := newTextElemFunc(value.Type()); newElem != nil {
+			return nil, newElem(value.Addr()), nil
 		}
 	}
 
@@ -197,14 +363,27 @@ func parseVal(value reflect.Value, optFuncs ...OptFunc) ([]*Flag, Value) {
 		if value.IsNil() {
 			value.Set(reflect.New(value.Type().Elem()))
 		}
-		val := parseGeneratedPtrs(value.Addr().Interface())
-		if val != nil {
-			return nil, val
+		if lookupType(value.Type().Elem()) == nil {
+			val := parseGeneratedPtrs(value.Addr().Interface())
+			if val != nil {
+				return nil, val, nil
+			}
 		}
 		return parseVal(value.Elem(), optFuncs...)
 	case reflect.Struct:
-		flags := parseStruct(value, optFuncs...)
-		return flags, nil
+		flags, err := parseStruct(value, optFuncs...)
+		return flags, nil, err
+	case reflect.Slice:
+		if !value.CanAddr() {
+			break
+		}
+		if newElem := newTextElemFunc(value.Type().Elem()); newElem != nil {
+			return nil, newElemSliceValue(value, newElem), nil
+		}
+		if isStructElems(value.Type()) {
+			flags, err := parseStructSlice(value, defOpts().apply(optFuncs...))
+			return flags, nil, err
+		}
 	case reflect.Map:
 		mapType := value.Type()
 		keyKind := value.Type().Key().Kind()
@@ -221,13 +400,36 @@ func parseVal(value reflect.Value, optFuncs ...OptFunc) ([]*Flag, Value) {
 		valueInterface := value.Addr().Interface()
 		val := parseGeneratedMap(valueInterface)
 		if val != nil {
-			return nil, val
+			return nil, val, nil
+		}
+		if newElem := newTextElemFunc(mapType.Elem()); newElem != nil {
+			return nil, newElemMapValue(value, newElem), nil
+		}
+		if newElem := newSliceElemFunc(mapType.Elem()); newElem != nil {
+			return nil, newElemMapValue(value, newElem), nil
+		}
+		if isStructElems(mapType) {
+			flags, err := parseStructMap(value, defOpts().apply(optFuncs...))
+			return flags, nil, err
 		}
 	}
-	return nil, nil
+	return nil, nil, nil
 }
 
-func parseStruct(value reflect.Value, optFuncs ...OptFunc) []*Flag {
+// setDefault sets value from default tag, if the field has zero value.
+// Value is set through Set, so it's parsed and validated as a value from command line.
+func setDefault(field reflect.StructField, isZero bool, val Value) error {
+	defValue, hasDefault := field.Tag.Lookup(defaultDefaultTag)
+	if !hasDefault || !isZero {
+		return nil
+	}
+	if err := val.Set(defValue); err != nil {
+		return fmt.Errorf("invalid default value %q for field %s: %v", defValue, field.Name, err)
+	}
+	return nil
+}
+
+func parseStruct(value reflect.Value, optFuncs ...OptFunc) ([]*Flag, error) {
 	opt := defOpts().apply(optFuncs...)
 
 	flags := []*Flag{}
@@ -241,26 +443,82 @@ fields:
 		if field.PkgPath != "" && !field.Anonymous {
 			continue fields
 		}
+		// positional arguments are parsed by ParseArgs
+		if _, isArg := field.Tag.Lookup(defaultArgTag); isArg {
+			continue fields
+		}
+		// subcommands are parsed by ParseCommand
+		if _, isCmd := field.Tag.Lookup(defaultCmdTag); isCmd {
+			continue fields
+		}
 
 		flag := parseFlagTag(field, opt)
 		if flag == nil {
 			continue fields
 		}
 
+		flag.Path = field.Name
+		if opt.path != "" {
+			flag.Path = opt.path + "." + field.Name
+		}
 		flag.EnvName = parseEnv(flag.Name, field, opt)
 		flag.Usage = field.Tag.Get(opt.descTag)
+		flag.Group = opt.group
 		prefix := flag.Name + opt.flagDivider
+		// flags of the nested structure are in its own group
+		group := &Group{
+			Path:        flag.Path,
+			Title:       field.Tag.Get(defaultGroupTag),
+			Description: flag.Usage,
+		}
+		if group.Title == "" {
+			group.Title = group.Path
+		}
 		if field.Anonymous && opt.flatten {
 			prefix = opt.prefix
+			group = opt.group
 		}
 
-		nestedFlags, val := parseVal(fieldValue,
+		isZero := fieldValue.IsZero()
+		nestedFlags, val, err := parseVal(fieldValue,
 			copyOpts(opt),
 			Prefix(prefix),
+			fieldPath(flag.Path),
+			flagGroup(group),
 		)
+		if err != nil {
+			return nil, err
+		}
 
 		// field contains a simple value.
 		if val != nil {
+			if flag.Negatable && !isBoolType(field.Type) {
+				return nil, fmt.Errorf("field %s with negatable option should be bool", flag.Path)
+			}
+			if mapVal, casted := val.(mapSeparated); casted {
+				sep := opt.mapSep
+				if tagSep := field.Tag.Get(defaultMapSepTag); tagSep != "" {
+					sep = tagSep
+				}
+				mapVal.setMapSeparator(sep)
+			}
+			sliceSep := opt.sliceSep
+			if tagSep := field.Tag.Get(defaultSepTag); tagSep != "" {
+				sliceSep = tagSep
+			}
+			if layoutVal, casted := val.(layouted); casted {
+				layout := opt.timeLayout
+				if tagLayout := field.Tag.Get(defaultLayoutTag); tagLayout != "" {
+					layout = tagLayout
+				}
+				layoutVal.setLayout(layout)
+			}
+			switch casted := val.(type) {
+			case sliceSeparated:
+				casted.setSliceSeparator(sliceSep)
+			case *elemMapValue:
+				casted.setElemSliceSeparator(sliceSep)
+			}
 			if opt.validator != nil {
 				val = &validateValue{
 					Value: val,
@@ -269,7 +527,24 @@ fields:
 					},
 				}
 			}
-			flag.Value = val
+			if choices := field.Tag.Get(defaultChoicesTag); choices != "" {
+				flag.Choices = strings.Split(choices, ",")
+				val = newChoicesValue(val, flag.Choices)
+			}
+			if complete := field.Tag.Get(defaultCompleteTag); complete != "" {
+				if complete != "file" && complete != "dir" {
+					return nil, fmt.Errorf("field %s has invalid complete tag %q, it should be file or dir", flag.Path, complete)
+				}
+				flag.Complete = complete
+			}
+			if err := setDefault(field, isZero, val); err != nil {
+				return nil, err
+			}
+			merge, err := parseMergeTag(field, val)
+			if err != nil {
+				return nil, err
+			}
+			flag.Value = newSourceValue(val, merge)
 			flag.DefValue = val.String()
 			flags = append(flags, flag)
 			continue fields
@@ -279,9 +554,53 @@ fields:
 			flags = append(flags, nestedFlags...)
 			continue fields
 		}
+		if isStructType(field.Type) || isStructElems(field.Type) {
+			continue fields
+		}
+		// field has unsupported type
+		if opt.strict {
+			return nil, fmt.Errorf("field %s has unsupported type %s", flag.Path, field.Type)
+		}
+		if opt.onSkip != nil {
+			opt.onSkip(flag.Path, field.Type)
+		}
+	}
+	return flags, nil
+}
+
+// parseMergeTag returns merge mode of slice and map values from merge tag,
+// it's empty if the tag isn't set, so default mode of the value is used.
+func parseMergeTag(field reflect.StructField, val Value) (string, error) {
+	coll, casted := unwrapValue(val).(collection)
+	if !casted {
+		return "", nil
+	}
+	merge := field.Tag.Get(defaultMergeTag)
+	switch merge {
+	case mergeAppend:
+		coll.appendNext()
+	case "", mergeReplace, mergeReset:
+	default:
+		return "", fmt.Errorf("invalid merge tag %q for field %s, allowed values: %s, %s, %s",
+			merge, field.Name, mergeAppend, mergeReplace, mergeReset)
+	}
+	return merge, nil
+}
+
+// isBoolType returns true for bool and pointers to it.
+func isBoolType(typ reflect.Type) bool {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ.Kind() == reflect.Bool
+}
 
+// isStructType returns true for structures and pointers to them.
+func isStructType(typ reflect.Type) bool {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
 	}
-	return flags
+	return typ.Kind() == reflect.Struct
 }
 
 func anyOf(kinds []reflect.Kind, needle reflect.Kind) bool {
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -121,43 +122,49 @@ func TestParseStruct(t *testing.T) {
 			expFlagSet: []*Flag{
 				{
 					Name:     "name",
+					Path:     "Name",
 					EnvName:  "",
 					DefValue: "name_value",
-					Value:    newStringValue(&simpleCfg.Name),
+					Value:    &sourceValue{Value: newStringValue(&simpleCfg.Name)},
 					Usage:    "name description",
 				},
 				{
 					Name:       "name_two",
+					Path:       "Name2",
 					Short:      "t",
 					EnvName:    "NAME_TWO",
 					DefValue:   "name2_value",
-					Value:      newStringValue(&simpleCfg.Name2),
+					Value:      &sourceValue{Value: newStringValue(&simpleCfg.Name2)},
 					Hidden:     true,
 					Deprecated: true,
 				},
 				{
 					Name:     "name3",
+					Path:     "Name3",
 					EnvName:  "NAME_THREE",
 					DefValue: "",
-					Value:    newStringValue(&simpleCfg.Name3),
+					Value:    &sourceValue{Value: newStringValue(&simpleCfg.Name3)},
 				},
 				{
 					Name:     "name4",
+					Path:     "Name4",
 					EnvName:  "NAME4",
 					DefValue: "name_value4",
-					Value:    newStringValue(simpleCfg.Name4),
+					Value:    &sourceValue{Value: newStringValue(simpleCfg.Name4)},
 				},
 				{
 					Name:     "addr",
+					Path:     "Addr",
 					EnvName:  "ADDR",
 					DefValue: "127.0.0.1:0",
-					Value:    newTCPAddrValue(simpleCfg.Addr),
+					Value:    &sourceValue{Value: newTCPAddrValue(simpleCfg.Addr)},
 				},
 				{
 					Name:     "map",
+					Path:     "Map",
 					EnvName:  "MAP",
 					DefValue: "map[test:15]",
-					Value:    newStringIntMapValue(&simpleCfg.Map),
+					Value:    &sourceValue{Value: newStringIntMapValue(&simpleCfg.Map)},
 				},
 			},
 		},
@@ -168,43 +175,49 @@ func TestParseStruct(t *testing.T) {
 			expFlagSet: []*Flag{
 				{
 					Name:     "name",
+					Path:     "Name",
 					EnvName:  "",
 					DefValue: "name_value",
-					Value:    newStringValue(&simpleCfg.Name),
+					Value:    &sourceValue{Value: newStringValue(&simpleCfg.Name)},
 					Usage:    "name description",
 				},
 				{
 					Name:       "name_two",
+					Path:       "Name2",
 					Short:      "t",
 					EnvName:    "PP|NAME_TWO",
 					DefValue:   "name2_value",
-					Value:      newStringValue(&simpleCfg.Name2),
+					Value:      &sourceValue{Value: newStringValue(&simpleCfg.Name2)},
 					Hidden:     true,
 					Deprecated: true,
 				},
 				{
 					Name:     "name3",
+					Path:     "Name3",
 					EnvName:  "PP|NAME_THREE",
 					DefValue: "",
-					Value:    newStringValue(&simpleCfg.Name3),
+					Value:    &sourceValue{Value: newStringValue(&simpleCfg.Name3)},
 				},
 				{
 					Name:     "name4",
+					Path:     "Name4",
 					EnvName:  "PP|NAME4",
 					DefValue: "name_value4",
-					Value:    newStringValue(simpleCfg.Name4),
+					Value:    &sourceValue{Value: newStringValue(simpleCfg.Name4)},
 				},
 				{
 					Name:     "addr",
+					Path:     "Addr",
 					EnvName:  "PP|ADDR",
 					DefValue: "127.0.0.1:0",
-					Value:    newTCPAddrValue(simpleCfg.Addr),
+					Value:    &sourceValue{Value: newTCPAddrValue(simpleCfg.Addr)},
 				},
 				{
 					Name:     "map",
+					Path:     "Map",
 					EnvName:  "PP|MAP",
 					DefValue: "map[test:15]",
-					Value:    newStringIntMapValue(&simpleCfg.Map),
+					Value:    &sourceValue{Value: newStringIntMapValue(&simpleCfg.Map)},
 				},
 			},
 			expErr: nil,
@@ -215,69 +228,79 @@ func TestParseStruct(t *testing.T) {
 			expFlagSet: []*Flag{
 				{
 					Name:     "string-value",
+					Path:     "StringValue",
 					EnvName:  "STRING_VALUE",
 					DefValue: "string",
-					Value:    newStringValue(&diffTypesCfg.StringValue),
+					Value:    &sourceValue{Value: newStringValue(&diffTypesCfg.StringValue)},
 					Usage:    "",
 				},
 				{
 					Name:     "byte-value",
+					Path:     "ByteValue",
 					EnvName:  "BYTE_VALUE",
 					DefValue: "10",
-					Value:    newUint8Value(&diffTypesCfg.ByteValue),
+					Value:    &sourceValue{Value: newUint8Value(&diffTypesCfg.ByteValue)},
 					Usage:    "",
 				},
 				{
 					Name:     "string-slice-value",
+					Path:     "StringSliceValue",
 					EnvName:  "STRING_SLICE_VALUE",
 					DefValue: "[]",
-					Value:    newStringSliceValue(&diffTypesCfg.StringSliceValue),
+					Value:    &sourceValue{Value: newStringSliceValue(&diffTypesCfg.StringSliceValue)},
 					Usage:    "",
 				},
 				{
 					Name:     "bool-slice-value",
+					Path:     "BoolSliceValue",
 					EnvName:  "BOOL_SLICE_VALUE",
 					DefValue: "[]",
-					Value:    newBoolSliceValue(&diffTypesCfg.BoolSliceValue),
+					Value:    &sourceValue{Value: newBoolSliceValue(&diffTypesCfg.BoolSliceValue)},
 					Usage:    "",
 				},
 				{
 					Name:     "counter-value",
+					Path:     "CounterValue",
 					EnvName:  "COUNTER_VALUE",
 					DefValue: "10",
-					Value:    &diffTypesCfg.CounterValue,
+					Value:    newSourceValue(&diffTypesCfg.CounterValue, ""),
 					Usage:    "",
 				},
 				{
 					Name:     "regexp-value",
+					Path:     "RegexpValue",
 					EnvName:  "REGEXP_VALUE",
 					DefValue: "",
-					Value:    newRegexpValue(&diffTypesCfg.RegexpValue),
+					Value:    &sourceValue{Value: newRegexpValue(&diffTypesCfg.RegexpValue)},
 					Usage:    "",
 				},
 				{
 					Name:     "map-int8-bool",
+					Path:     "MapInt8Bool",
 					EnvName:  "MAP_INT8_BOOL",
 					DefValue: "",
-					Value:    newInt8BoolMapValue(&diffTypesCfg.MapInt8Bool),
+					Value:    &sourceValue{Value: newInt8BoolMapValue(&diffTypesCfg.MapInt8Bool)},
 				},
 				{
 					Name:     "map-int16-int8",
+					Path:     "MapInt16Int8",
 					EnvName:  "MAP_INT16_INT8",
 					DefValue: "",
-					Value:    newInt16Int8MapValue(&diffTypesCfg.MapInt16Int8),
+					Value:    &sourceValue{Value: newInt16Int8MapValue(&diffTypesCfg.MapInt16Int8)},
 				},
 				{
 					Name:     "map-string-int64",
+					Path:     "MapStringInt64",
 					EnvName:  "MAP_STRING_INT64",
 					DefValue: "map[test:888]",
-					Value:    newStringInt64MapValue(&diffTypesCfg.MapStringInt64),
+					Value:    &sourceValue{Value: newStringInt64MapValue(&diffTypesCfg.MapStringInt64)},
 				},
 				{
 					Name:     "map-string-string",
+					Path:     "MapStringString",
 					EnvName:  "MAP_STRING_STRING",
 					DefValue: "map[test:test-val]",
-					Value:    newStringStringMapValue(&diffTypesCfg.MapStringString),
+					Value:    &sourceValue{Value: newStringStringMapValue(&diffTypesCfg.MapStringString)},
 				},
 			},
 		},
@@ -287,34 +310,44 @@ func TestParseStruct(t *testing.T) {
 			expFlagSet: []*Flag{
 				{
 					Name:     "sub-name",
+					Path:     "Sub.Name",
+					Group:    &Group{Path: "Sub", Title: "Sub"},
 					EnvName:  "SUB_NAME",
 					DefValue: "name_value",
-					Value:    newStringValue(&nestedCfg.Sub.Name),
+					Value:    &sourceValue{Value: newStringValue(&nestedCfg.Sub.Name)},
 					Usage:    "name description",
 				},
 				{
 					Name:     "sub-name2",
+					Path:     "Sub.Name2",
+					Group:    &Group{Path: "Sub", Title: "Sub"},
 					EnvName:  "SUB_NAME_TWO",
 					DefValue: "name2_value",
-					Value:    newStringValue(&nestedCfg.Sub.Name2),
+					Value:    &sourceValue{Value: newStringValue(&nestedCfg.Sub.Name2)},
 				},
 				{
 					Name:     "name3",
+					Path:     "Sub.Name3",
+					Group:    &Group{Path: "Sub", Title: "Sub"},
 					EnvName:  "NAME_THREE",
 					DefValue: "",
-					Value:    newStringValue(&nestedCfg.Sub.Name3),
+					Value:    &sourceValue{Value: newStringValue(&nestedCfg.Sub.Name3)},
 				},
 				{
 					Name:     "sub-sub2-name4",
+					Path:     "Sub.SUB2.Name4",
+					Group:    &Group{Path: "Sub.SUB2", Title: "Sub.SUB2"},
 					EnvName:  "SUB_SUB2_NAME4",
 					DefValue: "name4_value",
-					Value:    newStringValue(&nestedCfg.Sub.SUB2.Name4),
+					Value:    &sourceValue{Value: newStringValue(&nestedCfg.Sub.SUB2.Name4)},
 				},
 				{
 					Name:     "sub-sub2-name5",
+					Path:     "Sub.SUB2.Name5",
+					Group:    &Group{Path: "Sub.SUB2", Title: "Sub.SUB2"},
 					EnvName:  "SUB_SUB2_name_five",
 					DefValue: "",
-					Value:    newStringValue(&nestedCfg.Sub.SUB2.Name5),
+					Value:    &sourceValue{Value: newStringValue(&nestedCfg.Sub.SUB2.Name5)},
 				},
 			},
 			expErr: nil,
@@ -326,13 +359,15 @@ func TestParseStruct(t *testing.T) {
 			expFlagSet: []*Flag{
 				{
 					Name:    "name",
+					Path:    "Name",
 					EnvName: "NAME",
-					Value:   newStringValue(&descCfg.Name),
+					Value:   &sourceValue{Value: newStringValue(&descCfg.Name)},
 				},
 				{
 					Name:    "name2",
+					Path:    "Name2",
 					EnvName: "NAME2",
-					Value:   newStringValue(&descCfg.Name2),
+					Value:   &sourceValue{Value: newStringValue(&descCfg.Name2)},
 					Usage:   "name2 description",
 				},
 			},
@@ -343,14 +378,16 @@ func TestParseStruct(t *testing.T) {
 			expFlagSet: []*Flag{
 				{
 					Name:    "name1",
+					Path:    "Name1",
 					EnvName: "NAME1",
-					Value:   newStringValue(&anonymousCfg.Name1),
+					Value:   &sourceValue{Value: newStringValue(&anonymousCfg.Name1)},
 				},
 				{
 					Name:     "name",
+					Path:     "simple.Name",
 					EnvName:  "NAME",
 					DefValue: "name_value",
-					Value:    newStringValue(&anonymousCfg.Name),
+					Value:    &sourceValue{Value: newStringValue(&anonymousCfg.Name)},
 				},
 			},
 		},
@@ -361,14 +398,17 @@ func TestParseStruct(t *testing.T) {
 			expFlagSet: []*Flag{
 				{
 					Name:    "name1",
+					Path:    "Name1",
 					EnvName: "NAME1",
-					Value:   newStringValue(&anonymousCfg.Name1),
+					Value:   &sourceValue{Value: newStringValue(&anonymousCfg.Name1)},
 				},
 				{
 					Name:     "simple-name",
+					Path:     "simple.Name",
+					Group:    &Group{Path: "simple", Title: "simple"},
 					EnvName:  "SIMPLE_NAME",
 					DefValue: "name_value",
-					Value:    newStringValue(&anonymousCfg.Name),
+					Value:    &sourceValue{Value: newStringValue(&anonymousCfg.Name)},
 				},
 			},
 		},
@@ -489,3 +529,388 @@ func TestFlatten(t *testing.T) {
 	Flatten(false)(&opt)
 	assert.Equal(t, false, opt.flatten)
 }
+
+func TestFromEnv(t *testing.T) {
+	opt := opts{
+		fromEnv: false,
+	}
+	FromEnv(true)(&opt)
+	assert.Equal(t, true, opt.fromEnv)
+}
+
+func TestParseStruct_Default(t *testing.T) {
+	cfg := struct {
+		Name     string        `default:"name_value"`
+		Name2    string        `default:"name2_value"`
+		Timeout  time.Duration `default:"10s"`
+		Ptr      *int          `default:"5"`
+		Hosts    []string      `default:"one,two"`
+		NoDefVal string
+	}{
+		Name2: "custom_value",
+	}
+	flags, err := ParseStruct(&cfg)
+	require.NoError(t, err)
+	require.Equal(t, 6, len(flags))
+	assert.Equal(t, "name_value", cfg.Name)
+	assert.Equal(t, "custom_value", cfg.Name2)
+	assert.Equal(t, 10*time.Second, cfg.Timeout)
+	require.NotNil(t, cfg.Ptr)
+	assert.Equal(t, 5, *cfg.Ptr)
+	assert.Equal(t, []string{"one", "two"}, cfg.Hosts)
+	assert.Equal(t, "", cfg.NoDefVal)
+
+	assert.Equal(t, "name_value", flags[0].DefValue)
+	assert.Equal(t, "10s", flags[2].DefValue)
+	assert.Equal(t, SourceDefault, flags[0].Source())
+}
+
+func TestParseStruct_DefaultErrors(t *testing.T) {
+	cfg := struct {
+		Count int `default:"abc"`
+	}{}
+	_, err := ParseStruct(&cfg)
+	assert.EqualError(t, err,
+		`invalid default value "abc" for field Count: strconv.ParseInt: parsing "abc": invalid syntax`)
+
+	cfg2 := struct {
+		Name string `default:"value"`
+	}{}
+	testErr := errors.New("validator test error")
+	_, err = ParseStruct(&cfg2, Validator(func(string, reflect.StructField, interface{}) error {
+		return testErr
+	}))
+	assert.EqualError(t, err, `invalid default value "value" for field Name: validator test error`)
+}
+
+func TestParseStruct_Strict(t *testing.T) {
+	cfg := struct {
+		Name    string
+		Headers map[string][]complex128
+		HTTP    struct {
+			Events  chan int
+			Complex complex128
+			Port    int
+		}
+		Empty struct{}
+		Ptr   *struct{}
+	}{}
+	flags, err := ParseStruct(&cfg)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(flags))
+
+	type skipped struct {
+		path string
+		typ  reflect.Type
+	}
+	var skips []skipped
+	_, err = ParseStruct(&cfg, OnSkip(func(path string, typ reflect.Type) {
+		skips = append(skips, skipped{path, typ})
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []skipped{
+		{"Headers", reflect.TypeOf(map[string][]complex128{})},
+		{"HTTP.Events", reflect.TypeOf(make(chan int))},
+		{"HTTP.Complex", reflect.TypeOf(complex128(0))},
+	}, skips)
+
+	_, err = ParseStruct(&cfg, Strict(true))
+	assert.EqualError(t, err, "field Headers has unsupported type map[string][]complex128")
+
+	cfg2 := struct {
+		HTTP struct {
+			Events chan int
+		}
+	}{}
+	_, err = ParseStruct(&cfg2, Strict(true))
+	assert.EqualError(t, err, "field HTTP.Events has unsupported type chan int")
+}
+
+func TestParseStruct_Choices(t *testing.T) {
+	cfg := struct {
+		Format string   `choices:"json,text,yaml" default:"text"`
+		Levels []string `choices:"debug,info"`
+	}{}
+	flags, err := ParseStruct(&cfg)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(flags))
+	assert.Equal(t, []string{"json", "text", "yaml"}, flags[0].Choices)
+	assert.Equal(t, "text", cfg.Format)
+
+	err = flags[0].Value.Set("xml")
+	assert.EqualError(t, err, `invalid value "xml", allowed values: json, text, yaml`)
+	assert.Equal(t, "text", cfg.Format)
+	require.NoError(t, flags[0].Value.Set("json"))
+	assert.Equal(t, "json", cfg.Format)
+
+	err = flags[1].Value.Set("debug,trace")
+	assert.EqualError(t, err, `invalid value "trace", allowed values: debug, info`)
+	require.NoError(t, flags[1].Value.Set("debug,info"))
+	assert.Equal(t, []string{"debug", "info"}, cfg.Levels)
+
+	_, err = ParseStruct(&struct {
+		Format string `choices:"json,text" default:"xml"`
+	}{})
+	assert.EqualError(t, err,
+		`invalid default value "xml" for field Format: invalid value "xml", allowed values: json, text`)
+}
+
+func TestParseStruct_TimeLayout(t *testing.T) {
+	cfg := struct {
+		Since time.Time
+		Until time.Time            `layout:"02.01.2006"`
+		Dates []time.Time          `layout:"02.01.2006"`
+		Days  map[string]time.Time `layout:"02.01.2006"`
+	}{}
+	flags, err := ParseStruct(&cfg)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(flags))
+
+	require.NoError(t, flags[0].Value.Set("2020-01-02"))
+	assert.Equal(t, "2020-01-02T00:00:00Z", flags[0].Value.String())
+	require.NoError(t, flags[1].Value.Set("03.01.2020"))
+	assert.Equal(t, time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC), cfg.Until)
+	assert.Equal(t, "03.01.2020", flags[1].Value.String())
+	assert.EqualError(t, flags[1].Value.Set("2020-01-03"),
+		`failed to parse time: "2020-01-03", allowed layouts: 02.01.2006`)
+	require.NoError(t, flags[2].Value.Set("04.01.2020,05.01.2020"))
+	assert.Equal(t, "[04.01.2020,05.01.2020]", flags[2].Value.String())
+	require.NoError(t, flags[3].Value.Set("start:06.01.2020"))
+	assert.Equal(t, time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC), cfg.Days["start"])
+	assert.Equal(t, "map[start:06.01.2020]", flags[3].Value.String())
+
+	flags, err = ParseStruct(&cfg, TimeLayout("2006/01/02"))
+	require.NoError(t, err)
+	require.NoError(t, flags[0].Value.Set("2020/01/07"))
+	assert.Equal(t, "2020/01/07", flags[0].Value.String())
+	require.NoError(t, flags[1].Value.Set("08.01.2020"))
+}
+
+func TestParseStruct_Complete(t *testing.T) {
+	cfg := struct {
+		Config  string `complete:"file"`
+		WorkDir string `complete:"dir"`
+		Name    string
+	}{}
+	flags, err := ParseStruct(&cfg)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(flags))
+	assert.Equal(t, "file", flags[0].Complete)
+	assert.Equal(t, "dir", flags[1].Complete)
+	assert.Equal(t, "", flags[2].Complete)
+
+	_, err = ParseStruct(&struct {
+		Host string `complete:"host"`
+	}{})
+	assert.EqualError(t, err, `field Host has invalid complete tag "host", it should be file or dir`)
+}
+
+func TestParseStruct_Duplicates(t *testing.T) {
+	tt := []struct {
+		name   string
+		cfg    interface{}
+		expErr string
+	}{
+		{
+			name: "Flattened",
+			cfg: &struct {
+				Name string
+				simple
+			}{},
+			expErr: `duplicate flag name "name" in fields Name and simple.Name`,
+		},
+		{
+			name: "Prefix override",
+			cfg: &struct {
+				Addr string
+				HTTP struct {
+					Addr string `flag:"~addr"`
+				}
+			}{},
+			expErr: `duplicate flag name "addr" in fields Addr and HTTP.Addr`,
+		},
+		{
+			name: "Short",
+			cfg: &struct {
+				Verbose bool `flag:"verbose v"`
+				Version bool `flag:"version v"`
+			}{},
+			expErr: `duplicate short flag name "v" in fields Verbose and Version`,
+		},
+		{
+			name: "Negation",
+			cfg: &struct {
+				Cache   bool `flag:"cache,negatable"`
+				NoCache bool
+			}{},
+			expErr: `duplicate flag name "no-cache" in fields Cache and NoCache`,
+		},
+		{
+			name: "Env",
+			cfg: &struct {
+				Host string `env:"ADDR"`
+				HTTP struct {
+					Host string `env:"~ADDR"`
+				}
+			}{},
+			expErr: `duplicate env name "ADDR" in fields Host and HTTP.Host`,
+		},
+	}
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseStruct(test.cfg)
+			assert.EqualError(t, err, test.expErr)
+		})
+	}
+}
+
+func TestParseStruct_Group(t *testing.T) {
+	cfg := struct {
+		Title string
+		HTTP  struct {
+			Host string
+			TLS  struct {
+				Cert string
+			} `group:"TLS options"`
+		} `group:"HTTP options" desc:"HTTP server settings"`
+		simple
+	}{}
+	flags, err := ParseStruct(&cfg)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(flags))
+	assert.Nil(t, flags[0].Group)
+	assert.Equal(t, &Group{
+		Path:        "HTTP",
+		Title:       "HTTP options",
+		Description: "HTTP server settings",
+	}, flags[1].Group)
+	assert.Equal(t, &Group{Path: "HTTP.TLS", Title: "TLS options"}, flags[2].Group)
+	assert.Equal(t, "HTTP.TLS.Cert", flags[2].Path)
+	// flatten anonymous structure is in the group of its parent
+	assert.Nil(t, flags[3].Group)
+}
+
+func TestParseStruct_MapSeparator(t *testing.T) {
+	cfg := struct {
+		Endpoints map[string]string
+		Limits    map[string]int `mapsep:"="`
+		Labels    map[string][]string
+	}{}
+	flags, err := ParseStruct(&cfg)
+	require.NoError(t, err)
+	require.NoError(t, flags[0].Value.Set("api:http://host:8080"))
+	assert.Equal(t, map[string]string{"api": "http://host:8080"}, cfg.Endpoints)
+	require.NoError(t, flags[1].Value.Set("cpu=2"))
+	assert.Equal(t, map[string]int{"cpu": 2}, cfg.Limits)
+	assert.EqualError(t, flags[1].Value.Set("cpu:2"), "invalid map flag syntax, use -map=key1=val1")
+
+	flags, err = ParseStruct(&cfg, MapSeparator("=>"), Validator(func(string, reflect.StructField, interface{}) error {
+		return nil
+	}))
+	require.NoError(t, err)
+	require.NoError(t, flags[0].Value.Set("web=>http://host"))
+	assert.Equal(t, "http://host", cfg.Endpoints["web"])
+	require.NoError(t, flags[1].Value.Set("mem=4"))
+	assert.Equal(t, 4, cfg.Limits["mem"])
+	require.NoError(t, flags[2].Value.Set("env=>prod,eu"))
+	assert.Equal(t, []string{"prod", "eu"}, cfg.Labels["env"])
+
+	err = SetFromMap(flags, map[string]interface{}{
+		"endpoints": map[string]interface{}{"a=>b": "c"},
+		"labels":    map[string]interface{}{"team": []interface{}{"core", 1}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "c", cfg.Endpoints["a=>b"])
+	assert.Equal(t, []string{"core", "1"}, cfg.Labels["team"])
+}
+
+func TestParseStruct_SliceSeparator(t *testing.T) {
+	cfg := struct {
+		Hosts   []string
+		Queries []string `sep:";"`
+		Filters []string `sep:"none"`
+		Levels  []logLevel
+		Formats []string            `sep:"|" choices:"json,text"`
+		Labels  map[string][]string `sep:";"`
+	}{}
+	flags, err := ParseStruct(&cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 6)
+
+	require.NoError(t, flags[0].Value.Set(`a,"b,c"`))
+	assert.Equal(t, []string{"a", "b,c"}, cfg.Hosts)
+	assert.EqualError(t, flags[0].Value.Set(`"d`), `unterminated quoted element in "\"d"`)
+
+	require.NoError(t, flags[1].Value.Set("select a, b;select 1"))
+	assert.Equal(t, []string{"select a, b", "select 1"}, cfg.Queries)
+
+	require.NoError(t, flags[2].Value.Set("a,b;c"))
+	require.NoError(t, flags[2].Value.Set(`"d"`))
+	assert.Equal(t, []string{"a,b;c", `"d"`}, cfg.Filters)
+
+	require.NoError(t, flags[3].Value.Set(`debug,"error"`))
+	assert.Equal(t, []logLevel{0, 2}, cfg.Levels)
+
+	require.NoError(t, flags[4].Value.Set("json|text"))
+	assert.Equal(t, []string{"json", "text"}, cfg.Formats)
+	assert.EqualError(t, flags[4].Value.Set("json,text"), `invalid value "json,text", allowed values: json, text`)
+
+	require.NoError(t, flags[5].Value.Set("env:prod,eu;us"))
+	assert.Equal(t, map[string][]string{"env": {"prod,eu", "us"}}, cfg.Labels)
+
+	flags, err = ParseStruct(&cfg, SliceSeparator(SeparatorNone))
+	require.NoError(t, err)
+	require.NoError(t, flags[0].Value.Set("a,b"))
+	assert.Equal(t, []string{"a,b"}, cfg.Hosts)
+	require.NoError(t, flags[1].Value.Set("c;d"))
+	assert.Equal(t, []string{"c", "d"}, cfg.Queries)
+
+	err = SetFromMap(flags, map[string]interface{}{
+		"hosts":   []interface{}{"x,y", "z"},
+		"queries": []interface{}{"select 1;", `"q"`},
+		"labels":  map[string]interface{}{"team": []interface{}{"a;b", "c"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"x,y", "z"}, cfg.Hosts)
+	assert.Equal(t, []string{"select 1;", `"q"`}, cfg.Queries)
+	assert.Equal(t, []string{"a;b", "c"}, cfg.Labels["team"])
+}
+
+func TestParseStruct_Negatable(t *testing.T) {
+	cfg := struct {
+		TLS     bool  `flag:"tls,negatable" desc:"use tls"`
+		Color   *bool `flag:",negatable"`
+		Verbose bool
+	}{
+		TLS: true,
+	}
+	flags, err := ParseStruct(&cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 3)
+	assert.True(t, flags[0].Negatable)
+	assert.True(t, flags[1].Negatable)
+	assert.Nil(t, flags[2].Negation())
+
+	neg := flags[0].Negation()
+	require.NotNil(t, neg)
+	assert.Equal(t, "no-tls", neg.Name)
+	assert.Equal(t, "TLS", neg.Path)
+	assert.Equal(t, "negates --tls", neg.Usage)
+	assert.Equal(t, "false", neg.Value.String())
+	assert.True(t, neg.Hidden)
+
+	require.NoError(t, neg.Value.Set("true"))
+	assert.False(t, cfg.TLS)
+	require.NoError(t, neg.Value.Set("false"))
+	assert.True(t, cfg.TLS)
+	assert.Error(t, neg.Value.Set("maybe"))
+
+	require.NoError(t, flags[1].Negation().Value.Set("true"))
+	require.NotNil(t, cfg.Color)
+	assert.False(t, *cfg.Color)
+
+	_, err = ParseStruct(&struct {
+		Level int `flag:",negatable"`
+	}{})
+	assert.EqualError(t, err, "field Level with negatable option should be bool")
+}
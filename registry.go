@@ -0,0 +1,65 @@
+package sflags
+
+import (
+	"reflect"
+	"sync"
+)
+
+// NewValueFunc returns Value for ptr, that is a pointer to a value of registered type.
+type NewValueFunc func(ptr interface{}) Value
+
+var registry = struct {
+	sync.RWMutex
+	types map[reflect.Type]NewValueFunc
+}{types: map[reflect.Type]NewValueFunc{}}
+
+// RegisterType registers a parser for values of typ, e.g. for types from other modules,
+// that don't implement Value interface.
+// Registered types take precedence over built-in types,
+// slices, pointers and maps (with string or integer keys) of registered types are supported too.
+// It's usually called once per program in init function:
+//
+//	sflags.RegisterType(reflect.TypeOf(uuid.UUID{}), func(ptr interface{}) sflags.Value {
+//		return &uuidValue{ptr.(*uuid.UUID)}
+//	})
+func RegisterType(typ reflect.Type, newValue NewValueFunc) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.types[typ] = newValue
+}
+
+func lookupType(typ reflect.Type) NewValueFunc {
+	registry.RLock()
+	defer registry.RUnlock()
+	return registry.types[typ]
+}
+
+// parseRegistered returns Value for addressable value of registered type,
+// or a slice or map of them. It returns nil for other types.
+func parseRegistered(value reflect.Value) Value {
+	typ := value.Type()
+	if newValue := lookupType(typ); newValue != nil {
+		return newValue(value.Addr().Interface())
+	}
+	switch typ.Kind() {
+	case reflect.Slice:
+		if newValue := lookupType(typ.Elem()); newValue != nil {
+			return newElemSliceValue(value, registeredElemFunc(newValue))
+		}
+	case reflect.Map:
+		if !anyOf(MapAllowedKinds, typ.Key().Kind()) {
+			return nil
+		}
+		if newValue := lookupType(typ.Elem()); newValue != nil {
+			if value.IsNil() {
+				value.Set(reflect.MakeMap(typ))
+			}
+			return newElemMapValue(value, registeredElemFunc(newValue))
+		}
+	}
+	return nil
+}
+
+func registeredElemFunc(newValue NewValueFunc) newElemFunc {
+	return func(ptr reflect.Value) Value { return newValue(ptr.Interface()) }
+}
@@ -0,0 +1,121 @@
+package sflags
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type point struct {
+	X, Y int
+}
+
+type pointValue struct {
+	value *point
+}
+
+func (v *pointValue) Set(s string) error {
+	parts := strings.Split(s, "x")
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid point %q", s)
+	}
+	x, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return err
+	}
+	y, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return err
+	}
+	*v.value = point{x, y}
+	return nil
+}
+
+func (v *pointValue) String() string { return fmt.Sprintf("%dx%d", v.value.X, v.value.Y) }
+
+func (v *pointValue) Type() string { return "point" }
+
+func init() {
+	RegisterType(reflect.TypeOf(point{}), func(ptr interface{}) Value {
+		return &pointValue{ptr.(*point)}
+	})
+}
+
+func TestRegisterType(t *testing.T) {
+	cfg := struct {
+		Size    point
+		Origin  *point
+		Points  []point
+		Named   map[string]point
+		Indexed map[int]point
+		Bad     map[float64]point
+	}{}
+	flags, err := ParseStruct(&cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 5)
+
+	assert.Equal(t, "point", flags[0].Value.Type())
+	require.NoError(t, flags[0].Value.Set("3x4"))
+	assert.Equal(t, point{3, 4}, cfg.Size)
+	assert.EqualError(t, flags[0].Value.Set("3"), `invalid point "3"`)
+
+	assert.Equal(t, "point", flags[1].Value.Type())
+	require.NoError(t, flags[1].Value.Set("1x2"))
+	assert.Equal(t, &point{1, 2}, cfg.Origin)
+
+	assert.Equal(t, "pointSlice", flags[2].Value.Type())
+	require.NoError(t, flags[2].Value.Set("1x1,2x2"))
+	require.NoError(t, flags[2].Value.Set("3x3"))
+	assert.Equal(t, []point{{1, 1}, {2, 2}, {3, 3}}, cfg.Points)
+	assert.Equal(t, "[1x1,2x2,3x3]", flags[2].Value.String())
+
+	assert.Equal(t, "map[string]point", flags[3].Value.Type())
+	require.NoError(t, flags[3].Value.Set("min:0x0"))
+	require.NoError(t, flags[3].Value.Set("max:9x9"))
+	assert.Equal(t, map[string]point{"min": {0, 0}, "max": {9, 9}}, cfg.Named)
+	assert.Equal(t, "map[max:9x9 min:0x0]", flags[3].Value.String())
+
+	assert.Equal(t, "map[int]point", flags[4].Value.Type())
+	require.NoError(t, flags[4].Value.Set("10:1x1"))
+	require.NoError(t, flags[4].Value.Set("2:2x2"))
+	assert.Equal(t, "map[2:2x2 10:1x1]", flags[4].Value.String())
+}
+
+func TestRegisterType_Precedence(t *testing.T) {
+	ipType := reflect.TypeOf(net.IP{})
+	RegisterType(ipType, func(ptr interface{}) Value {
+		return &validateValue{
+			Value: newIPValue(ptr.(*net.IP)),
+			validateFunc: func(s string) error {
+				if ip := net.ParseIP(s); ip == nil || ip.To4() == nil {
+					return fmt.Errorf("%q is not IPv4", s)
+				}
+				return nil
+			},
+		}
+	})
+	defer func() {
+		registry.Lock()
+		delete(registry.types, ipType)
+		registry.Unlock()
+	}()
+
+	cfg := struct {
+		Addr  net.IP
+		Addrs []net.IP
+	}{}
+	flags, err := ParseStruct(&cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 2)
+
+	require.NoError(t, flags[0].Value.Set("127.0.0.1"))
+	assert.Equal(t, net.ParseIP("127.0.0.1"), cfg.Addr)
+	assert.EqualError(t, flags[0].Value.Set("::1"), `"::1" is not IPv4`)
+	assert.EqualError(t, flags[1].Value.Set("127.0.0.1,::1"), `"::1" is not IPv4`)
+}
@@ -0,0 +1,45 @@
+package sflags
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ValidateRequired checks that all required flags were set or have non zero default values.
+// It should be called after command line arguments are parsed.
+// Returns a single error with names of all missing flags.
+func ValidateRequired(flags []*Flag) error {
+	var missing []string
+	for _, flag := range flags {
+		if flag.Required && !flag.Changed() && isZeroValue(flag.Value) {
+			missing = append(missing, flag.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf(`required flag(s) "%s" not set`, strings.Join(missing, `", "`))
+	}
+	return nil
+}
+
+// isZeroValue returns true if value wasn't set
+// by command line, environment or default value.
+func isZeroValue(value Value) bool {
+	if value == nil || value.String() == "" {
+		return true
+	}
+	getter, casted := value.(Getter)
+	if !casted {
+		return false
+	}
+	v := reflect.ValueOf(getter.Get())
+	if !v.IsValid() {
+		return true
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
@@ -0,0 +1,74 @@
+package sflags
+
+import (
+	"net"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRequired(t *testing.T) {
+	cfg := &struct {
+		Name    string         `flag:",required"`
+		Port    int            `flag:",required"`
+		Hosts   []string       `flag:",required"`
+		Labels  map[string]int `flag:",required"`
+		Regexp  *regexp.Regexp `flag:",required"`
+		Addr    net.TCPAddr    `flag:",required"`
+		Counter Counter        `flag:",required"`
+		Default string         `flag:",required"`
+		Other   string
+	}{
+		Hosts:   []string{},
+		Default: "default",
+	}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Equal(t, 9, len(flags))
+	assert.True(t, flags[0].Required)
+	assert.False(t, flags[8].Required)
+
+	err = ValidateRequired(flags)
+	assert.EqualError(t, err,
+		`required flag(s) "name", "port", "hosts", "labels", "regexp", "addr", "counter" not set`)
+
+	for _, kv := range [][2]string{
+		{"name", "name"},
+		{"port", "80"},
+		{"hosts", "one"},
+		{"labels", "a:1"},
+		{"regexp", "abc"},
+		{"addr", "127.0.0.1:80"},
+		{"counter", ""},
+	} {
+		for _, flag := range flags {
+			if flag.Name == kv[0] {
+				require.NoError(t, flag.Value.Set(kv[1]))
+			}
+		}
+	}
+	assert.NoError(t, ValidateRequired(flags))
+}
+
+func TestIsZeroValue(t *testing.T) {
+	assert.True(t, isZeroValue(nil))
+	assert.True(t, isZeroValue(newStringValue(strP(""))))
+	assert.False(t, isZeroValue(newStringValue(strP("value"))))
+	assert.True(t, isZeroValue(&validateValue{Value: newStringValue(strP(""))}))
+	assert.False(t, isZeroValue(&validateValue{Value: newStringValue(strP("value"))}))
+	assert.True(t, isZeroValue(&validateValue{Value: new(Counter)}))
+}
+
+func TestValidateRequired_ChangedToZero(t *testing.T) {
+	cfg := &struct {
+		Port int `flag:",required"`
+	}{}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Error(t, ValidateRequired(flags))
+
+	require.NoError(t, flags[0].Value.Set("0"))
+	assert.NoError(t, ValidateRequired(flags))
+}
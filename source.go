@@ -0,0 +1,143 @@
+package sflags
+
+// Source describes where the value of a flag came from.
+type Source int
+
+// Sources of flag values, from the lowest precedence to the highest.
+const (
+	SourceDefault Source = iota // value wasn't changed after parsing
+	SourceFile                  // value was set from config file (or other loader)
+	SourceEnv                   // value was set from environment variable
+	SourceFlag                  // value was set from command line
+)
+
+func (s Source) String() string {
+	switch s {
+	case SourceDefault:
+		return "default"
+	case SourceFile:
+		return "file"
+	case SourceEnv:
+		return "env"
+	case SourceFlag:
+		return "flag"
+	default:
+		return "unknown"
+	}
+}
+
+// Merge modes of slice and map values, they're set by merge tag.
+const (
+	mergeAppend  = "append"  // values are appended to existing elements, default for maps
+	mergeReplace = "replace" // values of a source replace elements of previous sources, default for slices
+	mergeReset   = "reset"   // every value replaces existing elements
+)
+
+// collection is implemented by slice and map values.
+type collection interface {
+	// clear removes all elements, following values are appended to the empty collection.
+	clear()
+	// appendNext makes the next value appended to existing elements,
+	// slices replace existing elements by the first value otherwise. Maps always append.
+	appendNext()
+}
+
+// sourceValue wraps every parsed value and remembers where it was set from.
+// cli/flag libraries call Set directly, so it's treated as command line source.
+type sourceValue struct {
+	Value
+	source   Source
+	merge    string // merge mode of slices and maps, replace for slices and append for maps if it's empty
+	afterSet func() // called after value is changed, e.g. to store an element of map back
+}
+
+// boolSourceValue wraps BoolFlag values. sourceValue itself doesn't implement BoolFlag,
+// because pflag treats every value with IsBoolFlag method as boolean in usage.
+type boolSourceValue struct {
+	*sourceValue
+}
+
+func (v *boolSourceValue) IsBoolFlag() bool {
+	return v.Value.(BoolFlag).IsBoolFlag()
+}
+
+// newSourceValue wraps val by sourceValue, or by boolSourceValue if val is BoolFlag.
+func newSourceValue(val Value, merge string) Value {
+	v := &sourceValue{Value: val, merge: merge}
+	if _, casted := val.(BoolFlag); casted {
+		return &boolSourceValue{v}
+	}
+	return v
+}
+
+// sourceValueOf returns sourceValue of v, if v is wrapped by it.
+func sourceValueOf(v Value) (*sourceValue, bool) {
+	switch casted := v.(type) {
+	case *sourceValue:
+		return casted, true
+	case *boolSourceValue:
+		return casted.sourceValue, true
+	}
+	return nil, false
+}
+
+func (v *sourceValue) IsCumulative() bool {
+	if cumulativeFlag, casted := v.Value.(RepeatableFlag); casted {
+		return cumulativeFlag.IsCumulative()
+	}
+	return false
+}
+
+func (v *sourceValue) String() string {
+	if v == nil || v.Value == nil {
+		return ""
+	}
+	return v.Value.String()
+}
+
+func (v *sourceValue) Get() interface{} {
+	if getter, casted := v.Value.(Getter); casted {
+		return getter.Get()
+	}
+	return nil
+}
+
+func (v *sourceValue) Set(val string) error {
+	return v.setFrom(SourceFlag, val)
+}
+
+func (v *sourceValue) setFrom(source Source, val string) error {
+	return v.setAllFrom(source, []string{val})
+}
+
+// setAllFrom sets values, that came from the same source at once, e.g. elements of a list from config file,
+// so merge mode is applied once for all of them.
+// Empty value or empty list of values clears slices and maps.
+func (v *sourceValue) setAllFrom(source Source, vals []string) error {
+	if coll, casted := unwrapValue(v.Value).(collection); casted {
+		merge := v.merge
+		if merge == "" {
+			merge = mergeAppend
+			if _, isSlice := sliceSeparatorOf(v.Value); isSlice {
+				merge = mergeReplace
+			}
+		}
+		switch {
+		case len(vals) == 0 || len(vals) == 1 && vals[0] == "":
+			coll.clear()
+			vals = nil
+		case merge == mergeReset, merge == mergeReplace && source != v.source:
+			coll.clear()
+		}
+	}
+	for _, val := range vals {
+		if err := v.Value.Set(val); err != nil {
+			return err
+		}
+	}
+	v.source = source
+	if v.afterSet != nil {
+		v.afterSet()
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package sflags
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSource_String(t *testing.T) {
+	assert.Equal(t, "default", SourceDefault.String())
+	assert.Equal(t, "file", SourceFile.String())
+	assert.Equal(t, "env", SourceEnv.String())
+	assert.Equal(t, "flag", SourceFlag.String())
+	assert.Equal(t, "unknown", Source(100).String())
+}
+
+func TestFlag_Source(t *testing.T) {
+	cfg := &struct {
+		Default string
+		File    string
+		Env     string
+		Flag    string
+		Bad     int
+	}{
+		Default: "default",
+	}
+	defer os.Unsetenv("SFLAGS_TEST_ENV")
+	os.Setenv("SFLAGS_TEST_ENV", "env")
+
+	loader := func(flags []*Flag, optFuncs ...OptFunc) error {
+		return SetFromMap(flags, map[string]interface{}{"file": "file"}, optFuncs...)
+	}
+	flags, err := ParseStruct(cfg, EnvPrefix("SFLAGS_TEST_"), Loader(loader), FromEnv(true))
+	require.NoError(t, err)
+	require.Equal(t, 5, len(flags))
+	require.NoError(t, flags[3].Value.Set("flag"))
+	require.Error(t, flags[4].Value.Set("bad"))
+
+	for i, exp := range []Source{SourceDefault, SourceFile, SourceEnv, SourceFlag, SourceDefault} {
+		assert.Equal(t, exp, flags[i].Source(), flags[i].Name)
+		assert.Equal(t, exp != SourceDefault, flags[i].Changed(), flags[i].Name)
+	}
+	assert.Equal(t, "default", cfg.Default)
+	assert.Equal(t, "file", cfg.File)
+	assert.Equal(t, "env", cfg.Env)
+	assert.Equal(t, "flag", cfg.Flag)
+}
+
+func TestFlag_SetFrom_NotTracked(t *testing.T) {
+	flag := &Flag{Value: newStringValue(strP(""))}
+	require.NoError(t, flag.SetFrom(SourceEnv, "value"))
+	assert.Equal(t, "value", flag.Value.String())
+	assert.Equal(t, SourceDefault, flag.Source())
+	assert.False(t, flag.Changed())
+}
+
+func TestFlag_Unwrap(t *testing.T) {
+	cfg := &struct {
+		Count Counter
+		Name  string
+	}{}
+	validator := func(val string, field reflect.StructField, cfg interface{}) error { return nil }
+	flags, err := ParseStruct(cfg, Validator(validator))
+	require.NoError(t, err)
+	require.Len(t, flags, 2)
+
+	_, casted := flags[0].Value.(*Counter)
+	assert.False(t, casted)
+	counter, casted := flags[0].Unwrap().(*Counter)
+	require.True(t, casted)
+	assert.Equal(t, &cfg.Count, counter)
+	assert.Equal(t, newStringValue(&cfg.Name), flags[1].Unwrap())
+}
+
+func TestSourceValue(t *testing.T) {
+	boolV := true
+	boolFlag, casted := newSourceValue(newBoolValue(&boolV), "").(BoolFlag)
+	require.True(t, casted)
+	assert.True(t, boolFlag.IsBoolFlag())
+	v, casted := sourceValueOf(boolFlag)
+	require.True(t, casted)
+	assert.False(t, v.IsCumulative())
+	assert.Equal(t, true, v.Get())
+	assert.Equal(t, "true", v.String())
+
+	sliceV := newSourceValue(newStringSliceValue(&[]string{}), "")
+	_, casted = sliceV.(BoolFlag)
+	assert.False(t, casted)
+	v, casted = sourceValueOf(sliceV)
+	require.True(t, casted)
+	assert.True(t, v.IsCumulative())
+
+	v = &sourceValue{Value: &validateValue{}}
+	assert.Nil(t, v.Get())
+	v = &sourceValue{}
+	assert.Equal(t, "", v.String())
+}
+
+func TestFlag_Merge(t *testing.T) {
+	cfg := &struct {
+		Tags         []string
+		AppendTags   []string `merge:"append"`
+		ResetTags    []string `merge:"reset"`
+		Labels       map[string]string
+		ReplaceLabel map[string]string `merge:"replace"`
+		ResetLabels  map[string]int    `merge:"reset"`
+		Levels       []logLevel        `merge:"append" default:"info"`
+	}{
+		Tags:         []string{"a"},
+		AppendTags:   []string{"a"},
+		ResetTags:    []string{"a"},
+		Labels:       map[string]string{"a": "1"},
+		ReplaceLabel: map[string]string{"a": "1"},
+		ResetLabels:  map[string]int{"a": 1},
+	}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 7)
+
+	err = SetFromMap(flags, map[string]interface{}{
+		"tags":         []interface{}{"b", "c"},
+		"appendTags":   []interface{}{"b", "c"},
+		"resetTags":    []interface{}{"b", "c"},
+		"labels":       map[string]interface{}{"b": "2"},
+		"replaceLabel": map[string]interface{}{"b": "2"},
+		"resetLabels":  map[string]interface{}{"b": 2, "c": 3},
+		"levels":       []interface{}{"debug"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "c"}, cfg.Tags)
+	assert.Equal(t, []string{"a", "b", "c"}, cfg.AppendTags)
+	assert.Equal(t, []string{"b", "c"}, cfg.ResetTags)
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, cfg.Labels)
+	assert.Equal(t, map[string]string{"b": "2"}, cfg.ReplaceLabel)
+	assert.Equal(t, map[string]int{"b": 2, "c": 3}, cfg.ResetLabels)
+	assert.Equal(t, []logLevel{1, 0}, cfg.Levels)
+
+	for _, flag := range flags[:5] {
+		require.NoError(t, flag.SetFrom(SourceEnv, flag.Name[:1]+":env"), flag.Name)
+	}
+	require.Error(t, flags[5].SetFrom(SourceEnv, "x:env"))
+	require.NoError(t, flags[5].SetFrom(SourceEnv, "x:10"))
+	assert.Equal(t, []string{"t:env"}, cfg.Tags)
+	assert.Equal(t, []string{"a", "b", "c", "a:env"}, cfg.AppendTags)
+	assert.Equal(t, []string{"r:env"}, cfg.ResetTags)
+	assert.Equal(t, map[string]string{"a": "1", "b": "2", "l": "env"}, cfg.Labels)
+	assert.Equal(t, map[string]string{"r": "env"}, cfg.ReplaceLabel)
+	assert.Equal(t, map[string]int{"x": 10}, cfg.ResetLabels)
+
+	for _, flag := range flags[:3] {
+		require.NoError(t, flag.Value.Set("x"))
+		require.NoError(t, flag.Value.Set("y"))
+	}
+	assert.Equal(t, []string{"x", "y"}, cfg.Tags)
+	assert.Equal(t, []string{"a", "b", "c", "a:env", "x", "y"}, cfg.AppendTags)
+	assert.Equal(t, []string{"y"}, cfg.ResetTags)
+
+	// empty value clears collections
+	for _, flag := range flags {
+		require.NoError(t, flag.Value.Set(""), flag.Name)
+		assert.Equal(t, SourceFlag, flag.Source())
+	}
+	assert.Equal(t, []string{}, cfg.Tags)
+	assert.Equal(t, []string{}, cfg.AppendTags)
+	assert.Equal(t, map[string]string{}, cfg.Labels)
+	assert.Equal(t, map[string]int{}, cfg.ResetLabels)
+	assert.Equal(t, []logLevel{}, cfg.Levels)
+	require.NoError(t, flags[0].Value.Set(`""`))
+	assert.Equal(t, []string{""}, cfg.Tags)
+
+	require.NoError(t, SetFromMap(flags, map[string]interface{}{
+		"tags":   []interface{}{},
+		"labels": map[string]interface{}{},
+	}))
+	assert.Equal(t, []string{}, cfg.Tags)
+	assert.Equal(t, SourceFile, flags[0].Source())
+
+	_, err = ParseStruct(&struct {
+		Tags []string `merge:"merge"`
+	}{})
+	assert.EqualError(t, err, `invalid merge tag "merge" for field Tags, allowed values: append, replace, reset`)
+}
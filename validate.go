@@ -0,0 +1,147 @@
+package sflags
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// StructValidator is implemented by config structures (or nested structures),
+// that check their values as a whole, e.g. that one flag requires another one,
+// or that min value is less than max value.
+type StructValidator interface {
+	Validate() error
+}
+
+// ValidateStruct calls Validate method of cfg and all its nested structures,
+// that implement StructValidator. It should be called after values are parsed
+// from command line and environment.
+// Nested structures are validated before their parents,
+// errors of nested structures are prefixed by the title of their flag group.
+// Subcommands aren't validated, use Command.Validate for them.
+func ValidateStruct(cfg interface{}, optFuncs ...OptFunc) error {
+	v, err := structValue(cfg)
+	if err != nil {
+		return err
+	}
+	return validateStruct(v, "", false, optFuncs...)
+}
+
+// validateStruct validates nested structures of value and value itself, unless skipSelf is set.
+// title is the title of the flag group of value, it's empty for the top level structure.
+func validateStruct(value reflect.Value, title string, skipSelf bool, optFuncs ...OptFunc) error {
+	opt := defOpts().apply(optFuncs...)
+	// Validate method of an embedded structure is promoted to its parent,
+	// so it's called (or overridden) by the parent.
+	_, implemented := value.Addr().Interface().(StructValidator)
+	valueType := value.Type()
+	for i := 0; i < value.NumField(); i++ {
+		field := valueType.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+		if _, isArg := field.Tag.Lookup(defaultArgTag); isArg {
+			continue
+		}
+		if _, isCmd := field.Tag.Lookup(defaultCmdTag); isCmd {
+			continue
+		}
+		if parseFlagTag(field, opt) == nil {
+			continue
+		}
+		path := field.Name
+		if opt.path != "" {
+			path = opt.path + "." + field.Name
+		}
+		fieldValue := value.Field(i)
+		if isStructElems(fieldValue.Type()) && fieldValue.CanInterface() {
+			if err := validateElems(fieldValue, path, opt); err != nil {
+				return err
+			}
+			continue
+		}
+		if fieldValue.Kind() == reflect.Ptr {
+			if fieldValue.IsNil() {
+				continue
+			}
+			fieldValue = fieldValue.Elem()
+		}
+		if fieldValue.Kind() != reflect.Struct || !fieldValue.CanInterface() || isValue(fieldValue) {
+			continue
+		}
+
+		nestedTitle := field.Tag.Get(defaultGroupTag)
+		if nestedTitle == "" {
+			nestedTitle = path
+		}
+		if field.Anonymous && opt.flatten {
+			nestedTitle = title
+		}
+		err := validateStruct(fieldValue, nestedTitle, field.Anonymous && implemented,
+			copyOpts(opt), fieldPath(path))
+		if err != nil {
+			return err
+		}
+	}
+	if skipSelf {
+		return nil
+	}
+	validator, casted := value.Addr().Interface().(StructValidator)
+	if !casted {
+		return nil
+	}
+	if err := validator.Validate(); err != nil {
+		if title != "" {
+			return fmt.Errorf("flag group %s: %v", title, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// validateElems validates elements of a slice or map of structures,
+// errors are prefixed by the path of the element, e.g. "Backends[0]".
+func validateElems(value reflect.Value, path string, opt opts) error {
+	validateElem := func(elem reflect.Value, elemPath string) error {
+		if elem.Kind() == reflect.Ptr {
+			if elem.IsNil() {
+				return nil
+			}
+			elem = elem.Elem()
+		}
+		return validateStruct(elem, elemPath, false, copyOpts(opt), fieldPath(elemPath))
+	}
+	if value.Kind() == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			if err := validateElem(value.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	keys := value.MapKeys()
+	sortMapKeys(keys)
+	for _, key := range keys {
+		// map elements aren't addressable, Validate might have a pointer receiver
+		ptr := reflect.New(value.Type().Elem())
+		ptr.Elem().Set(value.MapIndex(key))
+		if err := validateElem(ptr.Elem(), fmt.Sprintf("%s[%v]", path, key.Interface())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isValue returns true if the structure is parsed as a single flag value.
+func isValue(value reflect.Value) bool {
+	if !value.CanAddr() || !value.Addr().CanInterface() {
+		return false
+	}
+	valueInterface := value.Addr().Interface()
+	if _, casted := valueInterface.(Value); casted {
+		return true
+	}
+	if lookupType(value.Type()) != nil || newTextElemFunc(value.Type()) != nil {
+		return true
+	}
+	return parseGenerated(valueInterface) != nil
+}
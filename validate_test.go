@@ -0,0 +1,150 @@
+package sflags
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tlsCfg struct {
+	Cert string
+	Key  string
+}
+
+func (c *tlsCfg) Validate() error {
+	if c.Cert != "" && c.Key == "" {
+		return errors.New("cert requires key")
+	}
+	return nil
+}
+
+type rangeCfg struct {
+	Min, Max int
+}
+
+func (c rangeCfg) Validate() error {
+	if c.Min > c.Max {
+		return errors.New("min should be less than max")
+	}
+	return nil
+}
+
+type embeddedCfg struct {
+	rangeCfg
+	Name string
+}
+
+type validatedCfg struct {
+	TLS     tlsCfg
+	Range   *rangeCfg `group:"Limits"`
+	Skipped tlsCfg    `flag:"-"`
+	Cmd     tlsCfg    `cmd:"cmd"`
+	Addr    string
+	calls   int
+}
+
+func (c *validatedCfg) Validate() error {
+	c.calls++
+	if c.Addr == "" {
+		return errors.New("addr is empty")
+	}
+	return nil
+}
+
+func TestValidateStruct(t *testing.T) {
+	tests := []struct {
+		name string
+
+		cfg    *validatedCfg
+		expErr string
+	}{
+		{
+			name: "Test valid",
+			cfg:  &validatedCfg{Addr: "localhost"},
+		},
+		{
+			name: "Test skipped and command fields",
+			cfg: &validatedCfg{
+				Addr:    "localhost",
+				Skipped: tlsCfg{Cert: "cert"},
+				Cmd:     tlsCfg{Cert: "cert"},
+			},
+		},
+		{
+			name:   "Test value receiver in group",
+			cfg:    &validatedCfg{Addr: "localhost", Range: &rangeCfg{Min: 2, Max: 1}},
+			expErr: "flag group Limits: min should be less than max",
+		},
+		{
+			name:   "Test pointer receiver in group",
+			cfg:    &validatedCfg{Addr: "localhost", TLS: tlsCfg{Cert: "cert"}},
+			expErr: "flag group TLS: cert requires key",
+		},
+		{
+			name:   "Test nested structures are validated first",
+			cfg:    &validatedCfg{TLS: tlsCfg{Cert: "cert"}},
+			expErr: "flag group TLS: cert requires key",
+		},
+		{
+			name:   "Test top level structure",
+			cfg:    &validatedCfg{TLS: tlsCfg{Cert: "cert", Key: "key"}, Range: &rangeCfg{Max: 1}},
+			expErr: "addr is empty",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateStruct(test.cfg)
+			if test.expErr != "" {
+				assert.EqualError(t, err, test.expErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 1, test.cfg.calls)
+		})
+	}
+
+	assert.EqualError(t, ValidateStruct(nil), "object cannot be nil")
+}
+
+func TestValidateStruct_Embedded(t *testing.T) {
+	cfg := &struct {
+		embeddedCfg
+		Nested embeddedCfg `group:"Nested"`
+	}{}
+	require.NoError(t, ValidateStruct(cfg))
+
+	cfg.Nested.Min = 1
+	assert.EqualError(t, ValidateStruct(cfg), "flag group Nested: min should be less than max")
+
+	cfg.Nested.Min = 0
+	cfg.Min = 1
+	assert.EqualError(t, ValidateStruct(cfg), "min should be less than max")
+}
+
+func TestCommand_Validate(t *testing.T) {
+	cfg := &struct {
+		TLS    tlsCfg
+		Remote struct {
+			Range rangeCfg
+			Add   struct {
+				TLS tlsCfg `group:"Remote TLS"`
+			} `cmd:"add"`
+		} `cmd:"remote"`
+	}{}
+	root, err := ParseCommand(cfg)
+	require.NoError(t, err)
+	add := root.Commands[0].Commands[0]
+	require.NoError(t, add.Validate())
+
+	cfg.Remote.Add.TLS.Cert = "cert"
+	require.NoError(t, root.Validate())
+	assert.EqualError(t, add.Validate(), "command remote add: flag group Remote TLS: cert requires key")
+
+	cfg.Remote.Range.Min = 1
+	assert.EqualError(t, add.Validate(), "command remote: flag group Range: min should be less than max")
+
+	cfg.TLS.Cert = "cert"
+	assert.EqualError(t, add.Validate(), "flag group TLS: cert requires key")
+}
@@ -26,6 +26,7 @@
 package govalidator
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -98,14 +99,14 @@ func validateFunc(val string, options tagOptionsMap) error {
 						var err error
 						if !negate {
 							if customMsgExists {
-								err = fmt.Errorf(customErrorMessage)
+								err = errors.New(customErrorMessage)
 							} else {
 								err = fmt.Errorf("`%s` does not validate as %s", val, validator)
 							}
 
 						} else {
 							if customMsgExists {
-								err = fmt.Errorf(customErrorMessage)
+								err = errors.New(customErrorMessage)
 							} else {
 								err = fmt.Errorf("`%s` does validate as %s", val, validator)
 							}
@@ -123,13 +124,13 @@ func validateFunc(val string, options tagOptionsMap) error {
 
 				if !negate {
 					if customMsgExists {
-						err = fmt.Errorf(customErrorMessage)
+						err = errors.New(customErrorMessage)
 					} else {
 						err = fmt.Errorf("`%s` does not validate as %s", val, validator)
 					}
 				} else {
 					if customMsgExists {
-						err = fmt.Errorf(customErrorMessage)
+						err = errors.New(customErrorMessage)
 					} else {
 						err = fmt.Errorf("`%s` does validate as %s", val, validator)
 					}
@@ -0,0 +1,174 @@
+// Package playground adds support for go-playground/validator library.
+//
+// Validation rules are taken from `validate` tag.
+// New returns ValidateFunc, that checks single values of flags before they are set,
+// Struct checks the whole structure after parsing, including cross-field
+// (e.g. gtfield) and conditional (e.g. required_if) rules.
+// Errors are reported with flag names instead of names of structure fields.
+package playground
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/octago/sflags"
+)
+
+const (
+	validTag = "validate"
+)
+
+// Validate is used by New and Struct.
+// Custom validations and aliases can be registered in it.
+var Validate = validator.New()
+
+// isStructRule returns true if rule can't be checked for a single value,
+// because it depends on other fields or on the whole structure.
+func isStructRule(rule string) bool {
+	name := strings.SplitN(rule, "=", 2)[0]
+	return strings.Contains(name, "field") ||
+		strings.HasPrefix(name, "required") ||
+		strings.HasPrefix(name, "excluded")
+}
+
+// valueRules returns rules from tag, that can be checked for a single value.
+func valueRules(tag string) string {
+	var rules []string
+	for _, rule := range strings.Split(tag, ",") {
+		if rule == "dive" || rule == "keys" {
+			break
+		}
+		if rule == "" || isStructRule(rule) {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return strings.Join(rules, ",")
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// parseValue converts val to a value of typ.
+// Only basic types are supported, false is returned for others.
+func parseValue(val string, typ reflect.Type) (interface{}, bool) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	var (
+		res interface{}
+		err error
+	)
+	switch kind := typ.Kind(); {
+	case typ == durationType:
+		res, err = time.ParseDuration(val)
+	case kind == reflect.String:
+		res = val
+	case kind == reflect.Bool:
+		res, err = strconv.ParseBool(val)
+	case kind >= reflect.Int && kind <= reflect.Int64:
+		res, err = strconv.ParseInt(val, 0, 64)
+	case kind >= reflect.Uint && kind <= reflect.Uint64:
+		res, err = strconv.ParseUint(val, 0, 64)
+	case kind == reflect.Float32 || kind == reflect.Float64:
+		res, err = strconv.ParseFloat(val, 64)
+	default:
+		return nil, false
+	}
+	// parsing errors are reported by values of flags
+	return res, err == nil
+}
+
+// New returns ValidateFunc for go-playground/validator library.
+// It checks values of basic types (strings, numbers, bools and durations)
+// with rules, that don't depend on other fields.
+// Use Struct after parsing to check the rest of rules.
+func New() func(val string, field reflect.StructField, obj interface{}) error {
+	return func(val string, field reflect.StructField, obj interface{}) error {
+		rules := valueRules(field.Tag.Get(validTag))
+		if rules == "" {
+			return nil
+		}
+		value, ok := parseValue(val, field.Type)
+		if !ok {
+			return nil
+		}
+		if err := Validate.Var(value, rules); err != nil {
+			if errs, casted := err.(validator.ValidationErrors); casted && len(errs) > 0 {
+				return fmt.Errorf("`%s` does not validate as %s", val, rule(errs[0], "", nil))
+			}
+			return err
+		}
+		return nil
+	}
+}
+
+// Struct validates cfg, that is a pointer to some structure,
+// and maps errors to flags, that are parsed from this structure.
+// All errors are returned as a single error.
+func Struct(cfg interface{}, flags []*sflags.Flag) error {
+	err := Validate.Struct(cfg)
+	if err == nil {
+		return nil
+	}
+	errs, casted := err.(validator.ValidationErrors)
+	if !casted {
+		return err
+	}
+	byPath := make(map[string]*sflags.Flag, len(flags))
+	for _, flag := range flags {
+		byPath[flag.Path] = flag
+	}
+	// namespace of errors starts with the name of the root structure, if it's not anonymous
+	root := reflect.Indirect(reflect.ValueOf(cfg)).Type().Name()
+	msgs := make([]string, 0, len(errs))
+	for _, fieldErr := range errs {
+		name := fieldPath(fieldErr, root)
+		if flag, found := byPath[name]; found {
+			name = "flag " + flag.Name
+		}
+		msgs = append(msgs, fmt.Sprintf("%s: `%v` does not validate as %s",
+			name, fieldErr.Value(), rule(fieldErr, root, byPath)))
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
+
+// fieldPath returns path of the field from the root structure, e.g. "HTTP.Host".
+func fieldPath(fieldErr validator.FieldError, root string) string {
+	path := fieldErr.StructNamespace()
+	if root != "" {
+		path = strings.TrimPrefix(path, root+".")
+	}
+	return path
+}
+
+// rule returns failed rule with its parameter,
+// names of fields in parameters of cross-field and conditional rules are replaced by flag names.
+func rule(fieldErr validator.FieldError, root string, byPath map[string]*sflags.Flag) string {
+	tag, param := fieldErr.Tag(), fieldErr.Param()
+	if param == "" {
+		return tag
+	}
+	if !isStructRule(tag) {
+		return tag + "=" + param
+	}
+	parent := fieldPath(fieldErr, root)
+	if i := strings.LastIndex(parent, "."); i >= 0 {
+		parent = parent[:i+1]
+	} else {
+		parent = ""
+	}
+	words := strings.Split(param, " ")
+	for i, word := range words {
+		if flag, found := byPath[parent+word]; found {
+			words[i] = flag.Name
+		} else if flag, found := byPath[word]; found {
+			words[i] = flag.Name
+		}
+	}
+	return tag + "=" + strings.Join(words, " ")
+}
@@ -0,0 +1,165 @@
+package playground
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/octago/sflags"
+	"github.com/octago/sflags/gen/gflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ExampleNew() {
+	type config struct {
+		Host string `validate:"hostname"`
+		Port int    `validate:"min=1,max=65535"`
+	}
+	cfg := &config{
+		Host: "localhost",
+		Port: 6000,
+	}
+	// Use gflags.ParseToDef if you want default `flag.CommandLine`
+	fs, err := gflag.Parse(cfg, sflags.Validator(New()))
+	if err != nil {
+		log.Fatalf("err: %v", err)
+	}
+	fs.Init("text", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+
+	// if we pass a wrong domain to the host flag, we'll get a error.
+	if err = fs.Parse([]string{"-host", "wrong domain"}); err != nil {
+		fmt.Printf("err: %v\n", err)
+	}
+	// if we pass a wrong port to the port flag, we'll get a error.
+	if err = fs.Parse([]string{"-port", "800000"}); err != nil {
+		fmt.Printf("err: %v\n", err)
+	}
+	// Output:
+	// err: invalid value "wrong domain" for flag -host: `wrong domain` does not validate as hostname
+	// err: invalid value "800000" for flag -port: `800000` does not validate as max=65535
+}
+
+func ExampleStruct() {
+	type config struct {
+		Min  int    `validate:"min=0"`
+		Max  int    `validate:"gtfield=Min"`
+		Mode string `validate:"oneof=http https"`
+		Cert string `validate:"required_if=Mode https"`
+	}
+	cfg := &config{Min: 10, Max: 20, Mode: "http"}
+	flags, err := sflags.ParseStruct(cfg)
+	if err != nil {
+		log.Fatalf("err: %v", err)
+	}
+	fs := flag.NewFlagSet("text", flag.ContinueOnError)
+	gflag.GenerateTo(flags, fs)
+
+	if err = fs.Parse([]string{"-max", "5", "-mode", "https"}); err != nil {
+		log.Fatalf("err: %v", err)
+	}
+	// cross-field rules are checked after parsing
+	if err = Struct(cfg, flags); err != nil {
+		fmt.Printf("err: %v\n", err)
+	}
+	// Output:
+	// err: flag max: `5` does not validate as gtfield=min; flag cert: `` does not validate as required_if=mode https
+}
+
+func TestValueRules(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{"", ""},
+		{"min=1,max=10", "min=1,max=10"},
+		{"required,gtfield=Min,lt=5", "lt=5"},
+		{"omitempty,required_with=Host,hostname", "omitempty,hostname"},
+		{"min=1,dive,max=3", "min=1"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, valueRules(tt.tag), "for %v", tt.tag)
+	}
+}
+
+func TestNew(t *testing.T) {
+	cfg := &struct {
+		Name     string        `validate:"required,min=3"`
+		Count    uint          `validate:"lte=10"`
+		Ratio    float64       `validate:"gt=0"`
+		Timeout  time.Duration `validate:"gte=1s"`
+		Enabled  *bool         `validate:"eq=true"`
+		Hosts    []string      `validate:"dive,hostname"`
+		NoRules  string
+		BadCount int `validate:"min=1"`
+	}{}
+	validate := New()
+	field := func(name string) reflect.StructField {
+		f, found := reflect.TypeOf(cfg).Elem().FieldByName(name)
+		require.True(t, found)
+		return f
+	}
+	tests := []struct {
+		field  string
+		val    string
+		expErr string
+	}{
+		{"Name", "abc", ""},
+		{"Name", "ab", "`ab` does not validate as min=3"},
+		{"Count", "11", "`11` does not validate as lte=10"},
+		{"Ratio", "0", "`0` does not validate as gt=0"},
+		{"Timeout", "10ms", "`10ms` does not validate as gte=1s"},
+		{"Timeout", "10s", ""},
+		{"Enabled", "false", "`false` does not validate as eq=true"},
+		{"Hosts", "bad host", ""},
+		{"NoRules", "anything", ""},
+		// parsing errors are reported by flag values
+		{"BadCount", "abc", ""},
+	}
+	for _, tt := range tests {
+		err := validate(tt.val, field(tt.field), cfg)
+		if tt.expErr == "" {
+			assert.NoError(t, err, "for %s=%s", tt.field, tt.val)
+		} else {
+			assert.EqualError(t, err, tt.expErr, "for %s=%s", tt.field, tt.val)
+		}
+	}
+}
+
+func TestStruct(t *testing.T) {
+	type tlsConfig struct {
+		Cert string `validate:"required_with=Key"`
+		Key  string
+	}
+	cfg := &struct {
+		HTTP struct {
+			Min int `validate:"min=0"`
+			Max int `validate:"gtefield=Min"`
+			TLS tlsConfig
+		}
+		Name string `validate:"required" flag:"app-name"`
+	}{}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+
+	err = Struct(cfg, flags)
+	assert.EqualError(t, err, "flag app-name: `` does not validate as required")
+
+	cfg.Name = "app"
+	cfg.HTTP.Min = 10
+	cfg.HTTP.TLS.Key = "key.pem"
+	err = Struct(cfg, flags)
+	assert.EqualError(t, err, "flag http-max: `0` does not validate as gtefield=http-min; "+
+		"flag http-tls-cert: `` does not validate as required_with=http-tls-key")
+
+	cfg.HTTP.Max = 10
+	cfg.HTTP.TLS.Cert = "cert.pem"
+	assert.NoError(t, Struct(cfg, flags))
+
+	assert.Error(t, Struct("bad config", flags))
+}
@@ -4,9 +4,12 @@ package sflags
 
 import (
 	"fmt"
+	"math/big"
 	"net"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Value is the interface to the dynamic value stored in v flag.
@@ -14,8 +17,8 @@ import (
 //
 // If v Value has an IsBoolFlag() bool method returning true, the command-line
 // parser makes --name equivalent to -name=true rather than using the next
-// command-line argument, and adds v --no-name counterpart for negating the
-// flag.
+// command-line argument. Fields with negatable option in flag tag also get
+// v --no-name counterpart for negating the flag (see Flag.Negation).
 type Value interface {
 	String() string
 	Set(string) error
@@ -34,7 +37,8 @@ type Getter interface {
 }
 
 // BoolFlag is an optional interface to indicate boolean flags
-// that don't accept v value, and implicitly have v --no-<x> negation counterpart.
+// that don't accept v value. They might have v --no-<x> negation counterpart,
+// if their fields are negatable.
 type BoolFlag interface {
 	Value
 	IsBoolFlag() bool
@@ -75,6 +79,13 @@ func (v *validateValue) String() string {
 	return v.Value.String()
 }
 
+func (v *validateValue) Get() interface{} {
+	if getter, casted := v.Value.(Getter); casted {
+		return getter.Get()
+	}
+	return nil
+}
+
 func (v *validateValue) Set(val string) error {
 	if v.validateFunc != nil {
 		err := v.validateFunc(val)
@@ -85,6 +96,59 @@ func (v *validateValue) Set(val string) error {
 	return v.Value.Set(val)
 }
 
+// newChoicesValue returns a value, that accepts only one of choices.
+// Every element is checked for slices, other cumulative values are split by comma.
+func newChoicesValue(val Value, choices []string) Value {
+	return &validateValue{
+		Value: val,
+		validateFunc: func(s string) error {
+			parts := []string{s}
+			if sep, isSlice := sliceSeparatorOf(val); isSlice {
+				var err error
+				if parts, err = splitSlice(s, sep); err != nil {
+					return err
+				}
+			} else if repeatable, casted := val.(RepeatableFlag); casted && repeatable.IsCumulative() {
+				parts = strings.Split(s, ",")
+			}
+			for _, part := range parts {
+				if !hasOption(choices, part) {
+					return fmt.Errorf("invalid value %q, allowed values: %s", part, strings.Join(choices, ", "))
+				}
+			}
+			return nil
+		},
+	}
+}
+
+const negationPrefix = "no-"
+
+// negatedValue sets the opposite value to a negatable boolean flag, e.g. --no-verbose sets false to --verbose.
+type negatedValue struct {
+	flag *Flag
+}
+
+var _ BoolFlag = (*negatedValue)(nil)
+
+func (v *negatedValue) Set(s string) error {
+	// flag package pass true if BoolFlag doesn't have an argument.
+	if s == "" {
+		s = "true"
+	}
+	negated, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	return v.flag.Value.Set(strconv.FormatBool(!negated))
+}
+
+// String returns "false", because the negation isn't set by default.
+func (v *negatedValue) String() string { return "false" }
+
+func (v *negatedValue) Type() string { return "bool" }
+
+func (v *negatedValue) IsBoolFlag() bool { return true }
+
 // HexBytes might be used if you want to parse slice of bytes as hex string.
 // Original `[]byte` or `[]uint8` parsed as a list of `uint8`.
 type HexBytes []byte
@@ -135,11 +199,227 @@ func (v Counter) IsCumulative() bool { return true }
 // Type returns `count` for Counter, it's mostly for pflag compatibility.
 func (v Counter) Type() string { return "count" }
 
+// ByteSize is a number of bytes, that is parsed with SI or IEC units,
+// e.g. `512KiB`, `1.5GB` or `10M`. Unit suffixes are case insensitive,
+// K, M, G, T, P and E are powers of 1000, Ki, Mi, Gi, Ti, Pi and Ei are powers of 1024,
+// the trailing B is optional.
+type ByteSize uint64
+
+// String returns size with the largest unit, that represents it exactly, e.g. `512KiB` or `2MB`.
+func (b ByteSize) String() string {
+	// the shortest of IEC and SI values, e.g. 1.5KiB or 1.5GB
+	iec, si := formatByteSize(uint64(b), iecUnits), formatByteSize(uint64(b), siUnits)
+	switch {
+	case iec == "" && si == "":
+		return strconv.FormatUint(uint64(b), 10) + "B"
+	case iec == "" || si != "" && len(si) < len(iec):
+		return si
+	}
+	return iec
+}
+
+// formatByteSize formats b in the largest of units, that keeps it exact
+// with up to two digits after the point. It returns empty string, if there is no such unit.
+func formatByteSize(b uint64, units []byteUnit) string {
+	for _, unit := range units {
+		if b < unit.size {
+			continue
+		}
+		val := strconv.FormatUint(b/unit.size, 10)
+		if b%unit.size != 0 {
+			val = strconv.FormatFloat(float64(b)/float64(unit.size), 'f', 2, 64)
+			val = strings.TrimRight(strings.TrimRight(val, "0"), ".")
+		}
+		if parsed, err := parseByteSize(val + unit.name); err == nil && uint64(parsed) == b {
+			return val + unit.name
+		}
+	}
+	return ""
+}
+
+type byteUnit struct {
+	name string
+	size uint64
+}
+
+// iecUnits and siUnits are ordered from the largest to the smallest.
+var (
+	iecUnits = []byteUnit{
+		{"EiB", 1 << 60}, {"PiB", 1 << 50}, {"TiB", 1 << 40}, {"GiB", 1 << 30}, {"MiB", 1 << 20}, {"KiB", 1 << 10},
+	}
+	siUnits = []byteUnit{
+		{"EB", 1e18}, {"PB", 1e15}, {"TB", 1e12}, {"GB", 1e9}, {"MB", 1e6}, {"KB", 1e3},
+	}
+)
+
 // === Some patches for generated flags
 
 // IsBoolFlag returns true. boolValue implements BoolFlag interface.
 func (v *boolValue) IsBoolFlag() bool { return true }
 
+// === Slice separators
+
+const (
+	defaultSliceSeparator = ","
+	// SeparatorNone is a separator of slices, that aren't split,
+	// every value is a single element, e.g. `--query "a,b" --query c`.
+	SeparatorNone = "none"
+)
+
+// sliceSeparated is implemented by slice values, that split elements by configurable separator.
+type sliceSeparated interface {
+	sliceSeparator() string
+	setSliceSeparator(sep string)
+}
+
+// sliceSeparatorOf returns separator of elements of v, that may be wrapped by other values.
+// It returns false if v isn't a slice value.
+func sliceSeparatorOf(v Value) (string, bool) {
+	sliceVal, casted := unwrapValue(v).(sliceSeparated)
+	if !casted {
+		return "", false
+	}
+	if sep := sliceVal.sliceSeparator(); sep != "" {
+		return sep, true
+	}
+	return defaultSliceSeparator, true
+}
+
+// splitSlice splits s to elements by sep, sep is comma if it's empty.
+// Elements might be quoted in CSV style to contain separators, e.g. `"a,b",c` is split to `a,b` and `c`,
+// quotes inside quoted element are escaped by doubling them. s isn't split if sep is SeparatorNone.
+func splitSlice(s, sep string) ([]string, error) {
+	switch sep {
+	case "":
+		sep = defaultSliceSeparator
+	case SeparatorNone:
+		return []string{s}, nil
+	}
+	if !strings.Contains(s, `"`) {
+		return strings.Split(s, sep), nil
+	}
+	out := []string{}
+	for {
+		if !strings.HasPrefix(s, `"`) {
+			i := strings.Index(s, sep)
+			if i < 0 {
+				return append(out, s), nil
+			}
+			out = append(out, s[:i])
+			s = s[i+len(sep):]
+			continue
+		}
+		elem := make([]byte, 0, len(s))
+		i := 1
+		for ; i < len(s); i++ {
+			if s[i] != '"' {
+				elem = append(elem, s[i])
+				continue
+			}
+			if i+1 < len(s) && s[i+1] == '"' {
+				elem = append(elem, '"')
+				i++
+				continue
+			}
+			break
+		}
+		if i >= len(s) {
+			return nil, fmt.Errorf("unterminated quoted element in %q", s)
+		}
+		out = append(out, string(elem))
+		s = s[i+1:]
+		if s == "" {
+			return out, nil
+		}
+		if !strings.HasPrefix(s, sep) {
+			return nil, fmt.Errorf("unexpected %q after quoted element", s)
+		}
+		s = s[len(sep):]
+	}
+}
+
+// joinSlice joins elements by sep, elements with separators or quotes are quoted,
+// so they're split by splitSlice as is. sep shouldn't be SeparatorNone.
+func joinSlice(elems []string, sep string) string {
+	out := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		out = append(out, quoteSliceElem(elem, sep))
+	}
+	return strings.Join(out, sep)
+}
+
+// quoteSliceElem quotes elem in CSV style, if it's empty, contains sep or starts with quote.
+func quoteSliceElem(elem, sep string) string {
+	if sep == SeparatorNone || elem != "" && !strings.Contains(elem, sep) && !strings.HasPrefix(elem, `"`) {
+		return elem
+	}
+	return `"` + strings.Replace(elem, `"`, `""`, -1) + `"`
+}
+
+// === Map separators
+
+const defaultMapSeparator = ":"
+
+// mapSeparated is implemented by map values, that split key and value by configurable separator.
+type mapSeparated interface {
+	mapSeparator() string
+	setMapSeparator(sep string)
+}
+
+// mapSeparatorOf returns separator of key and value of v, that may be wrapped by other values.
+func mapSeparatorOf(v Value) string {
+	if mapVal, casted := unwrapValue(v).(mapSeparated); casted && mapVal.mapSeparator() != "" {
+		return mapVal.mapSeparator()
+	}
+	return defaultMapSeparator
+}
+
+// unwrapValue returns value, that is wrapped by sourceValue and validateValue.
+func unwrapValue(v Value) Value {
+	for {
+		switch casted := v.(type) {
+		case *sourceValue:
+			v = casted.Value
+		case *boolSourceValue:
+			v = casted.Value
+		case *validateValue:
+			v = casted.Value
+		default:
+			return v
+		}
+	}
+}
+
+// splitKeyValue splits s to key and value by the first separator, that isn't escaped by backslash,
+// e.g. `a\:b:c` is split to `a:b` and `c`. sep is ":" if it's empty.
+// Value is returned as is, so it may contain separators, e.g. `api:http://host:8080`.
+func splitKeyValue(s, sep string) (string, string, error) {
+	if sep == "" {
+		sep = defaultMapSeparator
+	}
+	key := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && strings.HasPrefix(s[i+1:], "\\"):
+			key = append(key, '\\')
+			i++
+		case s[i] == '\\' && strings.HasPrefix(s[i+1:], sep):
+			key = append(key, sep...)
+			i += len(sep)
+		case strings.HasPrefix(s[i:], sep):
+			return string(key), s[i+len(sep):], nil
+		default:
+			key = append(key, s[i])
+		}
+	}
+	return "", "", fmt.Errorf("invalid map flag syntax, use -map=key1%sval1", sep)
+}
+
+// escapeMapKey escapes separators and backslashes in key, so it's split by splitKeyValue as is.
+func escapeMapKey(key, sep string) string {
+	return strings.NewReplacer("\\", "\\\\", sep, "\\"+sep).Replace(key)
+}
+
 // === Custom parsers
 
 func parseIP(s string) (net.IP, error) {
@@ -165,3 +445,123 @@ func parseIPNet(s string) (net.IPNet, error) {
 	}
 	return *ipNet, nil
 }
+
+func parseUDPAddr(s string) (net.UDPAddr, error) {
+	udpAddr, err := net.ResolveUDPAddr("udp", strings.TrimSpace(s))
+	if err != nil {
+		return net.UDPAddr{}, fmt.Errorf("failed to parse UDPAddr: %q", s)
+	}
+	return *udpAddr, nil
+}
+
+// defaultTimeLayouts are used to parse time.Time values without layout, they are tried in order.
+// The first one is used to print values.
+var defaultTimeLayouts = []string{time.RFC3339Nano, "2006-01-02"}
+
+// layouted is implemented by values, that are parsed and printed by configurable layout, e.g. time.Time.
+type layouted interface {
+	setLayout(layout string)
+}
+
+func parseTime(s, layout string) (time.Time, error) {
+	layouts := defaultTimeLayouts
+	if layout != "" {
+		layouts = []string{layout}
+	}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("failed to parse time: %q, allowed layouts: %s", s, strings.Join(layouts, ", "))
+}
+
+func formatTime(t time.Time, layout string) string {
+	if layout == "" {
+		layout = defaultTimeLayouts[0]
+	}
+	return t.Format(layout)
+}
+
+// validStringer is implemented by values, that might be invalid, e.g. netip.Addr.
+type validStringer interface {
+	IsValid() bool
+	String() string
+}
+
+// formatValid prints invalid values as "", e.g. zero netip.Addr instead of "invalid IP".
+func formatValid(v validStringer) string {
+	if !v.IsValid() {
+		return ""
+	}
+	return v.String()
+}
+
+func parseBigInt(s string) (*big.Int, error) {
+	i, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse big.Int: %q", s)
+	}
+	return i, nil
+}
+
+func parseBigFloat(s string) (*big.Float, error) {
+	f, _, err := big.ParseFloat(s, 0, 0, big.ToNearestEven)
+	return f, err
+}
+
+// parseFileMode parses file mode as an octal number, e.g. 0644 or 755.
+func parseFileMode(s string) (os.FileMode, error) {
+	mode, err := strconv.ParseUint(s, 8, 32)
+	if err != nil {
+		return 0, err
+	}
+	return os.FileMode(mode), nil
+}
+
+func parseByteSize(s string) (ByteSize, error) {
+	trimmed := strings.TrimSpace(s)
+	i := len(trimmed)
+	for i > 0 && (trimmed[i-1] < '0' || trimmed[i-1] > '9') && trimmed[i-1] != '.' {
+		i--
+	}
+	num, suffix := trimmed[:i], strings.ToUpper(strings.TrimSpace(trimmed[i:]))
+	if num == "" {
+		return 0, fmt.Errorf("failed to parse ByteSize: %q", s)
+	}
+	if suffix != "B" {
+		suffix = strings.TrimSuffix(suffix, "B")
+	}
+	multiplier := uint64(0)
+	switch suffix {
+	case "", "B":
+		multiplier = 1
+	default:
+		for _, unit := range append(iecUnits, siUnits...) {
+			if strings.TrimSuffix(strings.ToUpper(unit.name), "B") == suffix {
+				multiplier = unit.size
+				break
+			}
+		}
+	}
+	if multiplier == 0 {
+		return 0, fmt.Errorf("failed to parse ByteSize: %q, unknown unit", s)
+	}
+	// the number is multiplied as an integer, so fractions are exact, e.g. 4.35MB
+	intPart, fracPart, _ := strings.Cut(num, ".")
+	digits := intPart + fracPart
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return 0, fmt.Errorf("failed to parse ByteSize: %q", s)
+	}
+	size, _ := new(big.Int).SetString(digits, 10)
+	size.Mul(size, new(big.Int).SetUint64(multiplier))
+	div := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(fracPart))), nil)
+	size, rem := size.QuoRem(size, div, new(big.Int))
+	if rem.Sign() != 0 {
+		return 0, fmt.Errorf("failed to parse ByteSize: %q, not a whole number of bytes", s)
+	}
+	if !size.IsUint64() {
+		return 0, fmt.Errorf("failed to parse ByteSize: %q, value out of range", s)
+	}
+	return ByteSize(size.Uint64()), nil
+}
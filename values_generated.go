@@ -4,9 +4,12 @@ package sflags
 
 import (
 	"encoding/hex"
-	"errors"
 	"fmt"
+	"math/big"
 	"net"
+	"net/netip"
+	"net/url"
+	"os"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -69,6 +72,22 @@ func parseGenerated(value interface{}) Value {
 		return newTCPAddrValue(value.(*net.TCPAddr))
 	case *net.IPNet:
 		return newIPNetValue(value.(*net.IPNet))
+	case *time.Time:
+		return newTimeValue(value.(*time.Time))
+	case *netip.Addr:
+		return newNetipAddrValue(value.(*netip.Addr))
+	case *netip.Prefix:
+		return newNetipPrefixValue(value.(*netip.Prefix))
+	case *netip.AddrPort:
+		return newNetipAddrPortValue(value.(*netip.AddrPort))
+	case *net.UDPAddr:
+		return newUDPAddrValue(value.(*net.UDPAddr))
+	case *net.HardwareAddr:
+		return newHardwareAddrValue(value.(*net.HardwareAddr))
+	case *os.FileMode:
+		return newFileModeValue(value.(*os.FileMode))
+	case *ByteSize:
+		return newByteSizeValue(value.(*ByteSize))
 	case *[]string:
 		return newStringSliceValue(value.(*[]string))
 	case *[]bool:
@@ -109,6 +128,30 @@ func parseGenerated(value interface{}) Value {
 		return newTCPAddrSliceValue(value.(*[]net.TCPAddr))
 	case *[]net.IPNet:
 		return newIPNetSliceValue(value.(*[]net.IPNet))
+	case *[]*url.URL:
+		return newURLSliceValue(value.(*[]*url.URL))
+	case *[]time.Time:
+		return newTimeSliceValue(value.(*[]time.Time))
+	case *[]netip.Addr:
+		return newNetipAddrSliceValue(value.(*[]netip.Addr))
+	case *[]netip.Prefix:
+		return newNetipPrefixSliceValue(value.(*[]netip.Prefix))
+	case *[]netip.AddrPort:
+		return newNetipAddrPortSliceValue(value.(*[]netip.AddrPort))
+	case *[]net.UDPAddr:
+		return newUDPAddrSliceValue(value.(*[]net.UDPAddr))
+	case *[]net.HardwareAddr:
+		return newHardwareAddrSliceValue(value.(*[]net.HardwareAddr))
+	case *[]*big.Int:
+		return newBigIntSliceValue(value.(*[]*big.Int))
+	case *[]*big.Float:
+		return newBigFloatSliceValue(value.(*[]*big.Float))
+	case *[]os.FileMode:
+		return newFileModeSliceValue(value.(*[]os.FileMode))
+	case *[]*time.Location:
+		return newLocationSliceValue(value.(*[]*time.Location))
+	case *[]ByteSize:
+		return newByteSizeSliceValue(value.(*[]ByteSize))
 	default:
 		return nil
 	}
@@ -118,6 +161,14 @@ func parseGeneratedPtrs(value interface{}) Value {
 	switch value.(type) {
 	case **regexp.Regexp:
 		return newRegexpValue(value.(**regexp.Regexp))
+	case **url.URL:
+		return newURLValue(value.(**url.URL))
+	case **big.Int:
+		return newBigIntValue(value.(**big.Int))
+	case **big.Float:
+		return newBigFloatValue(value.(**big.Float))
+	case **time.Location:
+		return newLocationValue(value.(**time.Location))
 	default:
 		return nil
 	}
@@ -543,6 +594,270 @@ func parseGeneratedMap(value interface{}) Value {
 		return newUint32IPNetMapValue(value.(*map[uint32]net.IPNet))
 	case *map[uint64]net.IPNet:
 		return newUint64IPNetMapValue(value.(*map[uint64]net.IPNet))
+	case *map[string]*url.URL:
+		return newStringURLMapValue(value.(*map[string]*url.URL))
+	case *map[int]*url.URL:
+		return newIntURLMapValue(value.(*map[int]*url.URL))
+	case *map[int8]*url.URL:
+		return newInt8URLMapValue(value.(*map[int8]*url.URL))
+	case *map[int16]*url.URL:
+		return newInt16URLMapValue(value.(*map[int16]*url.URL))
+	case *map[int32]*url.URL:
+		return newInt32URLMapValue(value.(*map[int32]*url.URL))
+	case *map[int64]*url.URL:
+		return newInt64URLMapValue(value.(*map[int64]*url.URL))
+	case *map[uint]*url.URL:
+		return newUintURLMapValue(value.(*map[uint]*url.URL))
+	case *map[uint8]*url.URL:
+		return newUint8URLMapValue(value.(*map[uint8]*url.URL))
+	case *map[uint16]*url.URL:
+		return newUint16URLMapValue(value.(*map[uint16]*url.URL))
+	case *map[uint32]*url.URL:
+		return newUint32URLMapValue(value.(*map[uint32]*url.URL))
+	case *map[uint64]*url.URL:
+		return newUint64URLMapValue(value.(*map[uint64]*url.URL))
+	case *map[string]time.Time:
+		return newStringTimeMapValue(value.(*map[string]time.Time))
+	case *map[int]time.Time:
+		return newIntTimeMapValue(value.(*map[int]time.Time))
+	case *map[int8]time.Time:
+		return newInt8TimeMapValue(value.(*map[int8]time.Time))
+	case *map[int16]time.Time:
+		return newInt16TimeMapValue(value.(*map[int16]time.Time))
+	case *map[int32]time.Time:
+		return newInt32TimeMapValue(value.(*map[int32]time.Time))
+	case *map[int64]time.Time:
+		return newInt64TimeMapValue(value.(*map[int64]time.Time))
+	case *map[uint]time.Time:
+		return newUintTimeMapValue(value.(*map[uint]time.Time))
+	case *map[uint8]time.Time:
+		return newUint8TimeMapValue(value.(*map[uint8]time.Time))
+	case *map[uint16]time.Time:
+		return newUint16TimeMapValue(value.(*map[uint16]time.Time))
+	case *map[uint32]time.Time:
+		return newUint32TimeMapValue(value.(*map[uint32]time.Time))
+	case *map[uint64]time.Time:
+		return newUint64TimeMapValue(value.(*map[uint64]time.Time))
+	case *map[string]netip.Addr:
+		return newStringNetipAddrMapValue(value.(*map[string]netip.Addr))
+	case *map[int]netip.Addr:
+		return newIntNetipAddrMapValue(value.(*map[int]netip.Addr))
+	case *map[int8]netip.Addr:
+		return newInt8NetipAddrMapValue(value.(*map[int8]netip.Addr))
+	case *map[int16]netip.Addr:
+		return newInt16NetipAddrMapValue(value.(*map[int16]netip.Addr))
+	case *map[int32]netip.Addr:
+		return newInt32NetipAddrMapValue(value.(*map[int32]netip.Addr))
+	case *map[int64]netip.Addr:
+		return newInt64NetipAddrMapValue(value.(*map[int64]netip.Addr))
+	case *map[uint]netip.Addr:
+		return newUintNetipAddrMapValue(value.(*map[uint]netip.Addr))
+	case *map[uint8]netip.Addr:
+		return newUint8NetipAddrMapValue(value.(*map[uint8]netip.Addr))
+	case *map[uint16]netip.Addr:
+		return newUint16NetipAddrMapValue(value.(*map[uint16]netip.Addr))
+	case *map[uint32]netip.Addr:
+		return newUint32NetipAddrMapValue(value.(*map[uint32]netip.Addr))
+	case *map[uint64]netip.Addr:
+		return newUint64NetipAddrMapValue(value.(*map[uint64]netip.Addr))
+	case *map[string]netip.Prefix:
+		return newStringNetipPrefixMapValue(value.(*map[string]netip.Prefix))
+	case *map[int]netip.Prefix:
+		return newIntNetipPrefixMapValue(value.(*map[int]netip.Prefix))
+	case *map[int8]netip.Prefix:
+		return newInt8NetipPrefixMapValue(value.(*map[int8]netip.Prefix))
+	case *map[int16]netip.Prefix:
+		return newInt16NetipPrefixMapValue(value.(*map[int16]netip.Prefix))
+	case *map[int32]netip.Prefix:
+		return newInt32NetipPrefixMapValue(value.(*map[int32]netip.Prefix))
+	case *map[int64]netip.Prefix:
+		return newInt64NetipPrefixMapValue(value.(*map[int64]netip.Prefix))
+	case *map[uint]netip.Prefix:
+		return newUintNetipPrefixMapValue(value.(*map[uint]netip.Prefix))
+	case *map[uint8]netip.Prefix:
+		return newUint8NetipPrefixMapValue(value.(*map[uint8]netip.Prefix))
+	case *map[uint16]netip.Prefix:
+		return newUint16NetipPrefixMapValue(value.(*map[uint16]netip.Prefix))
+	case *map[uint32]netip.Prefix:
+		return newUint32NetipPrefixMapValue(value.(*map[uint32]netip.Prefix))
+	case *map[uint64]netip.Prefix:
+		return newUint64NetipPrefixMapValue(value.(*map[uint64]netip.Prefix))
+	case *map[string]netip.AddrPort:
+		return newStringNetipAddrPortMapValue(value.(*map[string]netip.AddrPort))
+	case *map[int]netip.AddrPort:
+		return newIntNetipAddrPortMapValue(value.(*map[int]netip.AddrPort))
+	case *map[int8]netip.AddrPort:
+		return newInt8NetipAddrPortMapValue(value.(*map[int8]netip.AddrPort))
+	case *map[int16]netip.AddrPort:
+		return newInt16NetipAddrPortMapValue(value.(*map[int16]netip.AddrPort))
+	case *map[int32]netip.AddrPort:
+		return newInt32NetipAddrPortMapValue(value.(*map[int32]netip.AddrPort))
+	case *map[int64]netip.AddrPort:
+		return newInt64NetipAddrPortMapValue(value.(*map[int64]netip.AddrPort))
+	case *map[uint]netip.AddrPort:
+		return newUintNetipAddrPortMapValue(value.(*map[uint]netip.AddrPort))
+	case *map[uint8]netip.AddrPort:
+		return newUint8NetipAddrPortMapValue(value.(*map[uint8]netip.AddrPort))
+	case *map[uint16]netip.AddrPort:
+		return newUint16NetipAddrPortMapValue(value.(*map[uint16]netip.AddrPort))
+	case *map[uint32]netip.AddrPort:
+		return newUint32NetipAddrPortMapValue(value.(*map[uint32]netip.AddrPort))
+	case *map[uint64]netip.AddrPort:
+		return newUint64NetipAddrPortMapValue(value.(*map[uint64]netip.AddrPort))
+	case *map[string]net.UDPAddr:
+		return newStringUDPAddrMapValue(value.(*map[string]net.UDPAddr))
+	case *map[int]net.UDPAddr:
+		return newIntUDPAddrMapValue(value.(*map[int]net.UDPAddr))
+	case *map[int8]net.UDPAddr:
+		return newInt8UDPAddrMapValue(value.(*map[int8]net.UDPAddr))
+	case *map[int16]net.UDPAddr:
+		return newInt16UDPAddrMapValue(value.(*map[int16]net.UDPAddr))
+	case *map[int32]net.UDPAddr:
+		return newInt32UDPAddrMapValue(value.(*map[int32]net.UDPAddr))
+	case *map[int64]net.UDPAddr:
+		return newInt64UDPAddrMapValue(value.(*map[int64]net.UDPAddr))
+	case *map[uint]net.UDPAddr:
+		return newUintUDPAddrMapValue(value.(*map[uint]net.UDPAddr))
+	case *map[uint8]net.UDPAddr:
+		return newUint8UDPAddrMapValue(value.(*map[uint8]net.UDPAddr))
+	case *map[uint16]net.UDPAddr:
+		return newUint16UDPAddrMapValue(value.(*map[uint16]net.UDPAddr))
+	case *map[uint32]net.UDPAddr:
+		return newUint32UDPAddrMapValue(value.(*map[uint32]net.UDPAddr))
+	case *map[uint64]net.UDPAddr:
+		return newUint64UDPAddrMapValue(value.(*map[uint64]net.UDPAddr))
+	case *map[string]net.HardwareAddr:
+		return newStringHardwareAddrMapValue(value.(*map[string]net.HardwareAddr))
+	case *map[int]net.HardwareAddr:
+		return newIntHardwareAddrMapValue(value.(*map[int]net.HardwareAddr))
+	case *map[int8]net.HardwareAddr:
+		return newInt8HardwareAddrMapValue(value.(*map[int8]net.HardwareAddr))
+	case *map[int16]net.HardwareAddr:
+		return newInt16HardwareAddrMapValue(value.(*map[int16]net.HardwareAddr))
+	case *map[int32]net.HardwareAddr:
+		return newInt32HardwareAddrMapValue(value.(*map[int32]net.HardwareAddr))
+	case *map[int64]net.HardwareAddr:
+		return newInt64HardwareAddrMapValue(value.(*map[int64]net.HardwareAddr))
+	case *map[uint]net.HardwareAddr:
+		return newUintHardwareAddrMapValue(value.(*map[uint]net.HardwareAddr))
+	case *map[uint8]net.HardwareAddr:
+		return newUint8HardwareAddrMapValue(value.(*map[uint8]net.HardwareAddr))
+	case *map[uint16]net.HardwareAddr:
+		return newUint16HardwareAddrMapValue(value.(*map[uint16]net.HardwareAddr))
+	case *map[uint32]net.HardwareAddr:
+		return newUint32HardwareAddrMapValue(value.(*map[uint32]net.HardwareAddr))
+	case *map[uint64]net.HardwareAddr:
+		return newUint64HardwareAddrMapValue(value.(*map[uint64]net.HardwareAddr))
+	case *map[string]*big.Int:
+		return newStringBigIntMapValue(value.(*map[string]*big.Int))
+	case *map[int]*big.Int:
+		return newIntBigIntMapValue(value.(*map[int]*big.Int))
+	case *map[int8]*big.Int:
+		return newInt8BigIntMapValue(value.(*map[int8]*big.Int))
+	case *map[int16]*big.Int:
+		return newInt16BigIntMapValue(value.(*map[int16]*big.Int))
+	case *map[int32]*big.Int:
+		return newInt32BigIntMapValue(value.(*map[int32]*big.Int))
+	case *map[int64]*big.Int:
+		return newInt64BigIntMapValue(value.(*map[int64]*big.Int))
+	case *map[uint]*big.Int:
+		return newUintBigIntMapValue(value.(*map[uint]*big.Int))
+	case *map[uint8]*big.Int:
+		return newUint8BigIntMapValue(value.(*map[uint8]*big.Int))
+	case *map[uint16]*big.Int:
+		return newUint16BigIntMapValue(value.(*map[uint16]*big.Int))
+	case *map[uint32]*big.Int:
+		return newUint32BigIntMapValue(value.(*map[uint32]*big.Int))
+	case *map[uint64]*big.Int:
+		return newUint64BigIntMapValue(value.(*map[uint64]*big.Int))
+	case *map[string]*big.Float:
+		return newStringBigFloatMapValue(value.(*map[string]*big.Float))
+	case *map[int]*big.Float:
+		return newIntBigFloatMapValue(value.(*map[int]*big.Float))
+	case *map[int8]*big.Float:
+		return newInt8BigFloatMapValue(value.(*map[int8]*big.Float))
+	case *map[int16]*big.Float:
+		return newInt16BigFloatMapValue(value.(*map[int16]*big.Float))
+	case *map[int32]*big.Float:
+		return newInt32BigFloatMapValue(value.(*map[int32]*big.Float))
+	case *map[int64]*big.Float:
+		return newInt64BigFloatMapValue(value.(*map[int64]*big.Float))
+	case *map[uint]*big.Float:
+		return newUintBigFloatMapValue(value.(*map[uint]*big.Float))
+	case *map[uint8]*big.Float:
+		return newUint8BigFloatMapValue(value.(*map[uint8]*big.Float))
+	case *map[uint16]*big.Float:
+		return newUint16BigFloatMapValue(value.(*map[uint16]*big.Float))
+	case *map[uint32]*big.Float:
+		return newUint32BigFloatMapValue(value.(*map[uint32]*big.Float))
+	case *map[uint64]*big.Float:
+		return newUint64BigFloatMapValue(value.(*map[uint64]*big.Float))
+	case *map[string]os.FileMode:
+		return newStringFileModeMapValue(value.(*map[string]os.FileMode))
+	case *map[int]os.FileMode:
+		return newIntFileModeMapValue(value.(*map[int]os.FileMode))
+	case *map[int8]os.FileMode:
+		return newInt8FileModeMapValue(value.(*map[int8]os.FileMode))
+	case *map[int16]os.FileMode:
+		return newInt16FileModeMapValue(value.(*map[int16]os.FileMode))
+	case *map[int32]os.FileMode:
+		return newInt32FileModeMapValue(value.(*map[int32]os.FileMode))
+	case *map[int64]os.FileMode:
+		return newInt64FileModeMapValue(value.(*map[int64]os.FileMode))
+	case *map[uint]os.FileMode:
+		return newUintFileModeMapValue(value.(*map[uint]os.FileMode))
+	case *map[uint8]os.FileMode:
+		return newUint8FileModeMapValue(value.(*map[uint8]os.FileMode))
+	case *map[uint16]os.FileMode:
+		return newUint16FileModeMapValue(value.(*map[uint16]os.FileMode))
+	case *map[uint32]os.FileMode:
+		return newUint32FileModeMapValue(value.(*map[uint32]os.FileMode))
+	case *map[uint64]os.FileMode:
+		return newUint64FileModeMapValue(value.(*map[uint64]os.FileMode))
+	case *map[string]*time.Location:
+		return newStringLocationMapValue(value.(*map[string]*time.Location))
+	case *map[int]*time.Location:
+		return newIntLocationMapValue(value.(*map[int]*time.Location))
+	case *map[int8]*time.Location:
+		return newInt8LocationMapValue(value.(*map[int8]*time.Location))
+	case *map[int16]*time.Location:
+		return newInt16LocationMapValue(value.(*map[int16]*time.Location))
+	case *map[int32]*time.Location:
+		return newInt32LocationMapValue(value.(*map[int32]*time.Location))
+	case *map[int64]*time.Location:
+		return newInt64LocationMapValue(value.(*map[int64]*time.Location))
+	case *map[uint]*time.Location:
+		return newUintLocationMapValue(value.(*map[uint]*time.Location))
+	case *map[uint8]*time.Location:
+		return newUint8LocationMapValue(value.(*map[uint8]*time.Location))
+	case *map[uint16]*time.Location:
+		return newUint16LocationMapValue(value.(*map[uint16]*time.Location))
+	case *map[uint32]*time.Location:
+		return newUint32LocationMapValue(value.(*map[uint32]*time.Location))
+	case *map[uint64]*time.Location:
+		return newUint64LocationMapValue(value.(*map[uint64]*time.Location))
+	case *map[string]ByteSize:
+		return newStringByteSizeMapValue(value.(*map[string]ByteSize))
+	case *map[int]ByteSize:
+		return newIntByteSizeMapValue(value.(*map[int]ByteSize))
+	case *map[int8]ByteSize:
+		return newInt8ByteSizeMapValue(value.(*map[int8]ByteSize))
+	case *map[int16]ByteSize:
+		return newInt16ByteSizeMapValue(value.(*map[int16]ByteSize))
+	case *map[int32]ByteSize:
+		return newInt32ByteSizeMapValue(value.(*map[int32]ByteSize))
+	case *map[int64]ByteSize:
+		return newInt64ByteSizeMapValue(value.(*map[int64]ByteSize))
+	case *map[uint]ByteSize:
+		return newUintByteSizeMapValue(value.(*map[uint]ByteSize))
+	case *map[uint8]ByteSize:
+		return newUint8ByteSizeMapValue(value.(*map[uint8]ByteSize))
+	case *map[uint16]ByteSize:
+		return newUint16ByteSizeMapValue(value.(*map[uint16]ByteSize))
+	case *map[uint32]ByteSize:
+		return newUint32ByteSizeMapValue(value.(*map[uint32]ByteSize))
+	case *map[uint64]ByteSize:
+		return newUint64ByteSizeMapValue(value.(*map[uint64]ByteSize))
 	default:
 		return nil
 	}
@@ -586,6 +901,7 @@ func (v *stringValue) Type() string { return "string" }
 type stringSliceValue struct {
 	value   *[]string
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*stringSliceValue)(nil)
@@ -599,7 +915,10 @@ func newStringSliceValue(slice *[]string) *stringSliceValue {
 }
 
 func (v *stringSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 	out := ss
 	if !v.changed {
 		*v.value = out
@@ -634,9 +953,21 @@ func (v *stringSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *stringSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *stringSliceValue) clear() {
+	*v.value = []string{}
+	v.changed = true
+}
+
+func (v *stringSliceValue) appendNext() { v.changed = true }
+
+func (v *stringSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringStringMapValue
 type stringStringMapValue struct {
 	value *map[string]string
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringStringMapValue)(nil)
@@ -650,16 +981,16 @@ func newStringStringMapValue(m *map[string]string) *stringStringMapValue {
 }
 
 func (v *stringStringMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	val := s
 
@@ -684,6 +1015,14 @@ func (v *stringStringMapValue) String() string {
 
 func (v *stringStringMapValue) Type() string { return "map[string]string" }
 
+func (v *stringStringMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringStringMapValue) clear() { *v.value = make(map[string]string) }
+
+func (v *stringStringMapValue) appendNext() {}
+
+func (v *stringStringMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringStringMapValue) IsCumulative() bool {
 	return true
 }
@@ -691,6 +1030,7 @@ func (v *stringStringMapValue) IsCumulative() bool {
 // -- intStringMapValue
 type intStringMapValue struct {
 	value *map[int]string
+	sep   string
 }
 
 var _ RepeatableFlag = (*intStringMapValue)(nil)
@@ -704,12 +1044,12 @@ func newIntStringMapValue(m *map[int]string) *intStringMapValue {
 }
 
 func (v *intStringMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -718,7 +1058,7 @@ func (v *intStringMapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	val := s
 
@@ -743,6 +1083,14 @@ func (v *intStringMapValue) String() string {
 
 func (v *intStringMapValue) Type() string { return "map[int]string" }
 
+func (v *intStringMapValue) mapSeparator() string { return v.sep }
+
+func (v *intStringMapValue) clear() { *v.value = make(map[int]string) }
+
+func (v *intStringMapValue) appendNext() {}
+
+func (v *intStringMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intStringMapValue) IsCumulative() bool {
 	return true
 }
@@ -750,6 +1098,7 @@ func (v *intStringMapValue) IsCumulative() bool {
 // -- int8StringMapValue
 type int8StringMapValue struct {
 	value *map[int8]string
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8StringMapValue)(nil)
@@ -763,12 +1112,12 @@ func newInt8StringMapValue(m *map[int8]string) *int8StringMapValue {
 }
 
 func (v *int8StringMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -777,7 +1126,7 @@ func (v *int8StringMapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	val := s
 
@@ -802,6 +1151,14 @@ func (v *int8StringMapValue) String() string {
 
 func (v *int8StringMapValue) Type() string { return "map[int8]string" }
 
+func (v *int8StringMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8StringMapValue) clear() { *v.value = make(map[int8]string) }
+
+func (v *int8StringMapValue) appendNext() {}
+
+func (v *int8StringMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8StringMapValue) IsCumulative() bool {
 	return true
 }
@@ -809,6 +1166,7 @@ func (v *int8StringMapValue) IsCumulative() bool {
 // -- int16StringMapValue
 type int16StringMapValue struct {
 	value *map[int16]string
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16StringMapValue)(nil)
@@ -822,12 +1180,12 @@ func newInt16StringMapValue(m *map[int16]string) *int16StringMapValue {
 }
 
 func (v *int16StringMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -836,7 +1194,7 @@ func (v *int16StringMapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	val := s
 
@@ -861,6 +1219,14 @@ func (v *int16StringMapValue) String() string {
 
 func (v *int16StringMapValue) Type() string { return "map[int16]string" }
 
+func (v *int16StringMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16StringMapValue) clear() { *v.value = make(map[int16]string) }
+
+func (v *int16StringMapValue) appendNext() {}
+
+func (v *int16StringMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16StringMapValue) IsCumulative() bool {
 	return true
 }
@@ -868,6 +1234,7 @@ func (v *int16StringMapValue) IsCumulative() bool {
 // -- int32StringMapValue
 type int32StringMapValue struct {
 	value *map[int32]string
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32StringMapValue)(nil)
@@ -881,12 +1248,12 @@ func newInt32StringMapValue(m *map[int32]string) *int32StringMapValue {
 }
 
 func (v *int32StringMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -895,7 +1262,7 @@ func (v *int32StringMapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	val := s
 
@@ -920,6 +1287,14 @@ func (v *int32StringMapValue) String() string {
 
 func (v *int32StringMapValue) Type() string { return "map[int32]string" }
 
+func (v *int32StringMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32StringMapValue) clear() { *v.value = make(map[int32]string) }
+
+func (v *int32StringMapValue) appendNext() {}
+
+func (v *int32StringMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32StringMapValue) IsCumulative() bool {
 	return true
 }
@@ -927,6 +1302,7 @@ func (v *int32StringMapValue) IsCumulative() bool {
 // -- int64StringMapValue
 type int64StringMapValue struct {
 	value *map[int64]string
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64StringMapValue)(nil)
@@ -940,12 +1316,12 @@ func newInt64StringMapValue(m *map[int64]string) *int64StringMapValue {
 }
 
 func (v *int64StringMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -954,7 +1330,7 @@ func (v *int64StringMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	val := s
 
@@ -979,6 +1355,14 @@ func (v *int64StringMapValue) String() string {
 
 func (v *int64StringMapValue) Type() string { return "map[int64]string" }
 
+func (v *int64StringMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64StringMapValue) clear() { *v.value = make(map[int64]string) }
+
+func (v *int64StringMapValue) appendNext() {}
+
+func (v *int64StringMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64StringMapValue) IsCumulative() bool {
 	return true
 }
@@ -986,6 +1370,7 @@ func (v *int64StringMapValue) IsCumulative() bool {
 // -- uintStringMapValue
 type uintStringMapValue struct {
 	value *map[uint]string
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintStringMapValue)(nil)
@@ -999,12 +1384,12 @@ func newUintStringMapValue(m *map[uint]string) *uintStringMapValue {
 }
 
 func (v *uintStringMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -1013,7 +1398,7 @@ func (v *uintStringMapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	val := s
 
@@ -1038,6 +1423,14 @@ func (v *uintStringMapValue) String() string {
 
 func (v *uintStringMapValue) Type() string { return "map[uint]string" }
 
+func (v *uintStringMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintStringMapValue) clear() { *v.value = make(map[uint]string) }
+
+func (v *uintStringMapValue) appendNext() {}
+
+func (v *uintStringMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintStringMapValue) IsCumulative() bool {
 	return true
 }
@@ -1045,6 +1438,7 @@ func (v *uintStringMapValue) IsCumulative() bool {
 // -- uint8StringMapValue
 type uint8StringMapValue struct {
 	value *map[uint8]string
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8StringMapValue)(nil)
@@ -1058,12 +1452,12 @@ func newUint8StringMapValue(m *map[uint8]string) *uint8StringMapValue {
 }
 
 func (v *uint8StringMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -1072,7 +1466,7 @@ func (v *uint8StringMapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	val := s
 
@@ -1097,6 +1491,14 @@ func (v *uint8StringMapValue) String() string {
 
 func (v *uint8StringMapValue) Type() string { return "map[uint8]string" }
 
+func (v *uint8StringMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8StringMapValue) clear() { *v.value = make(map[uint8]string) }
+
+func (v *uint8StringMapValue) appendNext() {}
+
+func (v *uint8StringMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8StringMapValue) IsCumulative() bool {
 	return true
 }
@@ -1104,6 +1506,7 @@ func (v *uint8StringMapValue) IsCumulative() bool {
 // -- uint16StringMapValue
 type uint16StringMapValue struct {
 	value *map[uint16]string
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16StringMapValue)(nil)
@@ -1117,12 +1520,12 @@ func newUint16StringMapValue(m *map[uint16]string) *uint16StringMapValue {
 }
 
 func (v *uint16StringMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -1131,7 +1534,7 @@ func (v *uint16StringMapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	val := s
 
@@ -1156,6 +1559,14 @@ func (v *uint16StringMapValue) String() string {
 
 func (v *uint16StringMapValue) Type() string { return "map[uint16]string" }
 
+func (v *uint16StringMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16StringMapValue) clear() { *v.value = make(map[uint16]string) }
+
+func (v *uint16StringMapValue) appendNext() {}
+
+func (v *uint16StringMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16StringMapValue) IsCumulative() bool {
 	return true
 }
@@ -1163,6 +1574,7 @@ func (v *uint16StringMapValue) IsCumulative() bool {
 // -- uint32StringMapValue
 type uint32StringMapValue struct {
 	value *map[uint32]string
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32StringMapValue)(nil)
@@ -1176,12 +1588,12 @@ func newUint32StringMapValue(m *map[uint32]string) *uint32StringMapValue {
 }
 
 func (v *uint32StringMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -1190,7 +1602,7 @@ func (v *uint32StringMapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	val := s
 
@@ -1215,6 +1627,14 @@ func (v *uint32StringMapValue) String() string {
 
 func (v *uint32StringMapValue) Type() string { return "map[uint32]string" }
 
+func (v *uint32StringMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32StringMapValue) clear() { *v.value = make(map[uint32]string) }
+
+func (v *uint32StringMapValue) appendNext() {}
+
+func (v *uint32StringMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32StringMapValue) IsCumulative() bool {
 	return true
 }
@@ -1222,6 +1642,7 @@ func (v *uint32StringMapValue) IsCumulative() bool {
 // -- uint64StringMapValue
 type uint64StringMapValue struct {
 	value *map[uint64]string
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64StringMapValue)(nil)
@@ -1235,12 +1656,12 @@ func newUint64StringMapValue(m *map[uint64]string) *uint64StringMapValue {
 }
 
 func (v *uint64StringMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -1249,7 +1670,7 @@ func (v *uint64StringMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	val := s
 
@@ -1274,6 +1695,14 @@ func (v *uint64StringMapValue) String() string {
 
 func (v *uint64StringMapValue) Type() string { return "map[uint64]string" }
 
+func (v *uint64StringMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64StringMapValue) clear() { *v.value = make(map[uint64]string) }
+
+func (v *uint64StringMapValue) appendNext() {}
+
+func (v *uint64StringMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64StringMapValue) IsCumulative() bool {
 	return true
 }
@@ -1320,6 +1749,7 @@ func (v *boolValue) Type() string { return "bool" }
 type boolSliceValue struct {
 	value   *[]bool
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*boolSliceValue)(nil)
@@ -1333,7 +1763,10 @@ func newBoolSliceValue(slice *[]bool) *boolSliceValue {
 }
 
 func (v *boolSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]bool, len(ss))
 	for i, s := range ss {
@@ -1377,9 +1810,21 @@ func (v *boolSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *boolSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *boolSliceValue) clear() {
+	*v.value = []bool{}
+	v.changed = true
+}
+
+func (v *boolSliceValue) appendNext() { v.changed = true }
+
+func (v *boolSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringBoolMapValue
 type stringBoolMapValue struct {
 	value *map[string]bool
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringBoolMapValue)(nil)
@@ -1393,16 +1838,16 @@ func newStringBoolMapValue(m *map[string]bool) *stringBoolMapValue {
 }
 
 func (v *stringBoolMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseBool(s)
 	if err != nil {
@@ -1432,6 +1877,14 @@ func (v *stringBoolMapValue) String() string {
 
 func (v *stringBoolMapValue) Type() string { return "map[string]bool" }
 
+func (v *stringBoolMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringBoolMapValue) clear() { *v.value = make(map[string]bool) }
+
+func (v *stringBoolMapValue) appendNext() {}
+
+func (v *stringBoolMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringBoolMapValue) IsCumulative() bool {
 	return true
 }
@@ -1439,6 +1892,7 @@ func (v *stringBoolMapValue) IsCumulative() bool {
 // -- intBoolMapValue
 type intBoolMapValue struct {
 	value *map[int]bool
+	sep   string
 }
 
 var _ RepeatableFlag = (*intBoolMapValue)(nil)
@@ -1452,12 +1906,12 @@ func newIntBoolMapValue(m *map[int]bool) *intBoolMapValue {
 }
 
 func (v *intBoolMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -1466,7 +1920,7 @@ func (v *intBoolMapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseBool(s)
 	if err != nil {
@@ -1496,6 +1950,14 @@ func (v *intBoolMapValue) String() string {
 
 func (v *intBoolMapValue) Type() string { return "map[int]bool" }
 
+func (v *intBoolMapValue) mapSeparator() string { return v.sep }
+
+func (v *intBoolMapValue) clear() { *v.value = make(map[int]bool) }
+
+func (v *intBoolMapValue) appendNext() {}
+
+func (v *intBoolMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intBoolMapValue) IsCumulative() bool {
 	return true
 }
@@ -1503,6 +1965,7 @@ func (v *intBoolMapValue) IsCumulative() bool {
 // -- int8BoolMapValue
 type int8BoolMapValue struct {
 	value *map[int8]bool
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8BoolMapValue)(nil)
@@ -1516,12 +1979,12 @@ func newInt8BoolMapValue(m *map[int8]bool) *int8BoolMapValue {
 }
 
 func (v *int8BoolMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -1530,7 +1993,7 @@ func (v *int8BoolMapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseBool(s)
 	if err != nil {
@@ -1560,6 +2023,14 @@ func (v *int8BoolMapValue) String() string {
 
 func (v *int8BoolMapValue) Type() string { return "map[int8]bool" }
 
+func (v *int8BoolMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8BoolMapValue) clear() { *v.value = make(map[int8]bool) }
+
+func (v *int8BoolMapValue) appendNext() {}
+
+func (v *int8BoolMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8BoolMapValue) IsCumulative() bool {
 	return true
 }
@@ -1567,6 +2038,7 @@ func (v *int8BoolMapValue) IsCumulative() bool {
 // -- int16BoolMapValue
 type int16BoolMapValue struct {
 	value *map[int16]bool
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16BoolMapValue)(nil)
@@ -1580,12 +2052,12 @@ func newInt16BoolMapValue(m *map[int16]bool) *int16BoolMapValue {
 }
 
 func (v *int16BoolMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -1594,7 +2066,7 @@ func (v *int16BoolMapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseBool(s)
 	if err != nil {
@@ -1624,6 +2096,14 @@ func (v *int16BoolMapValue) String() string {
 
 func (v *int16BoolMapValue) Type() string { return "map[int16]bool" }
 
+func (v *int16BoolMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16BoolMapValue) clear() { *v.value = make(map[int16]bool) }
+
+func (v *int16BoolMapValue) appendNext() {}
+
+func (v *int16BoolMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16BoolMapValue) IsCumulative() bool {
 	return true
 }
@@ -1631,6 +2111,7 @@ func (v *int16BoolMapValue) IsCumulative() bool {
 // -- int32BoolMapValue
 type int32BoolMapValue struct {
 	value *map[int32]bool
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32BoolMapValue)(nil)
@@ -1644,12 +2125,12 @@ func newInt32BoolMapValue(m *map[int32]bool) *int32BoolMapValue {
 }
 
 func (v *int32BoolMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -1658,7 +2139,7 @@ func (v *int32BoolMapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseBool(s)
 	if err != nil {
@@ -1688,6 +2169,14 @@ func (v *int32BoolMapValue) String() string {
 
 func (v *int32BoolMapValue) Type() string { return "map[int32]bool" }
 
+func (v *int32BoolMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32BoolMapValue) clear() { *v.value = make(map[int32]bool) }
+
+func (v *int32BoolMapValue) appendNext() {}
+
+func (v *int32BoolMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32BoolMapValue) IsCumulative() bool {
 	return true
 }
@@ -1695,6 +2184,7 @@ func (v *int32BoolMapValue) IsCumulative() bool {
 // -- int64BoolMapValue
 type int64BoolMapValue struct {
 	value *map[int64]bool
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64BoolMapValue)(nil)
@@ -1708,12 +2198,12 @@ func newInt64BoolMapValue(m *map[int64]bool) *int64BoolMapValue {
 }
 
 func (v *int64BoolMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -1722,7 +2212,7 @@ func (v *int64BoolMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseBool(s)
 	if err != nil {
@@ -1752,6 +2242,14 @@ func (v *int64BoolMapValue) String() string {
 
 func (v *int64BoolMapValue) Type() string { return "map[int64]bool" }
 
+func (v *int64BoolMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64BoolMapValue) clear() { *v.value = make(map[int64]bool) }
+
+func (v *int64BoolMapValue) appendNext() {}
+
+func (v *int64BoolMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64BoolMapValue) IsCumulative() bool {
 	return true
 }
@@ -1759,6 +2257,7 @@ func (v *int64BoolMapValue) IsCumulative() bool {
 // -- uintBoolMapValue
 type uintBoolMapValue struct {
 	value *map[uint]bool
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintBoolMapValue)(nil)
@@ -1772,12 +2271,12 @@ func newUintBoolMapValue(m *map[uint]bool) *uintBoolMapValue {
 }
 
 func (v *uintBoolMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -1786,7 +2285,7 @@ func (v *uintBoolMapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseBool(s)
 	if err != nil {
@@ -1816,6 +2315,14 @@ func (v *uintBoolMapValue) String() string {
 
 func (v *uintBoolMapValue) Type() string { return "map[uint]bool" }
 
+func (v *uintBoolMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintBoolMapValue) clear() { *v.value = make(map[uint]bool) }
+
+func (v *uintBoolMapValue) appendNext() {}
+
+func (v *uintBoolMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintBoolMapValue) IsCumulative() bool {
 	return true
 }
@@ -1823,6 +2330,7 @@ func (v *uintBoolMapValue) IsCumulative() bool {
 // -- uint8BoolMapValue
 type uint8BoolMapValue struct {
 	value *map[uint8]bool
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8BoolMapValue)(nil)
@@ -1836,12 +2344,12 @@ func newUint8BoolMapValue(m *map[uint8]bool) *uint8BoolMapValue {
 }
 
 func (v *uint8BoolMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -1850,7 +2358,7 @@ func (v *uint8BoolMapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseBool(s)
 	if err != nil {
@@ -1880,6 +2388,14 @@ func (v *uint8BoolMapValue) String() string {
 
 func (v *uint8BoolMapValue) Type() string { return "map[uint8]bool" }
 
+func (v *uint8BoolMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8BoolMapValue) clear() { *v.value = make(map[uint8]bool) }
+
+func (v *uint8BoolMapValue) appendNext() {}
+
+func (v *uint8BoolMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8BoolMapValue) IsCumulative() bool {
 	return true
 }
@@ -1887,6 +2403,7 @@ func (v *uint8BoolMapValue) IsCumulative() bool {
 // -- uint16BoolMapValue
 type uint16BoolMapValue struct {
 	value *map[uint16]bool
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16BoolMapValue)(nil)
@@ -1900,12 +2417,12 @@ func newUint16BoolMapValue(m *map[uint16]bool) *uint16BoolMapValue {
 }
 
 func (v *uint16BoolMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -1914,7 +2431,7 @@ func (v *uint16BoolMapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseBool(s)
 	if err != nil {
@@ -1944,6 +2461,14 @@ func (v *uint16BoolMapValue) String() string {
 
 func (v *uint16BoolMapValue) Type() string { return "map[uint16]bool" }
 
+func (v *uint16BoolMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16BoolMapValue) clear() { *v.value = make(map[uint16]bool) }
+
+func (v *uint16BoolMapValue) appendNext() {}
+
+func (v *uint16BoolMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16BoolMapValue) IsCumulative() bool {
 	return true
 }
@@ -1951,6 +2476,7 @@ func (v *uint16BoolMapValue) IsCumulative() bool {
 // -- uint32BoolMapValue
 type uint32BoolMapValue struct {
 	value *map[uint32]bool
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32BoolMapValue)(nil)
@@ -1964,12 +2490,12 @@ func newUint32BoolMapValue(m *map[uint32]bool) *uint32BoolMapValue {
 }
 
 func (v *uint32BoolMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -1978,7 +2504,7 @@ func (v *uint32BoolMapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseBool(s)
 	if err != nil {
@@ -2008,6 +2534,14 @@ func (v *uint32BoolMapValue) String() string {
 
 func (v *uint32BoolMapValue) Type() string { return "map[uint32]bool" }
 
+func (v *uint32BoolMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32BoolMapValue) clear() { *v.value = make(map[uint32]bool) }
+
+func (v *uint32BoolMapValue) appendNext() {}
+
+func (v *uint32BoolMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32BoolMapValue) IsCumulative() bool {
 	return true
 }
@@ -2015,6 +2549,7 @@ func (v *uint32BoolMapValue) IsCumulative() bool {
 // -- uint64BoolMapValue
 type uint64BoolMapValue struct {
 	value *map[uint64]bool
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64BoolMapValue)(nil)
@@ -2028,12 +2563,12 @@ func newUint64BoolMapValue(m *map[uint64]bool) *uint64BoolMapValue {
 }
 
 func (v *uint64BoolMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -2042,7 +2577,7 @@ func (v *uint64BoolMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseBool(s)
 	if err != nil {
@@ -2072,6 +2607,14 @@ func (v *uint64BoolMapValue) String() string {
 
 func (v *uint64BoolMapValue) Type() string { return "map[uint64]bool" }
 
+func (v *uint64BoolMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64BoolMapValue) clear() { *v.value = make(map[uint64]bool) }
+
+func (v *uint64BoolMapValue) appendNext() {}
+
+func (v *uint64BoolMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64BoolMapValue) IsCumulative() bool {
 	return true
 }
@@ -2118,6 +2661,7 @@ func (v *uintValue) Type() string { return "uint" }
 type uintSliceValue struct {
 	value   *[]uint
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*uintSliceValue)(nil)
@@ -2131,7 +2675,10 @@ func newUintSliceValue(slice *[]uint) *uintSliceValue {
 }
 
 func (v *uintSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]uint, len(ss))
 	for i, s := range ss {
@@ -2175,9 +2722,21 @@ func (v *uintSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *uintSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *uintSliceValue) clear() {
+	*v.value = []uint{}
+	v.changed = true
+}
+
+func (v *uintSliceValue) appendNext() { v.changed = true }
+
+func (v *uintSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringUintMapValue
 type stringUintMapValue struct {
 	value *map[string]uint
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringUintMapValue)(nil)
@@ -2191,16 +2750,16 @@ func newStringUintMapValue(m *map[string]uint) *stringUintMapValue {
 }
 
 func (v *stringUintMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -2230,6 +2789,14 @@ func (v *stringUintMapValue) String() string {
 
 func (v *stringUintMapValue) Type() string { return "map[string]uint" }
 
+func (v *stringUintMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringUintMapValue) clear() { *v.value = make(map[string]uint) }
+
+func (v *stringUintMapValue) appendNext() {}
+
+func (v *stringUintMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringUintMapValue) IsCumulative() bool {
 	return true
 }
@@ -2237,6 +2804,7 @@ func (v *stringUintMapValue) IsCumulative() bool {
 // -- intUintMapValue
 type intUintMapValue struct {
 	value *map[int]uint
+	sep   string
 }
 
 var _ RepeatableFlag = (*intUintMapValue)(nil)
@@ -2250,12 +2818,12 @@ func newIntUintMapValue(m *map[int]uint) *intUintMapValue {
 }
 
 func (v *intUintMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -2264,7 +2832,7 @@ func (v *intUintMapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -2294,6 +2862,14 @@ func (v *intUintMapValue) String() string {
 
 func (v *intUintMapValue) Type() string { return "map[int]uint" }
 
+func (v *intUintMapValue) mapSeparator() string { return v.sep }
+
+func (v *intUintMapValue) clear() { *v.value = make(map[int]uint) }
+
+func (v *intUintMapValue) appendNext() {}
+
+func (v *intUintMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intUintMapValue) IsCumulative() bool {
 	return true
 }
@@ -2301,6 +2877,7 @@ func (v *intUintMapValue) IsCumulative() bool {
 // -- int8UintMapValue
 type int8UintMapValue struct {
 	value *map[int8]uint
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8UintMapValue)(nil)
@@ -2314,12 +2891,12 @@ func newInt8UintMapValue(m *map[int8]uint) *int8UintMapValue {
 }
 
 func (v *int8UintMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -2328,7 +2905,7 @@ func (v *int8UintMapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -2358,6 +2935,14 @@ func (v *int8UintMapValue) String() string {
 
 func (v *int8UintMapValue) Type() string { return "map[int8]uint" }
 
+func (v *int8UintMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8UintMapValue) clear() { *v.value = make(map[int8]uint) }
+
+func (v *int8UintMapValue) appendNext() {}
+
+func (v *int8UintMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8UintMapValue) IsCumulative() bool {
 	return true
 }
@@ -2365,6 +2950,7 @@ func (v *int8UintMapValue) IsCumulative() bool {
 // -- int16UintMapValue
 type int16UintMapValue struct {
 	value *map[int16]uint
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16UintMapValue)(nil)
@@ -2378,12 +2964,12 @@ func newInt16UintMapValue(m *map[int16]uint) *int16UintMapValue {
 }
 
 func (v *int16UintMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -2392,7 +2978,7 @@ func (v *int16UintMapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -2422,6 +3008,14 @@ func (v *int16UintMapValue) String() string {
 
 func (v *int16UintMapValue) Type() string { return "map[int16]uint" }
 
+func (v *int16UintMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16UintMapValue) clear() { *v.value = make(map[int16]uint) }
+
+func (v *int16UintMapValue) appendNext() {}
+
+func (v *int16UintMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16UintMapValue) IsCumulative() bool {
 	return true
 }
@@ -2429,6 +3023,7 @@ func (v *int16UintMapValue) IsCumulative() bool {
 // -- int32UintMapValue
 type int32UintMapValue struct {
 	value *map[int32]uint
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32UintMapValue)(nil)
@@ -2442,12 +3037,12 @@ func newInt32UintMapValue(m *map[int32]uint) *int32UintMapValue {
 }
 
 func (v *int32UintMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -2456,7 +3051,7 @@ func (v *int32UintMapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -2486,6 +3081,14 @@ func (v *int32UintMapValue) String() string {
 
 func (v *int32UintMapValue) Type() string { return "map[int32]uint" }
 
+func (v *int32UintMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32UintMapValue) clear() { *v.value = make(map[int32]uint) }
+
+func (v *int32UintMapValue) appendNext() {}
+
+func (v *int32UintMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32UintMapValue) IsCumulative() bool {
 	return true
 }
@@ -2493,6 +3096,7 @@ func (v *int32UintMapValue) IsCumulative() bool {
 // -- int64UintMapValue
 type int64UintMapValue struct {
 	value *map[int64]uint
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64UintMapValue)(nil)
@@ -2506,12 +3110,12 @@ func newInt64UintMapValue(m *map[int64]uint) *int64UintMapValue {
 }
 
 func (v *int64UintMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -2520,7 +3124,7 @@ func (v *int64UintMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -2550,6 +3154,14 @@ func (v *int64UintMapValue) String() string {
 
 func (v *int64UintMapValue) Type() string { return "map[int64]uint" }
 
+func (v *int64UintMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64UintMapValue) clear() { *v.value = make(map[int64]uint) }
+
+func (v *int64UintMapValue) appendNext() {}
+
+func (v *int64UintMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64UintMapValue) IsCumulative() bool {
 	return true
 }
@@ -2557,6 +3169,7 @@ func (v *int64UintMapValue) IsCumulative() bool {
 // -- uintUintMapValue
 type uintUintMapValue struct {
 	value *map[uint]uint
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintUintMapValue)(nil)
@@ -2570,12 +3183,12 @@ func newUintUintMapValue(m *map[uint]uint) *uintUintMapValue {
 }
 
 func (v *uintUintMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -2584,7 +3197,7 @@ func (v *uintUintMapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -2614,6 +3227,14 @@ func (v *uintUintMapValue) String() string {
 
 func (v *uintUintMapValue) Type() string { return "map[uint]uint" }
 
+func (v *uintUintMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintUintMapValue) clear() { *v.value = make(map[uint]uint) }
+
+func (v *uintUintMapValue) appendNext() {}
+
+func (v *uintUintMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintUintMapValue) IsCumulative() bool {
 	return true
 }
@@ -2621,6 +3242,7 @@ func (v *uintUintMapValue) IsCumulative() bool {
 // -- uint8UintMapValue
 type uint8UintMapValue struct {
 	value *map[uint8]uint
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8UintMapValue)(nil)
@@ -2634,12 +3256,12 @@ func newUint8UintMapValue(m *map[uint8]uint) *uint8UintMapValue {
 }
 
 func (v *uint8UintMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -2648,7 +3270,7 @@ func (v *uint8UintMapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -2678,6 +3300,14 @@ func (v *uint8UintMapValue) String() string {
 
 func (v *uint8UintMapValue) Type() string { return "map[uint8]uint" }
 
+func (v *uint8UintMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8UintMapValue) clear() { *v.value = make(map[uint8]uint) }
+
+func (v *uint8UintMapValue) appendNext() {}
+
+func (v *uint8UintMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8UintMapValue) IsCumulative() bool {
 	return true
 }
@@ -2685,6 +3315,7 @@ func (v *uint8UintMapValue) IsCumulative() bool {
 // -- uint16UintMapValue
 type uint16UintMapValue struct {
 	value *map[uint16]uint
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16UintMapValue)(nil)
@@ -2698,12 +3329,12 @@ func newUint16UintMapValue(m *map[uint16]uint) *uint16UintMapValue {
 }
 
 func (v *uint16UintMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -2712,7 +3343,7 @@ func (v *uint16UintMapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -2742,6 +3373,14 @@ func (v *uint16UintMapValue) String() string {
 
 func (v *uint16UintMapValue) Type() string { return "map[uint16]uint" }
 
+func (v *uint16UintMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16UintMapValue) clear() { *v.value = make(map[uint16]uint) }
+
+func (v *uint16UintMapValue) appendNext() {}
+
+func (v *uint16UintMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16UintMapValue) IsCumulative() bool {
 	return true
 }
@@ -2749,6 +3388,7 @@ func (v *uint16UintMapValue) IsCumulative() bool {
 // -- uint32UintMapValue
 type uint32UintMapValue struct {
 	value *map[uint32]uint
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32UintMapValue)(nil)
@@ -2762,12 +3402,12 @@ func newUint32UintMapValue(m *map[uint32]uint) *uint32UintMapValue {
 }
 
 func (v *uint32UintMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -2776,7 +3416,7 @@ func (v *uint32UintMapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -2806,6 +3446,14 @@ func (v *uint32UintMapValue) String() string {
 
 func (v *uint32UintMapValue) Type() string { return "map[uint32]uint" }
 
+func (v *uint32UintMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32UintMapValue) clear() { *v.value = make(map[uint32]uint) }
+
+func (v *uint32UintMapValue) appendNext() {}
+
+func (v *uint32UintMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32UintMapValue) IsCumulative() bool {
 	return true
 }
@@ -2813,6 +3461,7 @@ func (v *uint32UintMapValue) IsCumulative() bool {
 // -- uint64UintMapValue
 type uint64UintMapValue struct {
 	value *map[uint64]uint
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64UintMapValue)(nil)
@@ -2826,12 +3475,12 @@ func newUint64UintMapValue(m *map[uint64]uint) *uint64UintMapValue {
 }
 
 func (v *uint64UintMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -2840,7 +3489,7 @@ func (v *uint64UintMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -2870,6 +3519,14 @@ func (v *uint64UintMapValue) String() string {
 
 func (v *uint64UintMapValue) Type() string { return "map[uint64]uint" }
 
+func (v *uint64UintMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64UintMapValue) clear() { *v.value = make(map[uint64]uint) }
+
+func (v *uint64UintMapValue) appendNext() {}
+
+func (v *uint64UintMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64UintMapValue) IsCumulative() bool {
 	return true
 }
@@ -2916,6 +3573,7 @@ func (v *uint8Value) Type() string { return "uint8" }
 type uint8SliceValue struct {
 	value   *[]uint8
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*uint8SliceValue)(nil)
@@ -2929,7 +3587,10 @@ func newUint8SliceValue(slice *[]uint8) *uint8SliceValue {
 }
 
 func (v *uint8SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]uint8, len(ss))
 	for i, s := range ss {
@@ -2973,9 +3634,21 @@ func (v *uint8SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *uint8SliceValue) sliceSeparator() string { return v.sep }
+
+func (v *uint8SliceValue) clear() {
+	*v.value = []uint8{}
+	v.changed = true
+}
+
+func (v *uint8SliceValue) appendNext() { v.changed = true }
+
+func (v *uint8SliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringUint8MapValue
 type stringUint8MapValue struct {
 	value *map[string]uint8
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringUint8MapValue)(nil)
@@ -2989,16 +3662,16 @@ func newStringUint8MapValue(m *map[string]uint8) *stringUint8MapValue {
 }
 
 func (v *stringUint8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -3028,6 +3701,14 @@ func (v *stringUint8MapValue) String() string {
 
 func (v *stringUint8MapValue) Type() string { return "map[string]uint8" }
 
+func (v *stringUint8MapValue) mapSeparator() string { return v.sep }
+
+func (v *stringUint8MapValue) clear() { *v.value = make(map[string]uint8) }
+
+func (v *stringUint8MapValue) appendNext() {}
+
+func (v *stringUint8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringUint8MapValue) IsCumulative() bool {
 	return true
 }
@@ -3035,6 +3716,7 @@ func (v *stringUint8MapValue) IsCumulative() bool {
 // -- intUint8MapValue
 type intUint8MapValue struct {
 	value *map[int]uint8
+	sep   string
 }
 
 var _ RepeatableFlag = (*intUint8MapValue)(nil)
@@ -3048,12 +3730,12 @@ func newIntUint8MapValue(m *map[int]uint8) *intUint8MapValue {
 }
 
 func (v *intUint8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -3062,7 +3744,7 @@ func (v *intUint8MapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -3092,6 +3774,14 @@ func (v *intUint8MapValue) String() string {
 
 func (v *intUint8MapValue) Type() string { return "map[int]uint8" }
 
+func (v *intUint8MapValue) mapSeparator() string { return v.sep }
+
+func (v *intUint8MapValue) clear() { *v.value = make(map[int]uint8) }
+
+func (v *intUint8MapValue) appendNext() {}
+
+func (v *intUint8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intUint8MapValue) IsCumulative() bool {
 	return true
 }
@@ -3099,6 +3789,7 @@ func (v *intUint8MapValue) IsCumulative() bool {
 // -- int8Uint8MapValue
 type int8Uint8MapValue struct {
 	value *map[int8]uint8
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8Uint8MapValue)(nil)
@@ -3112,12 +3803,12 @@ func newInt8Uint8MapValue(m *map[int8]uint8) *int8Uint8MapValue {
 }
 
 func (v *int8Uint8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -3126,7 +3817,7 @@ func (v *int8Uint8MapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -3156,6 +3847,14 @@ func (v *int8Uint8MapValue) String() string {
 
 func (v *int8Uint8MapValue) Type() string { return "map[int8]uint8" }
 
+func (v *int8Uint8MapValue) mapSeparator() string { return v.sep }
+
+func (v *int8Uint8MapValue) clear() { *v.value = make(map[int8]uint8) }
+
+func (v *int8Uint8MapValue) appendNext() {}
+
+func (v *int8Uint8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8Uint8MapValue) IsCumulative() bool {
 	return true
 }
@@ -3163,6 +3862,7 @@ func (v *int8Uint8MapValue) IsCumulative() bool {
 // -- int16Uint8MapValue
 type int16Uint8MapValue struct {
 	value *map[int16]uint8
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16Uint8MapValue)(nil)
@@ -3176,12 +3876,12 @@ func newInt16Uint8MapValue(m *map[int16]uint8) *int16Uint8MapValue {
 }
 
 func (v *int16Uint8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -3190,7 +3890,7 @@ func (v *int16Uint8MapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -3220,13 +3920,22 @@ func (v *int16Uint8MapValue) String() string {
 
 func (v *int16Uint8MapValue) Type() string { return "map[int16]uint8" }
 
-func (v *int16Uint8MapValue) IsCumulative() bool {
-	return true
+func (v *int16Uint8MapValue) mapSeparator() string { return v.sep }
+
+func (v *int16Uint8MapValue) clear() { *v.value = make(map[int16]uint8) }
+
+func (v *int16Uint8MapValue) appendNext() {}
+
+func (v *int16Uint8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int16Uint8MapValue) IsCumulative() bool {
+	return true
 }
 
 // -- int32Uint8MapValue
 type int32Uint8MapValue struct {
 	value *map[int32]uint8
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32Uint8MapValue)(nil)
@@ -3240,12 +3949,12 @@ func newInt32Uint8MapValue(m *map[int32]uint8) *int32Uint8MapValue {
 }
 
 func (v *int32Uint8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -3254,7 +3963,7 @@ func (v *int32Uint8MapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -3284,6 +3993,14 @@ func (v *int32Uint8MapValue) String() string {
 
 func (v *int32Uint8MapValue) Type() string { return "map[int32]uint8" }
 
+func (v *int32Uint8MapValue) mapSeparator() string { return v.sep }
+
+func (v *int32Uint8MapValue) clear() { *v.value = make(map[int32]uint8) }
+
+func (v *int32Uint8MapValue) appendNext() {}
+
+func (v *int32Uint8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32Uint8MapValue) IsCumulative() bool {
 	return true
 }
@@ -3291,6 +4008,7 @@ func (v *int32Uint8MapValue) IsCumulative() bool {
 // -- int64Uint8MapValue
 type int64Uint8MapValue struct {
 	value *map[int64]uint8
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64Uint8MapValue)(nil)
@@ -3304,12 +4022,12 @@ func newInt64Uint8MapValue(m *map[int64]uint8) *int64Uint8MapValue {
 }
 
 func (v *int64Uint8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -3318,7 +4036,7 @@ func (v *int64Uint8MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -3348,6 +4066,14 @@ func (v *int64Uint8MapValue) String() string {
 
 func (v *int64Uint8MapValue) Type() string { return "map[int64]uint8" }
 
+func (v *int64Uint8MapValue) mapSeparator() string { return v.sep }
+
+func (v *int64Uint8MapValue) clear() { *v.value = make(map[int64]uint8) }
+
+func (v *int64Uint8MapValue) appendNext() {}
+
+func (v *int64Uint8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64Uint8MapValue) IsCumulative() bool {
 	return true
 }
@@ -3355,6 +4081,7 @@ func (v *int64Uint8MapValue) IsCumulative() bool {
 // -- uintUint8MapValue
 type uintUint8MapValue struct {
 	value *map[uint]uint8
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintUint8MapValue)(nil)
@@ -3368,12 +4095,12 @@ func newUintUint8MapValue(m *map[uint]uint8) *uintUint8MapValue {
 }
 
 func (v *uintUint8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -3382,7 +4109,7 @@ func (v *uintUint8MapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -3412,6 +4139,14 @@ func (v *uintUint8MapValue) String() string {
 
 func (v *uintUint8MapValue) Type() string { return "map[uint]uint8" }
 
+func (v *uintUint8MapValue) mapSeparator() string { return v.sep }
+
+func (v *uintUint8MapValue) clear() { *v.value = make(map[uint]uint8) }
+
+func (v *uintUint8MapValue) appendNext() {}
+
+func (v *uintUint8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintUint8MapValue) IsCumulative() bool {
 	return true
 }
@@ -3419,6 +4154,7 @@ func (v *uintUint8MapValue) IsCumulative() bool {
 // -- uint8Uint8MapValue
 type uint8Uint8MapValue struct {
 	value *map[uint8]uint8
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8Uint8MapValue)(nil)
@@ -3432,12 +4168,12 @@ func newUint8Uint8MapValue(m *map[uint8]uint8) *uint8Uint8MapValue {
 }
 
 func (v *uint8Uint8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -3446,7 +4182,7 @@ func (v *uint8Uint8MapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -3476,6 +4212,14 @@ func (v *uint8Uint8MapValue) String() string {
 
 func (v *uint8Uint8MapValue) Type() string { return "map[uint8]uint8" }
 
+func (v *uint8Uint8MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8Uint8MapValue) clear() { *v.value = make(map[uint8]uint8) }
+
+func (v *uint8Uint8MapValue) appendNext() {}
+
+func (v *uint8Uint8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8Uint8MapValue) IsCumulative() bool {
 	return true
 }
@@ -3483,6 +4227,7 @@ func (v *uint8Uint8MapValue) IsCumulative() bool {
 // -- uint16Uint8MapValue
 type uint16Uint8MapValue struct {
 	value *map[uint16]uint8
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16Uint8MapValue)(nil)
@@ -3496,12 +4241,12 @@ func newUint16Uint8MapValue(m *map[uint16]uint8) *uint16Uint8MapValue {
 }
 
 func (v *uint16Uint8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -3510,7 +4255,7 @@ func (v *uint16Uint8MapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -3540,6 +4285,14 @@ func (v *uint16Uint8MapValue) String() string {
 
 func (v *uint16Uint8MapValue) Type() string { return "map[uint16]uint8" }
 
+func (v *uint16Uint8MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16Uint8MapValue) clear() { *v.value = make(map[uint16]uint8) }
+
+func (v *uint16Uint8MapValue) appendNext() {}
+
+func (v *uint16Uint8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16Uint8MapValue) IsCumulative() bool {
 	return true
 }
@@ -3547,6 +4300,7 @@ func (v *uint16Uint8MapValue) IsCumulative() bool {
 // -- uint32Uint8MapValue
 type uint32Uint8MapValue struct {
 	value *map[uint32]uint8
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32Uint8MapValue)(nil)
@@ -3560,12 +4314,12 @@ func newUint32Uint8MapValue(m *map[uint32]uint8) *uint32Uint8MapValue {
 }
 
 func (v *uint32Uint8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -3574,7 +4328,7 @@ func (v *uint32Uint8MapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -3604,6 +4358,14 @@ func (v *uint32Uint8MapValue) String() string {
 
 func (v *uint32Uint8MapValue) Type() string { return "map[uint32]uint8" }
 
+func (v *uint32Uint8MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32Uint8MapValue) clear() { *v.value = make(map[uint32]uint8) }
+
+func (v *uint32Uint8MapValue) appendNext() {}
+
+func (v *uint32Uint8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32Uint8MapValue) IsCumulative() bool {
 	return true
 }
@@ -3611,6 +4373,7 @@ func (v *uint32Uint8MapValue) IsCumulative() bool {
 // -- uint64Uint8MapValue
 type uint64Uint8MapValue struct {
 	value *map[uint64]uint8
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64Uint8MapValue)(nil)
@@ -3624,12 +4387,12 @@ func newUint64Uint8MapValue(m *map[uint64]uint8) *uint64Uint8MapValue {
 }
 
 func (v *uint64Uint8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -3638,7 +4401,7 @@ func (v *uint64Uint8MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -3668,6 +4431,14 @@ func (v *uint64Uint8MapValue) String() string {
 
 func (v *uint64Uint8MapValue) Type() string { return "map[uint64]uint8" }
 
+func (v *uint64Uint8MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64Uint8MapValue) clear() { *v.value = make(map[uint64]uint8) }
+
+func (v *uint64Uint8MapValue) appendNext() {}
+
+func (v *uint64Uint8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64Uint8MapValue) IsCumulative() bool {
 	return true
 }
@@ -3714,6 +4485,7 @@ func (v *uint16Value) Type() string { return "uint16" }
 type uint16SliceValue struct {
 	value   *[]uint16
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*uint16SliceValue)(nil)
@@ -3727,7 +4499,10 @@ func newUint16SliceValue(slice *[]uint16) *uint16SliceValue {
 }
 
 func (v *uint16SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]uint16, len(ss))
 	for i, s := range ss {
@@ -3771,9 +4546,21 @@ func (v *uint16SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *uint16SliceValue) sliceSeparator() string { return v.sep }
+
+func (v *uint16SliceValue) clear() {
+	*v.value = []uint16{}
+	v.changed = true
+}
+
+func (v *uint16SliceValue) appendNext() { v.changed = true }
+
+func (v *uint16SliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringUint16MapValue
 type stringUint16MapValue struct {
 	value *map[string]uint16
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringUint16MapValue)(nil)
@@ -3787,16 +4574,16 @@ func newStringUint16MapValue(m *map[string]uint16) *stringUint16MapValue {
 }
 
 func (v *stringUint16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -3826,6 +4613,14 @@ func (v *stringUint16MapValue) String() string {
 
 func (v *stringUint16MapValue) Type() string { return "map[string]uint16" }
 
+func (v *stringUint16MapValue) mapSeparator() string { return v.sep }
+
+func (v *stringUint16MapValue) clear() { *v.value = make(map[string]uint16) }
+
+func (v *stringUint16MapValue) appendNext() {}
+
+func (v *stringUint16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringUint16MapValue) IsCumulative() bool {
 	return true
 }
@@ -3833,6 +4628,7 @@ func (v *stringUint16MapValue) IsCumulative() bool {
 // -- intUint16MapValue
 type intUint16MapValue struct {
 	value *map[int]uint16
+	sep   string
 }
 
 var _ RepeatableFlag = (*intUint16MapValue)(nil)
@@ -3846,12 +4642,12 @@ func newIntUint16MapValue(m *map[int]uint16) *intUint16MapValue {
 }
 
 func (v *intUint16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -3860,7 +4656,7 @@ func (v *intUint16MapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -3890,6 +4686,14 @@ func (v *intUint16MapValue) String() string {
 
 func (v *intUint16MapValue) Type() string { return "map[int]uint16" }
 
+func (v *intUint16MapValue) mapSeparator() string { return v.sep }
+
+func (v *intUint16MapValue) clear() { *v.value = make(map[int]uint16) }
+
+func (v *intUint16MapValue) appendNext() {}
+
+func (v *intUint16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intUint16MapValue) IsCumulative() bool {
 	return true
 }
@@ -3897,6 +4701,7 @@ func (v *intUint16MapValue) IsCumulative() bool {
 // -- int8Uint16MapValue
 type int8Uint16MapValue struct {
 	value *map[int8]uint16
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8Uint16MapValue)(nil)
@@ -3910,12 +4715,12 @@ func newInt8Uint16MapValue(m *map[int8]uint16) *int8Uint16MapValue {
 }
 
 func (v *int8Uint16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -3924,7 +4729,7 @@ func (v *int8Uint16MapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -3954,6 +4759,14 @@ func (v *int8Uint16MapValue) String() string {
 
 func (v *int8Uint16MapValue) Type() string { return "map[int8]uint16" }
 
+func (v *int8Uint16MapValue) mapSeparator() string { return v.sep }
+
+func (v *int8Uint16MapValue) clear() { *v.value = make(map[int8]uint16) }
+
+func (v *int8Uint16MapValue) appendNext() {}
+
+func (v *int8Uint16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8Uint16MapValue) IsCumulative() bool {
 	return true
 }
@@ -3961,6 +4774,7 @@ func (v *int8Uint16MapValue) IsCumulative() bool {
 // -- int16Uint16MapValue
 type int16Uint16MapValue struct {
 	value *map[int16]uint16
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16Uint16MapValue)(nil)
@@ -3974,12 +4788,12 @@ func newInt16Uint16MapValue(m *map[int16]uint16) *int16Uint16MapValue {
 }
 
 func (v *int16Uint16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -3988,7 +4802,7 @@ func (v *int16Uint16MapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -4018,6 +4832,14 @@ func (v *int16Uint16MapValue) String() string {
 
 func (v *int16Uint16MapValue) Type() string { return "map[int16]uint16" }
 
+func (v *int16Uint16MapValue) mapSeparator() string { return v.sep }
+
+func (v *int16Uint16MapValue) clear() { *v.value = make(map[int16]uint16) }
+
+func (v *int16Uint16MapValue) appendNext() {}
+
+func (v *int16Uint16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16Uint16MapValue) IsCumulative() bool {
 	return true
 }
@@ -4025,6 +4847,7 @@ func (v *int16Uint16MapValue) IsCumulative() bool {
 // -- int32Uint16MapValue
 type int32Uint16MapValue struct {
 	value *map[int32]uint16
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32Uint16MapValue)(nil)
@@ -4038,12 +4861,12 @@ func newInt32Uint16MapValue(m *map[int32]uint16) *int32Uint16MapValue {
 }
 
 func (v *int32Uint16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -4052,7 +4875,7 @@ func (v *int32Uint16MapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -4082,6 +4905,14 @@ func (v *int32Uint16MapValue) String() string {
 
 func (v *int32Uint16MapValue) Type() string { return "map[int32]uint16" }
 
+func (v *int32Uint16MapValue) mapSeparator() string { return v.sep }
+
+func (v *int32Uint16MapValue) clear() { *v.value = make(map[int32]uint16) }
+
+func (v *int32Uint16MapValue) appendNext() {}
+
+func (v *int32Uint16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32Uint16MapValue) IsCumulative() bool {
 	return true
 }
@@ -4089,6 +4920,7 @@ func (v *int32Uint16MapValue) IsCumulative() bool {
 // -- int64Uint16MapValue
 type int64Uint16MapValue struct {
 	value *map[int64]uint16
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64Uint16MapValue)(nil)
@@ -4102,12 +4934,12 @@ func newInt64Uint16MapValue(m *map[int64]uint16) *int64Uint16MapValue {
 }
 
 func (v *int64Uint16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -4116,7 +4948,7 @@ func (v *int64Uint16MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -4146,6 +4978,14 @@ func (v *int64Uint16MapValue) String() string {
 
 func (v *int64Uint16MapValue) Type() string { return "map[int64]uint16" }
 
+func (v *int64Uint16MapValue) mapSeparator() string { return v.sep }
+
+func (v *int64Uint16MapValue) clear() { *v.value = make(map[int64]uint16) }
+
+func (v *int64Uint16MapValue) appendNext() {}
+
+func (v *int64Uint16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64Uint16MapValue) IsCumulative() bool {
 	return true
 }
@@ -4153,6 +4993,7 @@ func (v *int64Uint16MapValue) IsCumulative() bool {
 // -- uintUint16MapValue
 type uintUint16MapValue struct {
 	value *map[uint]uint16
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintUint16MapValue)(nil)
@@ -4166,12 +5007,12 @@ func newUintUint16MapValue(m *map[uint]uint16) *uintUint16MapValue {
 }
 
 func (v *uintUint16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -4180,7 +5021,7 @@ func (v *uintUint16MapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -4210,6 +5051,14 @@ func (v *uintUint16MapValue) String() string {
 
 func (v *uintUint16MapValue) Type() string { return "map[uint]uint16" }
 
+func (v *uintUint16MapValue) mapSeparator() string { return v.sep }
+
+func (v *uintUint16MapValue) clear() { *v.value = make(map[uint]uint16) }
+
+func (v *uintUint16MapValue) appendNext() {}
+
+func (v *uintUint16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintUint16MapValue) IsCumulative() bool {
 	return true
 }
@@ -4217,6 +5066,7 @@ func (v *uintUint16MapValue) IsCumulative() bool {
 // -- uint8Uint16MapValue
 type uint8Uint16MapValue struct {
 	value *map[uint8]uint16
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8Uint16MapValue)(nil)
@@ -4230,12 +5080,12 @@ func newUint8Uint16MapValue(m *map[uint8]uint16) *uint8Uint16MapValue {
 }
 
 func (v *uint8Uint16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -4244,7 +5094,7 @@ func (v *uint8Uint16MapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -4274,6 +5124,14 @@ func (v *uint8Uint16MapValue) String() string {
 
 func (v *uint8Uint16MapValue) Type() string { return "map[uint8]uint16" }
 
+func (v *uint8Uint16MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8Uint16MapValue) clear() { *v.value = make(map[uint8]uint16) }
+
+func (v *uint8Uint16MapValue) appendNext() {}
+
+func (v *uint8Uint16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8Uint16MapValue) IsCumulative() bool {
 	return true
 }
@@ -4281,6 +5139,7 @@ func (v *uint8Uint16MapValue) IsCumulative() bool {
 // -- uint16Uint16MapValue
 type uint16Uint16MapValue struct {
 	value *map[uint16]uint16
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16Uint16MapValue)(nil)
@@ -4294,12 +5153,12 @@ func newUint16Uint16MapValue(m *map[uint16]uint16) *uint16Uint16MapValue {
 }
 
 func (v *uint16Uint16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -4308,7 +5167,7 @@ func (v *uint16Uint16MapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -4338,6 +5197,14 @@ func (v *uint16Uint16MapValue) String() string {
 
 func (v *uint16Uint16MapValue) Type() string { return "map[uint16]uint16" }
 
+func (v *uint16Uint16MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16Uint16MapValue) clear() { *v.value = make(map[uint16]uint16) }
+
+func (v *uint16Uint16MapValue) appendNext() {}
+
+func (v *uint16Uint16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16Uint16MapValue) IsCumulative() bool {
 	return true
 }
@@ -4345,6 +5212,7 @@ func (v *uint16Uint16MapValue) IsCumulative() bool {
 // -- uint32Uint16MapValue
 type uint32Uint16MapValue struct {
 	value *map[uint32]uint16
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32Uint16MapValue)(nil)
@@ -4358,12 +5226,12 @@ func newUint32Uint16MapValue(m *map[uint32]uint16) *uint32Uint16MapValue {
 }
 
 func (v *uint32Uint16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -4372,7 +5240,7 @@ func (v *uint32Uint16MapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -4402,6 +5270,14 @@ func (v *uint32Uint16MapValue) String() string {
 
 func (v *uint32Uint16MapValue) Type() string { return "map[uint32]uint16" }
 
+func (v *uint32Uint16MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32Uint16MapValue) clear() { *v.value = make(map[uint32]uint16) }
+
+func (v *uint32Uint16MapValue) appendNext() {}
+
+func (v *uint32Uint16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32Uint16MapValue) IsCumulative() bool {
 	return true
 }
@@ -4409,6 +5285,7 @@ func (v *uint32Uint16MapValue) IsCumulative() bool {
 // -- uint64Uint16MapValue
 type uint64Uint16MapValue struct {
 	value *map[uint64]uint16
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64Uint16MapValue)(nil)
@@ -4422,12 +5299,12 @@ func newUint64Uint16MapValue(m *map[uint64]uint16) *uint64Uint16MapValue {
 }
 
 func (v *uint64Uint16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -4436,7 +5313,7 @@ func (v *uint64Uint16MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -4466,6 +5343,14 @@ func (v *uint64Uint16MapValue) String() string {
 
 func (v *uint64Uint16MapValue) Type() string { return "map[uint64]uint16" }
 
+func (v *uint64Uint16MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64Uint16MapValue) clear() { *v.value = make(map[uint64]uint16) }
+
+func (v *uint64Uint16MapValue) appendNext() {}
+
+func (v *uint64Uint16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64Uint16MapValue) IsCumulative() bool {
 	return true
 }
@@ -4512,6 +5397,7 @@ func (v *uint32Value) Type() string { return "uint32" }
 type uint32SliceValue struct {
 	value   *[]uint32
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*uint32SliceValue)(nil)
@@ -4525,7 +5411,10 @@ func newUint32SliceValue(slice *[]uint32) *uint32SliceValue {
 }
 
 func (v *uint32SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]uint32, len(ss))
 	for i, s := range ss {
@@ -4569,9 +5458,21 @@ func (v *uint32SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *uint32SliceValue) sliceSeparator() string { return v.sep }
+
+func (v *uint32SliceValue) clear() {
+	*v.value = []uint32{}
+	v.changed = true
+}
+
+func (v *uint32SliceValue) appendNext() { v.changed = true }
+
+func (v *uint32SliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringUint32MapValue
 type stringUint32MapValue struct {
 	value *map[string]uint32
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringUint32MapValue)(nil)
@@ -4585,16 +5486,16 @@ func newStringUint32MapValue(m *map[string]uint32) *stringUint32MapValue {
 }
 
 func (v *stringUint32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -4624,6 +5525,14 @@ func (v *stringUint32MapValue) String() string {
 
 func (v *stringUint32MapValue) Type() string { return "map[string]uint32" }
 
+func (v *stringUint32MapValue) mapSeparator() string { return v.sep }
+
+func (v *stringUint32MapValue) clear() { *v.value = make(map[string]uint32) }
+
+func (v *stringUint32MapValue) appendNext() {}
+
+func (v *stringUint32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringUint32MapValue) IsCumulative() bool {
 	return true
 }
@@ -4631,6 +5540,7 @@ func (v *stringUint32MapValue) IsCumulative() bool {
 // -- intUint32MapValue
 type intUint32MapValue struct {
 	value *map[int]uint32
+	sep   string
 }
 
 var _ RepeatableFlag = (*intUint32MapValue)(nil)
@@ -4644,12 +5554,12 @@ func newIntUint32MapValue(m *map[int]uint32) *intUint32MapValue {
 }
 
 func (v *intUint32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -4658,7 +5568,7 @@ func (v *intUint32MapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -4688,6 +5598,14 @@ func (v *intUint32MapValue) String() string {
 
 func (v *intUint32MapValue) Type() string { return "map[int]uint32" }
 
+func (v *intUint32MapValue) mapSeparator() string { return v.sep }
+
+func (v *intUint32MapValue) clear() { *v.value = make(map[int]uint32) }
+
+func (v *intUint32MapValue) appendNext() {}
+
+func (v *intUint32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intUint32MapValue) IsCumulative() bool {
 	return true
 }
@@ -4695,6 +5613,7 @@ func (v *intUint32MapValue) IsCumulative() bool {
 // -- int8Uint32MapValue
 type int8Uint32MapValue struct {
 	value *map[int8]uint32
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8Uint32MapValue)(nil)
@@ -4708,12 +5627,12 @@ func newInt8Uint32MapValue(m *map[int8]uint32) *int8Uint32MapValue {
 }
 
 func (v *int8Uint32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -4722,7 +5641,7 @@ func (v *int8Uint32MapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -4752,6 +5671,14 @@ func (v *int8Uint32MapValue) String() string {
 
 func (v *int8Uint32MapValue) Type() string { return "map[int8]uint32" }
 
+func (v *int8Uint32MapValue) mapSeparator() string { return v.sep }
+
+func (v *int8Uint32MapValue) clear() { *v.value = make(map[int8]uint32) }
+
+func (v *int8Uint32MapValue) appendNext() {}
+
+func (v *int8Uint32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8Uint32MapValue) IsCumulative() bool {
 	return true
 }
@@ -4759,6 +5686,7 @@ func (v *int8Uint32MapValue) IsCumulative() bool {
 // -- int16Uint32MapValue
 type int16Uint32MapValue struct {
 	value *map[int16]uint32
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16Uint32MapValue)(nil)
@@ -4772,12 +5700,12 @@ func newInt16Uint32MapValue(m *map[int16]uint32) *int16Uint32MapValue {
 }
 
 func (v *int16Uint32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -4786,7 +5714,7 @@ func (v *int16Uint32MapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -4816,6 +5744,14 @@ func (v *int16Uint32MapValue) String() string {
 
 func (v *int16Uint32MapValue) Type() string { return "map[int16]uint32" }
 
+func (v *int16Uint32MapValue) mapSeparator() string { return v.sep }
+
+func (v *int16Uint32MapValue) clear() { *v.value = make(map[int16]uint32) }
+
+func (v *int16Uint32MapValue) appendNext() {}
+
+func (v *int16Uint32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16Uint32MapValue) IsCumulative() bool {
 	return true
 }
@@ -4823,6 +5759,7 @@ func (v *int16Uint32MapValue) IsCumulative() bool {
 // -- int32Uint32MapValue
 type int32Uint32MapValue struct {
 	value *map[int32]uint32
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32Uint32MapValue)(nil)
@@ -4836,12 +5773,12 @@ func newInt32Uint32MapValue(m *map[int32]uint32) *int32Uint32MapValue {
 }
 
 func (v *int32Uint32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -4850,7 +5787,7 @@ func (v *int32Uint32MapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -4880,6 +5817,14 @@ func (v *int32Uint32MapValue) String() string {
 
 func (v *int32Uint32MapValue) Type() string { return "map[int32]uint32" }
 
+func (v *int32Uint32MapValue) mapSeparator() string { return v.sep }
+
+func (v *int32Uint32MapValue) clear() { *v.value = make(map[int32]uint32) }
+
+func (v *int32Uint32MapValue) appendNext() {}
+
+func (v *int32Uint32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32Uint32MapValue) IsCumulative() bool {
 	return true
 }
@@ -4887,6 +5832,7 @@ func (v *int32Uint32MapValue) IsCumulative() bool {
 // -- int64Uint32MapValue
 type int64Uint32MapValue struct {
 	value *map[int64]uint32
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64Uint32MapValue)(nil)
@@ -4900,12 +5846,12 @@ func newInt64Uint32MapValue(m *map[int64]uint32) *int64Uint32MapValue {
 }
 
 func (v *int64Uint32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -4914,7 +5860,7 @@ func (v *int64Uint32MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -4944,6 +5890,14 @@ func (v *int64Uint32MapValue) String() string {
 
 func (v *int64Uint32MapValue) Type() string { return "map[int64]uint32" }
 
+func (v *int64Uint32MapValue) mapSeparator() string { return v.sep }
+
+func (v *int64Uint32MapValue) clear() { *v.value = make(map[int64]uint32) }
+
+func (v *int64Uint32MapValue) appendNext() {}
+
+func (v *int64Uint32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64Uint32MapValue) IsCumulative() bool {
 	return true
 }
@@ -4951,6 +5905,7 @@ func (v *int64Uint32MapValue) IsCumulative() bool {
 // -- uintUint32MapValue
 type uintUint32MapValue struct {
 	value *map[uint]uint32
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintUint32MapValue)(nil)
@@ -4964,12 +5919,12 @@ func newUintUint32MapValue(m *map[uint]uint32) *uintUint32MapValue {
 }
 
 func (v *uintUint32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -4978,7 +5933,7 @@ func (v *uintUint32MapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -5008,6 +5963,14 @@ func (v *uintUint32MapValue) String() string {
 
 func (v *uintUint32MapValue) Type() string { return "map[uint]uint32" }
 
+func (v *uintUint32MapValue) mapSeparator() string { return v.sep }
+
+func (v *uintUint32MapValue) clear() { *v.value = make(map[uint]uint32) }
+
+func (v *uintUint32MapValue) appendNext() {}
+
+func (v *uintUint32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintUint32MapValue) IsCumulative() bool {
 	return true
 }
@@ -5015,6 +5978,7 @@ func (v *uintUint32MapValue) IsCumulative() bool {
 // -- uint8Uint32MapValue
 type uint8Uint32MapValue struct {
 	value *map[uint8]uint32
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8Uint32MapValue)(nil)
@@ -5028,12 +5992,12 @@ func newUint8Uint32MapValue(m *map[uint8]uint32) *uint8Uint32MapValue {
 }
 
 func (v *uint8Uint32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -5042,7 +6006,7 @@ func (v *uint8Uint32MapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -5072,6 +6036,14 @@ func (v *uint8Uint32MapValue) String() string {
 
 func (v *uint8Uint32MapValue) Type() string { return "map[uint8]uint32" }
 
+func (v *uint8Uint32MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8Uint32MapValue) clear() { *v.value = make(map[uint8]uint32) }
+
+func (v *uint8Uint32MapValue) appendNext() {}
+
+func (v *uint8Uint32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8Uint32MapValue) IsCumulative() bool {
 	return true
 }
@@ -5079,6 +6051,7 @@ func (v *uint8Uint32MapValue) IsCumulative() bool {
 // -- uint16Uint32MapValue
 type uint16Uint32MapValue struct {
 	value *map[uint16]uint32
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16Uint32MapValue)(nil)
@@ -5092,12 +6065,12 @@ func newUint16Uint32MapValue(m *map[uint16]uint32) *uint16Uint32MapValue {
 }
 
 func (v *uint16Uint32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -5106,7 +6079,7 @@ func (v *uint16Uint32MapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -5136,6 +6109,14 @@ func (v *uint16Uint32MapValue) String() string {
 
 func (v *uint16Uint32MapValue) Type() string { return "map[uint16]uint32" }
 
+func (v *uint16Uint32MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16Uint32MapValue) clear() { *v.value = make(map[uint16]uint32) }
+
+func (v *uint16Uint32MapValue) appendNext() {}
+
+func (v *uint16Uint32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16Uint32MapValue) IsCumulative() bool {
 	return true
 }
@@ -5143,6 +6124,7 @@ func (v *uint16Uint32MapValue) IsCumulative() bool {
 // -- uint32Uint32MapValue
 type uint32Uint32MapValue struct {
 	value *map[uint32]uint32
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32Uint32MapValue)(nil)
@@ -5156,12 +6138,12 @@ func newUint32Uint32MapValue(m *map[uint32]uint32) *uint32Uint32MapValue {
 }
 
 func (v *uint32Uint32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -5170,7 +6152,7 @@ func (v *uint32Uint32MapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -5200,6 +6182,14 @@ func (v *uint32Uint32MapValue) String() string {
 
 func (v *uint32Uint32MapValue) Type() string { return "map[uint32]uint32" }
 
+func (v *uint32Uint32MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32Uint32MapValue) clear() { *v.value = make(map[uint32]uint32) }
+
+func (v *uint32Uint32MapValue) appendNext() {}
+
+func (v *uint32Uint32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32Uint32MapValue) IsCumulative() bool {
 	return true
 }
@@ -5207,6 +6197,7 @@ func (v *uint32Uint32MapValue) IsCumulative() bool {
 // -- uint64Uint32MapValue
 type uint64Uint32MapValue struct {
 	value *map[uint64]uint32
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64Uint32MapValue)(nil)
@@ -5220,12 +6211,12 @@ func newUint64Uint32MapValue(m *map[uint64]uint32) *uint64Uint32MapValue {
 }
 
 func (v *uint64Uint32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -5234,7 +6225,7 @@ func (v *uint64Uint32MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -5264,6 +6255,14 @@ func (v *uint64Uint32MapValue) String() string {
 
 func (v *uint64Uint32MapValue) Type() string { return "map[uint64]uint32" }
 
+func (v *uint64Uint32MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64Uint32MapValue) clear() { *v.value = make(map[uint64]uint32) }
+
+func (v *uint64Uint32MapValue) appendNext() {}
+
+func (v *uint64Uint32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64Uint32MapValue) IsCumulative() bool {
 	return true
 }
@@ -5310,6 +6309,7 @@ func (v *uint64Value) Type() string { return "uint64" }
 type uint64SliceValue struct {
 	value   *[]uint64
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*uint64SliceValue)(nil)
@@ -5323,7 +6323,10 @@ func newUint64SliceValue(slice *[]uint64) *uint64SliceValue {
 }
 
 func (v *uint64SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]uint64, len(ss))
 	for i, s := range ss {
@@ -5367,9 +6370,21 @@ func (v *uint64SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *uint64SliceValue) sliceSeparator() string { return v.sep }
+
+func (v *uint64SliceValue) clear() {
+	*v.value = []uint64{}
+	v.changed = true
+}
+
+func (v *uint64SliceValue) appendNext() { v.changed = true }
+
+func (v *uint64SliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringUint64MapValue
 type stringUint64MapValue struct {
 	value *map[string]uint64
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringUint64MapValue)(nil)
@@ -5383,16 +6398,16 @@ func newStringUint64MapValue(m *map[string]uint64) *stringUint64MapValue {
 }
 
 func (v *stringUint64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -5422,6 +6437,14 @@ func (v *stringUint64MapValue) String() string {
 
 func (v *stringUint64MapValue) Type() string { return "map[string]uint64" }
 
+func (v *stringUint64MapValue) mapSeparator() string { return v.sep }
+
+func (v *stringUint64MapValue) clear() { *v.value = make(map[string]uint64) }
+
+func (v *stringUint64MapValue) appendNext() {}
+
+func (v *stringUint64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringUint64MapValue) IsCumulative() bool {
 	return true
 }
@@ -5429,6 +6452,7 @@ func (v *stringUint64MapValue) IsCumulative() bool {
 // -- intUint64MapValue
 type intUint64MapValue struct {
 	value *map[int]uint64
+	sep   string
 }
 
 var _ RepeatableFlag = (*intUint64MapValue)(nil)
@@ -5442,12 +6466,12 @@ func newIntUint64MapValue(m *map[int]uint64) *intUint64MapValue {
 }
 
 func (v *intUint64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -5456,7 +6480,7 @@ func (v *intUint64MapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -5486,6 +6510,14 @@ func (v *intUint64MapValue) String() string {
 
 func (v *intUint64MapValue) Type() string { return "map[int]uint64" }
 
+func (v *intUint64MapValue) mapSeparator() string { return v.sep }
+
+func (v *intUint64MapValue) clear() { *v.value = make(map[int]uint64) }
+
+func (v *intUint64MapValue) appendNext() {}
+
+func (v *intUint64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intUint64MapValue) IsCumulative() bool {
 	return true
 }
@@ -5493,6 +6525,7 @@ func (v *intUint64MapValue) IsCumulative() bool {
 // -- int8Uint64MapValue
 type int8Uint64MapValue struct {
 	value *map[int8]uint64
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8Uint64MapValue)(nil)
@@ -5506,12 +6539,12 @@ func newInt8Uint64MapValue(m *map[int8]uint64) *int8Uint64MapValue {
 }
 
 func (v *int8Uint64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -5520,7 +6553,7 @@ func (v *int8Uint64MapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -5550,6 +6583,14 @@ func (v *int8Uint64MapValue) String() string {
 
 func (v *int8Uint64MapValue) Type() string { return "map[int8]uint64" }
 
+func (v *int8Uint64MapValue) mapSeparator() string { return v.sep }
+
+func (v *int8Uint64MapValue) clear() { *v.value = make(map[int8]uint64) }
+
+func (v *int8Uint64MapValue) appendNext() {}
+
+func (v *int8Uint64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8Uint64MapValue) IsCumulative() bool {
 	return true
 }
@@ -5557,6 +6598,7 @@ func (v *int8Uint64MapValue) IsCumulative() bool {
 // -- int16Uint64MapValue
 type int16Uint64MapValue struct {
 	value *map[int16]uint64
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16Uint64MapValue)(nil)
@@ -5570,12 +6612,12 @@ func newInt16Uint64MapValue(m *map[int16]uint64) *int16Uint64MapValue {
 }
 
 func (v *int16Uint64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -5584,7 +6626,7 @@ func (v *int16Uint64MapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -5614,6 +6656,14 @@ func (v *int16Uint64MapValue) String() string {
 
 func (v *int16Uint64MapValue) Type() string { return "map[int16]uint64" }
 
+func (v *int16Uint64MapValue) mapSeparator() string { return v.sep }
+
+func (v *int16Uint64MapValue) clear() { *v.value = make(map[int16]uint64) }
+
+func (v *int16Uint64MapValue) appendNext() {}
+
+func (v *int16Uint64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16Uint64MapValue) IsCumulative() bool {
 	return true
 }
@@ -5621,6 +6671,7 @@ func (v *int16Uint64MapValue) IsCumulative() bool {
 // -- int32Uint64MapValue
 type int32Uint64MapValue struct {
 	value *map[int32]uint64
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32Uint64MapValue)(nil)
@@ -5634,12 +6685,12 @@ func newInt32Uint64MapValue(m *map[int32]uint64) *int32Uint64MapValue {
 }
 
 func (v *int32Uint64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -5648,7 +6699,7 @@ func (v *int32Uint64MapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -5678,6 +6729,14 @@ func (v *int32Uint64MapValue) String() string {
 
 func (v *int32Uint64MapValue) Type() string { return "map[int32]uint64" }
 
+func (v *int32Uint64MapValue) mapSeparator() string { return v.sep }
+
+func (v *int32Uint64MapValue) clear() { *v.value = make(map[int32]uint64) }
+
+func (v *int32Uint64MapValue) appendNext() {}
+
+func (v *int32Uint64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32Uint64MapValue) IsCumulative() bool {
 	return true
 }
@@ -5685,6 +6744,7 @@ func (v *int32Uint64MapValue) IsCumulative() bool {
 // -- int64Uint64MapValue
 type int64Uint64MapValue struct {
 	value *map[int64]uint64
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64Uint64MapValue)(nil)
@@ -5698,12 +6758,12 @@ func newInt64Uint64MapValue(m *map[int64]uint64) *int64Uint64MapValue {
 }
 
 func (v *int64Uint64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -5712,7 +6772,7 @@ func (v *int64Uint64MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -5742,6 +6802,14 @@ func (v *int64Uint64MapValue) String() string {
 
 func (v *int64Uint64MapValue) Type() string { return "map[int64]uint64" }
 
+func (v *int64Uint64MapValue) mapSeparator() string { return v.sep }
+
+func (v *int64Uint64MapValue) clear() { *v.value = make(map[int64]uint64) }
+
+func (v *int64Uint64MapValue) appendNext() {}
+
+func (v *int64Uint64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64Uint64MapValue) IsCumulative() bool {
 	return true
 }
@@ -5749,6 +6817,7 @@ func (v *int64Uint64MapValue) IsCumulative() bool {
 // -- uintUint64MapValue
 type uintUint64MapValue struct {
 	value *map[uint]uint64
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintUint64MapValue)(nil)
@@ -5762,12 +6831,12 @@ func newUintUint64MapValue(m *map[uint]uint64) *uintUint64MapValue {
 }
 
 func (v *uintUint64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -5776,7 +6845,7 @@ func (v *uintUint64MapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -5806,6 +6875,14 @@ func (v *uintUint64MapValue) String() string {
 
 func (v *uintUint64MapValue) Type() string { return "map[uint]uint64" }
 
+func (v *uintUint64MapValue) mapSeparator() string { return v.sep }
+
+func (v *uintUint64MapValue) clear() { *v.value = make(map[uint]uint64) }
+
+func (v *uintUint64MapValue) appendNext() {}
+
+func (v *uintUint64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintUint64MapValue) IsCumulative() bool {
 	return true
 }
@@ -5813,6 +6890,7 @@ func (v *uintUint64MapValue) IsCumulative() bool {
 // -- uint8Uint64MapValue
 type uint8Uint64MapValue struct {
 	value *map[uint8]uint64
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8Uint64MapValue)(nil)
@@ -5826,12 +6904,12 @@ func newUint8Uint64MapValue(m *map[uint8]uint64) *uint8Uint64MapValue {
 }
 
 func (v *uint8Uint64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -5840,7 +6918,7 @@ func (v *uint8Uint64MapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -5870,6 +6948,14 @@ func (v *uint8Uint64MapValue) String() string {
 
 func (v *uint8Uint64MapValue) Type() string { return "map[uint8]uint64" }
 
+func (v *uint8Uint64MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8Uint64MapValue) clear() { *v.value = make(map[uint8]uint64) }
+
+func (v *uint8Uint64MapValue) appendNext() {}
+
+func (v *uint8Uint64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8Uint64MapValue) IsCumulative() bool {
 	return true
 }
@@ -5877,6 +6963,7 @@ func (v *uint8Uint64MapValue) IsCumulative() bool {
 // -- uint16Uint64MapValue
 type uint16Uint64MapValue struct {
 	value *map[uint16]uint64
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16Uint64MapValue)(nil)
@@ -5890,12 +6977,12 @@ func newUint16Uint64MapValue(m *map[uint16]uint64) *uint16Uint64MapValue {
 }
 
 func (v *uint16Uint64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
-	}
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -5904,7 +6991,7 @@ func (v *uint16Uint64MapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -5934,6 +7021,14 @@ func (v *uint16Uint64MapValue) String() string {
 
 func (v *uint16Uint64MapValue) Type() string { return "map[uint16]uint64" }
 
+func (v *uint16Uint64MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16Uint64MapValue) clear() { *v.value = make(map[uint16]uint64) }
+
+func (v *uint16Uint64MapValue) appendNext() {}
+
+func (v *uint16Uint64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16Uint64MapValue) IsCumulative() bool {
 	return true
 }
@@ -5941,6 +7036,7 @@ func (v *uint16Uint64MapValue) IsCumulative() bool {
 // -- uint32Uint64MapValue
 type uint32Uint64MapValue struct {
 	value *map[uint32]uint64
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32Uint64MapValue)(nil)
@@ -5954,12 +7050,12 @@ func newUint32Uint64MapValue(m *map[uint32]uint64) *uint32Uint64MapValue {
 }
 
 func (v *uint32Uint64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -5968,7 +7064,7 @@ func (v *uint32Uint64MapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -5998,6 +7094,14 @@ func (v *uint32Uint64MapValue) String() string {
 
 func (v *uint32Uint64MapValue) Type() string { return "map[uint32]uint64" }
 
+func (v *uint32Uint64MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32Uint64MapValue) clear() { *v.value = make(map[uint32]uint64) }
+
+func (v *uint32Uint64MapValue) appendNext() {}
+
+func (v *uint32Uint64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32Uint64MapValue) IsCumulative() bool {
 	return true
 }
@@ -6005,6 +7109,7 @@ func (v *uint32Uint64MapValue) IsCumulative() bool {
 // -- uint64Uint64MapValue
 type uint64Uint64MapValue struct {
 	value *map[uint64]uint64
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64Uint64MapValue)(nil)
@@ -6018,12 +7123,12 @@ func newUint64Uint64MapValue(m *map[uint64]uint64) *uint64Uint64MapValue {
 }
 
 func (v *uint64Uint64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -6032,7 +7137,7 @@ func (v *uint64Uint64MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -6062,6 +7167,14 @@ func (v *uint64Uint64MapValue) String() string {
 
 func (v *uint64Uint64MapValue) Type() string { return "map[uint64]uint64" }
 
+func (v *uint64Uint64MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64Uint64MapValue) clear() { *v.value = make(map[uint64]uint64) }
+
+func (v *uint64Uint64MapValue) appendNext() {}
+
+func (v *uint64Uint64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64Uint64MapValue) IsCumulative() bool {
 	return true
 }
@@ -6108,6 +7221,7 @@ func (v *intValue) Type() string { return "int" }
 type intSliceValue struct {
 	value   *[]int
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*intSliceValue)(nil)
@@ -6121,7 +7235,10 @@ func newIntSliceValue(slice *[]int) *intSliceValue {
 }
 
 func (v *intSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]int, len(ss))
 	for i, s := range ss {
@@ -6165,9 +7282,21 @@ func (v *intSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *intSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *intSliceValue) clear() {
+	*v.value = []int{}
+	v.changed = true
+}
+
+func (v *intSliceValue) appendNext() { v.changed = true }
+
+func (v *intSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringIntMapValue
 type stringIntMapValue struct {
 	value *map[string]int
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringIntMapValue)(nil)
@@ -6181,16 +7310,16 @@ func newStringIntMapValue(m *map[string]int) *stringIntMapValue {
 }
 
 func (v *stringIntMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -6220,6 +7349,14 @@ func (v *stringIntMapValue) String() string {
 
 func (v *stringIntMapValue) Type() string { return "map[string]int" }
 
+func (v *stringIntMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringIntMapValue) clear() { *v.value = make(map[string]int) }
+
+func (v *stringIntMapValue) appendNext() {}
+
+func (v *stringIntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringIntMapValue) IsCumulative() bool {
 	return true
 }
@@ -6227,6 +7364,7 @@ func (v *stringIntMapValue) IsCumulative() bool {
 // -- intIntMapValue
 type intIntMapValue struct {
 	value *map[int]int
+	sep   string
 }
 
 var _ RepeatableFlag = (*intIntMapValue)(nil)
@@ -6240,12 +7378,12 @@ func newIntIntMapValue(m *map[int]int) *intIntMapValue {
 }
 
 func (v *intIntMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -6254,7 +7392,7 @@ func (v *intIntMapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -6284,6 +7422,14 @@ func (v *intIntMapValue) String() string {
 
 func (v *intIntMapValue) Type() string { return "map[int]int" }
 
+func (v *intIntMapValue) mapSeparator() string { return v.sep }
+
+func (v *intIntMapValue) clear() { *v.value = make(map[int]int) }
+
+func (v *intIntMapValue) appendNext() {}
+
+func (v *intIntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intIntMapValue) IsCumulative() bool {
 	return true
 }
@@ -6291,6 +7437,7 @@ func (v *intIntMapValue) IsCumulative() bool {
 // -- int8IntMapValue
 type int8IntMapValue struct {
 	value *map[int8]int
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8IntMapValue)(nil)
@@ -6304,12 +7451,12 @@ func newInt8IntMapValue(m *map[int8]int) *int8IntMapValue {
 }
 
 func (v *int8IntMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -6318,7 +7465,7 @@ func (v *int8IntMapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -6348,6 +7495,14 @@ func (v *int8IntMapValue) String() string {
 
 func (v *int8IntMapValue) Type() string { return "map[int8]int" }
 
+func (v *int8IntMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8IntMapValue) clear() { *v.value = make(map[int8]int) }
+
+func (v *int8IntMapValue) appendNext() {}
+
+func (v *int8IntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8IntMapValue) IsCumulative() bool {
 	return true
 }
@@ -6355,6 +7510,7 @@ func (v *int8IntMapValue) IsCumulative() bool {
 // -- int16IntMapValue
 type int16IntMapValue struct {
 	value *map[int16]int
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16IntMapValue)(nil)
@@ -6368,12 +7524,12 @@ func newInt16IntMapValue(m *map[int16]int) *int16IntMapValue {
 }
 
 func (v *int16IntMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -6382,7 +7538,7 @@ func (v *int16IntMapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -6412,6 +7568,14 @@ func (v *int16IntMapValue) String() string {
 
 func (v *int16IntMapValue) Type() string { return "map[int16]int" }
 
+func (v *int16IntMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16IntMapValue) clear() { *v.value = make(map[int16]int) }
+
+func (v *int16IntMapValue) appendNext() {}
+
+func (v *int16IntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16IntMapValue) IsCumulative() bool {
 	return true
 }
@@ -6419,6 +7583,7 @@ func (v *int16IntMapValue) IsCumulative() bool {
 // -- int32IntMapValue
 type int32IntMapValue struct {
 	value *map[int32]int
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32IntMapValue)(nil)
@@ -6432,12 +7597,12 @@ func newInt32IntMapValue(m *map[int32]int) *int32IntMapValue {
 }
 
 func (v *int32IntMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -6446,7 +7611,7 @@ func (v *int32IntMapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -6476,6 +7641,14 @@ func (v *int32IntMapValue) String() string {
 
 func (v *int32IntMapValue) Type() string { return "map[int32]int" }
 
+func (v *int32IntMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32IntMapValue) clear() { *v.value = make(map[int32]int) }
+
+func (v *int32IntMapValue) appendNext() {}
+
+func (v *int32IntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32IntMapValue) IsCumulative() bool {
 	return true
 }
@@ -6483,6 +7656,7 @@ func (v *int32IntMapValue) IsCumulative() bool {
 // -- int64IntMapValue
 type int64IntMapValue struct {
 	value *map[int64]int
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64IntMapValue)(nil)
@@ -6496,12 +7670,12 @@ func newInt64IntMapValue(m *map[int64]int) *int64IntMapValue {
 }
 
 func (v *int64IntMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -6510,7 +7684,7 @@ func (v *int64IntMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -6540,6 +7714,14 @@ func (v *int64IntMapValue) String() string {
 
 func (v *int64IntMapValue) Type() string { return "map[int64]int" }
 
+func (v *int64IntMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64IntMapValue) clear() { *v.value = make(map[int64]int) }
+
+func (v *int64IntMapValue) appendNext() {}
+
+func (v *int64IntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64IntMapValue) IsCumulative() bool {
 	return true
 }
@@ -6547,6 +7729,7 @@ func (v *int64IntMapValue) IsCumulative() bool {
 // -- uintIntMapValue
 type uintIntMapValue struct {
 	value *map[uint]int
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintIntMapValue)(nil)
@@ -6560,12 +7743,12 @@ func newUintIntMapValue(m *map[uint]int) *uintIntMapValue {
 }
 
 func (v *uintIntMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -6574,7 +7757,7 @@ func (v *uintIntMapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -6604,6 +7787,14 @@ func (v *uintIntMapValue) String() string {
 
 func (v *uintIntMapValue) Type() string { return "map[uint]int" }
 
+func (v *uintIntMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintIntMapValue) clear() { *v.value = make(map[uint]int) }
+
+func (v *uintIntMapValue) appendNext() {}
+
+func (v *uintIntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintIntMapValue) IsCumulative() bool {
 	return true
 }
@@ -6611,6 +7802,7 @@ func (v *uintIntMapValue) IsCumulative() bool {
 // -- uint8IntMapValue
 type uint8IntMapValue struct {
 	value *map[uint8]int
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8IntMapValue)(nil)
@@ -6624,12 +7816,12 @@ func newUint8IntMapValue(m *map[uint8]int) *uint8IntMapValue {
 }
 
 func (v *uint8IntMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -6638,7 +7830,7 @@ func (v *uint8IntMapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -6668,6 +7860,14 @@ func (v *uint8IntMapValue) String() string {
 
 func (v *uint8IntMapValue) Type() string { return "map[uint8]int" }
 
+func (v *uint8IntMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8IntMapValue) clear() { *v.value = make(map[uint8]int) }
+
+func (v *uint8IntMapValue) appendNext() {}
+
+func (v *uint8IntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8IntMapValue) IsCumulative() bool {
 	return true
 }
@@ -6675,6 +7875,7 @@ func (v *uint8IntMapValue) IsCumulative() bool {
 // -- uint16IntMapValue
 type uint16IntMapValue struct {
 	value *map[uint16]int
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16IntMapValue)(nil)
@@ -6688,12 +7889,12 @@ func newUint16IntMapValue(m *map[uint16]int) *uint16IntMapValue {
 }
 
 func (v *uint16IntMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -6702,7 +7903,7 @@ func (v *uint16IntMapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -6732,6 +7933,14 @@ func (v *uint16IntMapValue) String() string {
 
 func (v *uint16IntMapValue) Type() string { return "map[uint16]int" }
 
+func (v *uint16IntMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16IntMapValue) clear() { *v.value = make(map[uint16]int) }
+
+func (v *uint16IntMapValue) appendNext() {}
+
+func (v *uint16IntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16IntMapValue) IsCumulative() bool {
 	return true
 }
@@ -6739,6 +7948,7 @@ func (v *uint16IntMapValue) IsCumulative() bool {
 // -- uint32IntMapValue
 type uint32IntMapValue struct {
 	value *map[uint32]int
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32IntMapValue)(nil)
@@ -6752,12 +7962,12 @@ func newUint32IntMapValue(m *map[uint32]int) *uint32IntMapValue {
 }
 
 func (v *uint32IntMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -6766,7 +7976,7 @@ func (v *uint32IntMapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -6796,6 +8006,14 @@ func (v *uint32IntMapValue) String() string {
 
 func (v *uint32IntMapValue) Type() string { return "map[uint32]int" }
 
+func (v *uint32IntMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32IntMapValue) clear() { *v.value = make(map[uint32]int) }
+
+func (v *uint32IntMapValue) appendNext() {}
+
+func (v *uint32IntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32IntMapValue) IsCumulative() bool {
 	return true
 }
@@ -6803,6 +8021,7 @@ func (v *uint32IntMapValue) IsCumulative() bool {
 // -- uint64IntMapValue
 type uint64IntMapValue struct {
 	value *map[uint64]int
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64IntMapValue)(nil)
@@ -6816,12 +8035,12 @@ func newUint64IntMapValue(m *map[uint64]int) *uint64IntMapValue {
 }
 
 func (v *uint64IntMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -6830,7 +8049,7 @@ func (v *uint64IntMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -6860,6 +8079,14 @@ func (v *uint64IntMapValue) String() string {
 
 func (v *uint64IntMapValue) Type() string { return "map[uint64]int" }
 
+func (v *uint64IntMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64IntMapValue) clear() { *v.value = make(map[uint64]int) }
+
+func (v *uint64IntMapValue) appendNext() {}
+
+func (v *uint64IntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64IntMapValue) IsCumulative() bool {
 	return true
 }
@@ -6906,6 +8133,7 @@ func (v *int8Value) Type() string { return "int8" }
 type int8SliceValue struct {
 	value   *[]int8
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*int8SliceValue)(nil)
@@ -6919,7 +8147,10 @@ func newInt8SliceValue(slice *[]int8) *int8SliceValue {
 }
 
 func (v *int8SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]int8, len(ss))
 	for i, s := range ss {
@@ -6963,9 +8194,21 @@ func (v *int8SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *int8SliceValue) sliceSeparator() string { return v.sep }
+
+func (v *int8SliceValue) clear() {
+	*v.value = []int8{}
+	v.changed = true
+}
+
+func (v *int8SliceValue) appendNext() { v.changed = true }
+
+func (v *int8SliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringInt8MapValue
 type stringInt8MapValue struct {
 	value *map[string]int8
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringInt8MapValue)(nil)
@@ -6979,16 +8222,16 @@ func newStringInt8MapValue(m *map[string]int8) *stringInt8MapValue {
 }
 
 func (v *stringInt8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -7018,6 +8261,14 @@ func (v *stringInt8MapValue) String() string {
 
 func (v *stringInt8MapValue) Type() string { return "map[string]int8" }
 
+func (v *stringInt8MapValue) mapSeparator() string { return v.sep }
+
+func (v *stringInt8MapValue) clear() { *v.value = make(map[string]int8) }
+
+func (v *stringInt8MapValue) appendNext() {}
+
+func (v *stringInt8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringInt8MapValue) IsCumulative() bool {
 	return true
 }
@@ -7025,6 +8276,7 @@ func (v *stringInt8MapValue) IsCumulative() bool {
 // -- intInt8MapValue
 type intInt8MapValue struct {
 	value *map[int]int8
+	sep   string
 }
 
 var _ RepeatableFlag = (*intInt8MapValue)(nil)
@@ -7038,12 +8290,12 @@ func newIntInt8MapValue(m *map[int]int8) *intInt8MapValue {
 }
 
 func (v *intInt8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -7052,7 +8304,7 @@ func (v *intInt8MapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -7082,6 +8334,14 @@ func (v *intInt8MapValue) String() string {
 
 func (v *intInt8MapValue) Type() string { return "map[int]int8" }
 
+func (v *intInt8MapValue) mapSeparator() string { return v.sep }
+
+func (v *intInt8MapValue) clear() { *v.value = make(map[int]int8) }
+
+func (v *intInt8MapValue) appendNext() {}
+
+func (v *intInt8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intInt8MapValue) IsCumulative() bool {
 	return true
 }
@@ -7089,6 +8349,7 @@ func (v *intInt8MapValue) IsCumulative() bool {
 // -- int8Int8MapValue
 type int8Int8MapValue struct {
 	value *map[int8]int8
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8Int8MapValue)(nil)
@@ -7102,12 +8363,12 @@ func newInt8Int8MapValue(m *map[int8]int8) *int8Int8MapValue {
 }
 
 func (v *int8Int8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -7116,7 +8377,7 @@ func (v *int8Int8MapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -7146,6 +8407,14 @@ func (v *int8Int8MapValue) String() string {
 
 func (v *int8Int8MapValue) Type() string { return "map[int8]int8" }
 
+func (v *int8Int8MapValue) mapSeparator() string { return v.sep }
+
+func (v *int8Int8MapValue) clear() { *v.value = make(map[int8]int8) }
+
+func (v *int8Int8MapValue) appendNext() {}
+
+func (v *int8Int8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8Int8MapValue) IsCumulative() bool {
 	return true
 }
@@ -7153,6 +8422,7 @@ func (v *int8Int8MapValue) IsCumulative() bool {
 // -- int16Int8MapValue
 type int16Int8MapValue struct {
 	value *map[int16]int8
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16Int8MapValue)(nil)
@@ -7166,12 +8436,12 @@ func newInt16Int8MapValue(m *map[int16]int8) *int16Int8MapValue {
 }
 
 func (v *int16Int8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -7180,7 +8450,7 @@ func (v *int16Int8MapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -7210,6 +8480,14 @@ func (v *int16Int8MapValue) String() string {
 
 func (v *int16Int8MapValue) Type() string { return "map[int16]int8" }
 
+func (v *int16Int8MapValue) mapSeparator() string { return v.sep }
+
+func (v *int16Int8MapValue) clear() { *v.value = make(map[int16]int8) }
+
+func (v *int16Int8MapValue) appendNext() {}
+
+func (v *int16Int8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16Int8MapValue) IsCumulative() bool {
 	return true
 }
@@ -7217,6 +8495,7 @@ func (v *int16Int8MapValue) IsCumulative() bool {
 // -- int32Int8MapValue
 type int32Int8MapValue struct {
 	value *map[int32]int8
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32Int8MapValue)(nil)
@@ -7230,12 +8509,12 @@ func newInt32Int8MapValue(m *map[int32]int8) *int32Int8MapValue {
 }
 
 func (v *int32Int8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -7244,7 +8523,7 @@ func (v *int32Int8MapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -7274,6 +8553,14 @@ func (v *int32Int8MapValue) String() string {
 
 func (v *int32Int8MapValue) Type() string { return "map[int32]int8" }
 
+func (v *int32Int8MapValue) mapSeparator() string { return v.sep }
+
+func (v *int32Int8MapValue) clear() { *v.value = make(map[int32]int8) }
+
+func (v *int32Int8MapValue) appendNext() {}
+
+func (v *int32Int8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32Int8MapValue) IsCumulative() bool {
 	return true
 }
@@ -7281,6 +8568,7 @@ func (v *int32Int8MapValue) IsCumulative() bool {
 // -- int64Int8MapValue
 type int64Int8MapValue struct {
 	value *map[int64]int8
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64Int8MapValue)(nil)
@@ -7294,12 +8582,12 @@ func newInt64Int8MapValue(m *map[int64]int8) *int64Int8MapValue {
 }
 
 func (v *int64Int8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -7308,7 +8596,7 @@ func (v *int64Int8MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -7338,6 +8626,14 @@ func (v *int64Int8MapValue) String() string {
 
 func (v *int64Int8MapValue) Type() string { return "map[int64]int8" }
 
+func (v *int64Int8MapValue) mapSeparator() string { return v.sep }
+
+func (v *int64Int8MapValue) clear() { *v.value = make(map[int64]int8) }
+
+func (v *int64Int8MapValue) appendNext() {}
+
+func (v *int64Int8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64Int8MapValue) IsCumulative() bool {
 	return true
 }
@@ -7345,6 +8641,7 @@ func (v *int64Int8MapValue) IsCumulative() bool {
 // -- uintInt8MapValue
 type uintInt8MapValue struct {
 	value *map[uint]int8
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintInt8MapValue)(nil)
@@ -7358,12 +8655,12 @@ func newUintInt8MapValue(m *map[uint]int8) *uintInt8MapValue {
 }
 
 func (v *uintInt8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -7372,7 +8669,7 @@ func (v *uintInt8MapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -7402,6 +8699,14 @@ func (v *uintInt8MapValue) String() string {
 
 func (v *uintInt8MapValue) Type() string { return "map[uint]int8" }
 
+func (v *uintInt8MapValue) mapSeparator() string { return v.sep }
+
+func (v *uintInt8MapValue) clear() { *v.value = make(map[uint]int8) }
+
+func (v *uintInt8MapValue) appendNext() {}
+
+func (v *uintInt8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintInt8MapValue) IsCumulative() bool {
 	return true
 }
@@ -7409,6 +8714,7 @@ func (v *uintInt8MapValue) IsCumulative() bool {
 // -- uint8Int8MapValue
 type uint8Int8MapValue struct {
 	value *map[uint8]int8
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8Int8MapValue)(nil)
@@ -7422,12 +8728,12 @@ func newUint8Int8MapValue(m *map[uint8]int8) *uint8Int8MapValue {
 }
 
 func (v *uint8Int8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -7436,7 +8742,7 @@ func (v *uint8Int8MapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -7466,6 +8772,14 @@ func (v *uint8Int8MapValue) String() string {
 
 func (v *uint8Int8MapValue) Type() string { return "map[uint8]int8" }
 
+func (v *uint8Int8MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8Int8MapValue) clear() { *v.value = make(map[uint8]int8) }
+
+func (v *uint8Int8MapValue) appendNext() {}
+
+func (v *uint8Int8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8Int8MapValue) IsCumulative() bool {
 	return true
 }
@@ -7473,6 +8787,7 @@ func (v *uint8Int8MapValue) IsCumulative() bool {
 // -- uint16Int8MapValue
 type uint16Int8MapValue struct {
 	value *map[uint16]int8
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16Int8MapValue)(nil)
@@ -7486,12 +8801,12 @@ func newUint16Int8MapValue(m *map[uint16]int8) *uint16Int8MapValue {
 }
 
 func (v *uint16Int8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -7500,7 +8815,7 @@ func (v *uint16Int8MapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -7530,6 +8845,14 @@ func (v *uint16Int8MapValue) String() string {
 
 func (v *uint16Int8MapValue) Type() string { return "map[uint16]int8" }
 
+func (v *uint16Int8MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16Int8MapValue) clear() { *v.value = make(map[uint16]int8) }
+
+func (v *uint16Int8MapValue) appendNext() {}
+
+func (v *uint16Int8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16Int8MapValue) IsCumulative() bool {
 	return true
 }
@@ -7537,6 +8860,7 @@ func (v *uint16Int8MapValue) IsCumulative() bool {
 // -- uint32Int8MapValue
 type uint32Int8MapValue struct {
 	value *map[uint32]int8
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32Int8MapValue)(nil)
@@ -7550,12 +8874,12 @@ func newUint32Int8MapValue(m *map[uint32]int8) *uint32Int8MapValue {
 }
 
 func (v *uint32Int8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -7564,7 +8888,7 @@ func (v *uint32Int8MapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -7594,6 +8918,14 @@ func (v *uint32Int8MapValue) String() string {
 
 func (v *uint32Int8MapValue) Type() string { return "map[uint32]int8" }
 
+func (v *uint32Int8MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32Int8MapValue) clear() { *v.value = make(map[uint32]int8) }
+
+func (v *uint32Int8MapValue) appendNext() {}
+
+func (v *uint32Int8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32Int8MapValue) IsCumulative() bool {
 	return true
 }
@@ -7601,6 +8933,7 @@ func (v *uint32Int8MapValue) IsCumulative() bool {
 // -- uint64Int8MapValue
 type uint64Int8MapValue struct {
 	value *map[uint64]int8
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64Int8MapValue)(nil)
@@ -7614,12 +8947,12 @@ func newUint64Int8MapValue(m *map[uint64]int8) *uint64Int8MapValue {
 }
 
 func (v *uint64Int8MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -7628,7 +8961,7 @@ func (v *uint64Int8MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -7658,6 +8991,14 @@ func (v *uint64Int8MapValue) String() string {
 
 func (v *uint64Int8MapValue) Type() string { return "map[uint64]int8" }
 
+func (v *uint64Int8MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64Int8MapValue) clear() { *v.value = make(map[uint64]int8) }
+
+func (v *uint64Int8MapValue) appendNext() {}
+
+func (v *uint64Int8MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64Int8MapValue) IsCumulative() bool {
 	return true
 }
@@ -7704,6 +9045,7 @@ func (v *int16Value) Type() string { return "int16" }
 type int16SliceValue struct {
 	value   *[]int16
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*int16SliceValue)(nil)
@@ -7717,7 +9059,10 @@ func newInt16SliceValue(slice *[]int16) *int16SliceValue {
 }
 
 func (v *int16SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]int16, len(ss))
 	for i, s := range ss {
@@ -7761,9 +9106,21 @@ func (v *int16SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *int16SliceValue) sliceSeparator() string { return v.sep }
+
+func (v *int16SliceValue) clear() {
+	*v.value = []int16{}
+	v.changed = true
+}
+
+func (v *int16SliceValue) appendNext() { v.changed = true }
+
+func (v *int16SliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringInt16MapValue
 type stringInt16MapValue struct {
 	value *map[string]int16
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringInt16MapValue)(nil)
@@ -7777,16 +9134,16 @@ func newStringInt16MapValue(m *map[string]int16) *stringInt16MapValue {
 }
 
 func (v *stringInt16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -7816,6 +9173,14 @@ func (v *stringInt16MapValue) String() string {
 
 func (v *stringInt16MapValue) Type() string { return "map[string]int16" }
 
+func (v *stringInt16MapValue) mapSeparator() string { return v.sep }
+
+func (v *stringInt16MapValue) clear() { *v.value = make(map[string]int16) }
+
+func (v *stringInt16MapValue) appendNext() {}
+
+func (v *stringInt16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringInt16MapValue) IsCumulative() bool {
 	return true
 }
@@ -7823,6 +9188,7 @@ func (v *stringInt16MapValue) IsCumulative() bool {
 // -- intInt16MapValue
 type intInt16MapValue struct {
 	value *map[int]int16
+	sep   string
 }
 
 var _ RepeatableFlag = (*intInt16MapValue)(nil)
@@ -7836,12 +9202,12 @@ func newIntInt16MapValue(m *map[int]int16) *intInt16MapValue {
 }
 
 func (v *intInt16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -7850,7 +9216,7 @@ func (v *intInt16MapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -7880,6 +9246,14 @@ func (v *intInt16MapValue) String() string {
 
 func (v *intInt16MapValue) Type() string { return "map[int]int16" }
 
+func (v *intInt16MapValue) mapSeparator() string { return v.sep }
+
+func (v *intInt16MapValue) clear() { *v.value = make(map[int]int16) }
+
+func (v *intInt16MapValue) appendNext() {}
+
+func (v *intInt16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intInt16MapValue) IsCumulative() bool {
 	return true
 }
@@ -7887,6 +9261,7 @@ func (v *intInt16MapValue) IsCumulative() bool {
 // -- int8Int16MapValue
 type int8Int16MapValue struct {
 	value *map[int8]int16
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8Int16MapValue)(nil)
@@ -7900,12 +9275,12 @@ func newInt8Int16MapValue(m *map[int8]int16) *int8Int16MapValue {
 }
 
 func (v *int8Int16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -7914,7 +9289,7 @@ func (v *int8Int16MapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -7944,6 +9319,14 @@ func (v *int8Int16MapValue) String() string {
 
 func (v *int8Int16MapValue) Type() string { return "map[int8]int16" }
 
+func (v *int8Int16MapValue) mapSeparator() string { return v.sep }
+
+func (v *int8Int16MapValue) clear() { *v.value = make(map[int8]int16) }
+
+func (v *int8Int16MapValue) appendNext() {}
+
+func (v *int8Int16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8Int16MapValue) IsCumulative() bool {
 	return true
 }
@@ -7951,6 +9334,7 @@ func (v *int8Int16MapValue) IsCumulative() bool {
 // -- int16Int16MapValue
 type int16Int16MapValue struct {
 	value *map[int16]int16
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16Int16MapValue)(nil)
@@ -7964,12 +9348,12 @@ func newInt16Int16MapValue(m *map[int16]int16) *int16Int16MapValue {
 }
 
 func (v *int16Int16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -7978,7 +9362,7 @@ func (v *int16Int16MapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -8008,6 +9392,14 @@ func (v *int16Int16MapValue) String() string {
 
 func (v *int16Int16MapValue) Type() string { return "map[int16]int16" }
 
+func (v *int16Int16MapValue) mapSeparator() string { return v.sep }
+
+func (v *int16Int16MapValue) clear() { *v.value = make(map[int16]int16) }
+
+func (v *int16Int16MapValue) appendNext() {}
+
+func (v *int16Int16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16Int16MapValue) IsCumulative() bool {
 	return true
 }
@@ -8015,6 +9407,7 @@ func (v *int16Int16MapValue) IsCumulative() bool {
 // -- int32Int16MapValue
 type int32Int16MapValue struct {
 	value *map[int32]int16
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32Int16MapValue)(nil)
@@ -8028,12 +9421,12 @@ func newInt32Int16MapValue(m *map[int32]int16) *int32Int16MapValue {
 }
 
 func (v *int32Int16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -8042,7 +9435,7 @@ func (v *int32Int16MapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -8072,6 +9465,14 @@ func (v *int32Int16MapValue) String() string {
 
 func (v *int32Int16MapValue) Type() string { return "map[int32]int16" }
 
+func (v *int32Int16MapValue) mapSeparator() string { return v.sep }
+
+func (v *int32Int16MapValue) clear() { *v.value = make(map[int32]int16) }
+
+func (v *int32Int16MapValue) appendNext() {}
+
+func (v *int32Int16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32Int16MapValue) IsCumulative() bool {
 	return true
 }
@@ -8079,6 +9480,7 @@ func (v *int32Int16MapValue) IsCumulative() bool {
 // -- int64Int16MapValue
 type int64Int16MapValue struct {
 	value *map[int64]int16
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64Int16MapValue)(nil)
@@ -8092,12 +9494,12 @@ func newInt64Int16MapValue(m *map[int64]int16) *int64Int16MapValue {
 }
 
 func (v *int64Int16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -8106,7 +9508,7 @@ func (v *int64Int16MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -8136,6 +9538,14 @@ func (v *int64Int16MapValue) String() string {
 
 func (v *int64Int16MapValue) Type() string { return "map[int64]int16" }
 
+func (v *int64Int16MapValue) mapSeparator() string { return v.sep }
+
+func (v *int64Int16MapValue) clear() { *v.value = make(map[int64]int16) }
+
+func (v *int64Int16MapValue) appendNext() {}
+
+func (v *int64Int16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64Int16MapValue) IsCumulative() bool {
 	return true
 }
@@ -8143,6 +9553,7 @@ func (v *int64Int16MapValue) IsCumulative() bool {
 // -- uintInt16MapValue
 type uintInt16MapValue struct {
 	value *map[uint]int16
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintInt16MapValue)(nil)
@@ -8156,12 +9567,12 @@ func newUintInt16MapValue(m *map[uint]int16) *uintInt16MapValue {
 }
 
 func (v *uintInt16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -8170,7 +9581,7 @@ func (v *uintInt16MapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -8200,6 +9611,14 @@ func (v *uintInt16MapValue) String() string {
 
 func (v *uintInt16MapValue) Type() string { return "map[uint]int16" }
 
+func (v *uintInt16MapValue) mapSeparator() string { return v.sep }
+
+func (v *uintInt16MapValue) clear() { *v.value = make(map[uint]int16) }
+
+func (v *uintInt16MapValue) appendNext() {}
+
+func (v *uintInt16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintInt16MapValue) IsCumulative() bool {
 	return true
 }
@@ -8207,6 +9626,7 @@ func (v *uintInt16MapValue) IsCumulative() bool {
 // -- uint8Int16MapValue
 type uint8Int16MapValue struct {
 	value *map[uint8]int16
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8Int16MapValue)(nil)
@@ -8220,12 +9640,12 @@ func newUint8Int16MapValue(m *map[uint8]int16) *uint8Int16MapValue {
 }
 
 func (v *uint8Int16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -8234,7 +9654,7 @@ func (v *uint8Int16MapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -8264,6 +9684,14 @@ func (v *uint8Int16MapValue) String() string {
 
 func (v *uint8Int16MapValue) Type() string { return "map[uint8]int16" }
 
+func (v *uint8Int16MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8Int16MapValue) clear() { *v.value = make(map[uint8]int16) }
+
+func (v *uint8Int16MapValue) appendNext() {}
+
+func (v *uint8Int16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8Int16MapValue) IsCumulative() bool {
 	return true
 }
@@ -8271,6 +9699,7 @@ func (v *uint8Int16MapValue) IsCumulative() bool {
 // -- uint16Int16MapValue
 type uint16Int16MapValue struct {
 	value *map[uint16]int16
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16Int16MapValue)(nil)
@@ -8284,12 +9713,12 @@ func newUint16Int16MapValue(m *map[uint16]int16) *uint16Int16MapValue {
 }
 
 func (v *uint16Int16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -8298,7 +9727,7 @@ func (v *uint16Int16MapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -8328,6 +9757,14 @@ func (v *uint16Int16MapValue) String() string {
 
 func (v *uint16Int16MapValue) Type() string { return "map[uint16]int16" }
 
+func (v *uint16Int16MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16Int16MapValue) clear() { *v.value = make(map[uint16]int16) }
+
+func (v *uint16Int16MapValue) appendNext() {}
+
+func (v *uint16Int16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16Int16MapValue) IsCumulative() bool {
 	return true
 }
@@ -8335,6 +9772,7 @@ func (v *uint16Int16MapValue) IsCumulative() bool {
 // -- uint32Int16MapValue
 type uint32Int16MapValue struct {
 	value *map[uint32]int16
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32Int16MapValue)(nil)
@@ -8348,12 +9786,12 @@ func newUint32Int16MapValue(m *map[uint32]int16) *uint32Int16MapValue {
 }
 
 func (v *uint32Int16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -8362,7 +9800,7 @@ func (v *uint32Int16MapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -8392,6 +9830,14 @@ func (v *uint32Int16MapValue) String() string {
 
 func (v *uint32Int16MapValue) Type() string { return "map[uint32]int16" }
 
+func (v *uint32Int16MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32Int16MapValue) clear() { *v.value = make(map[uint32]int16) }
+
+func (v *uint32Int16MapValue) appendNext() {}
+
+func (v *uint32Int16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32Int16MapValue) IsCumulative() bool {
 	return true
 }
@@ -8399,6 +9845,7 @@ func (v *uint32Int16MapValue) IsCumulative() bool {
 // -- uint64Int16MapValue
 type uint64Int16MapValue struct {
 	value *map[uint64]int16
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64Int16MapValue)(nil)
@@ -8412,12 +9859,12 @@ func newUint64Int16MapValue(m *map[uint64]int16) *uint64Int16MapValue {
 }
 
 func (v *uint64Int16MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -8426,7 +9873,7 @@ func (v *uint64Int16MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -8456,6 +9903,14 @@ func (v *uint64Int16MapValue) String() string {
 
 func (v *uint64Int16MapValue) Type() string { return "map[uint64]int16" }
 
+func (v *uint64Int16MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64Int16MapValue) clear() { *v.value = make(map[uint64]int16) }
+
+func (v *uint64Int16MapValue) appendNext() {}
+
+func (v *uint64Int16MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64Int16MapValue) IsCumulative() bool {
 	return true
 }
@@ -8502,6 +9957,7 @@ func (v *int32Value) Type() string { return "int32" }
 type int32SliceValue struct {
 	value   *[]int32
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*int32SliceValue)(nil)
@@ -8515,7 +9971,10 @@ func newInt32SliceValue(slice *[]int32) *int32SliceValue {
 }
 
 func (v *int32SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]int32, len(ss))
 	for i, s := range ss {
@@ -8559,9 +10018,21 @@ func (v *int32SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *int32SliceValue) sliceSeparator() string { return v.sep }
+
+func (v *int32SliceValue) clear() {
+	*v.value = []int32{}
+	v.changed = true
+}
+
+func (v *int32SliceValue) appendNext() { v.changed = true }
+
+func (v *int32SliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringInt32MapValue
 type stringInt32MapValue struct {
 	value *map[string]int32
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringInt32MapValue)(nil)
@@ -8575,16 +10046,16 @@ func newStringInt32MapValue(m *map[string]int32) *stringInt32MapValue {
 }
 
 func (v *stringInt32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -8614,13 +10085,22 @@ func (v *stringInt32MapValue) String() string {
 
 func (v *stringInt32MapValue) Type() string { return "map[string]int32" }
 
-func (v *stringInt32MapValue) IsCumulative() bool {
+func (v *stringInt32MapValue) mapSeparator() string { return v.sep }
+
+func (v *stringInt32MapValue) clear() { *v.value = make(map[string]int32) }
+
+func (v *stringInt32MapValue) appendNext() {}
+
+func (v *stringInt32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *stringInt32MapValue) IsCumulative() bool {
 	return true
 }
 
 // -- intInt32MapValue
 type intInt32MapValue struct {
 	value *map[int]int32
+	sep   string
 }
 
 var _ RepeatableFlag = (*intInt32MapValue)(nil)
@@ -8634,12 +10114,12 @@ func newIntInt32MapValue(m *map[int]int32) *intInt32MapValue {
 }
 
 func (v *intInt32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -8648,7 +10128,7 @@ func (v *intInt32MapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -8678,6 +10158,14 @@ func (v *intInt32MapValue) String() string {
 
 func (v *intInt32MapValue) Type() string { return "map[int]int32" }
 
+func (v *intInt32MapValue) mapSeparator() string { return v.sep }
+
+func (v *intInt32MapValue) clear() { *v.value = make(map[int]int32) }
+
+func (v *intInt32MapValue) appendNext() {}
+
+func (v *intInt32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intInt32MapValue) IsCumulative() bool {
 	return true
 }
@@ -8685,6 +10173,7 @@ func (v *intInt32MapValue) IsCumulative() bool {
 // -- int8Int32MapValue
 type int8Int32MapValue struct {
 	value *map[int8]int32
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8Int32MapValue)(nil)
@@ -8698,12 +10187,12 @@ func newInt8Int32MapValue(m *map[int8]int32) *int8Int32MapValue {
 }
 
 func (v *int8Int32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -8712,7 +10201,7 @@ func (v *int8Int32MapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -8742,6 +10231,14 @@ func (v *int8Int32MapValue) String() string {
 
 func (v *int8Int32MapValue) Type() string { return "map[int8]int32" }
 
+func (v *int8Int32MapValue) mapSeparator() string { return v.sep }
+
+func (v *int8Int32MapValue) clear() { *v.value = make(map[int8]int32) }
+
+func (v *int8Int32MapValue) appendNext() {}
+
+func (v *int8Int32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8Int32MapValue) IsCumulative() bool {
 	return true
 }
@@ -8749,6 +10246,7 @@ func (v *int8Int32MapValue) IsCumulative() bool {
 // -- int16Int32MapValue
 type int16Int32MapValue struct {
 	value *map[int16]int32
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16Int32MapValue)(nil)
@@ -8762,12 +10260,12 @@ func newInt16Int32MapValue(m *map[int16]int32) *int16Int32MapValue {
 }
 
 func (v *int16Int32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -8776,7 +10274,7 @@ func (v *int16Int32MapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -8806,6 +10304,14 @@ func (v *int16Int32MapValue) String() string {
 
 func (v *int16Int32MapValue) Type() string { return "map[int16]int32" }
 
+func (v *int16Int32MapValue) mapSeparator() string { return v.sep }
+
+func (v *int16Int32MapValue) clear() { *v.value = make(map[int16]int32) }
+
+func (v *int16Int32MapValue) appendNext() {}
+
+func (v *int16Int32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16Int32MapValue) IsCumulative() bool {
 	return true
 }
@@ -8813,6 +10319,7 @@ func (v *int16Int32MapValue) IsCumulative() bool {
 // -- int32Int32MapValue
 type int32Int32MapValue struct {
 	value *map[int32]int32
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32Int32MapValue)(nil)
@@ -8826,12 +10333,12 @@ func newInt32Int32MapValue(m *map[int32]int32) *int32Int32MapValue {
 }
 
 func (v *int32Int32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -8840,7 +10347,7 @@ func (v *int32Int32MapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -8870,6 +10377,14 @@ func (v *int32Int32MapValue) String() string {
 
 func (v *int32Int32MapValue) Type() string { return "map[int32]int32" }
 
+func (v *int32Int32MapValue) mapSeparator() string { return v.sep }
+
+func (v *int32Int32MapValue) clear() { *v.value = make(map[int32]int32) }
+
+func (v *int32Int32MapValue) appendNext() {}
+
+func (v *int32Int32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32Int32MapValue) IsCumulative() bool {
 	return true
 }
@@ -8877,6 +10392,7 @@ func (v *int32Int32MapValue) IsCumulative() bool {
 // -- int64Int32MapValue
 type int64Int32MapValue struct {
 	value *map[int64]int32
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64Int32MapValue)(nil)
@@ -8890,12 +10406,12 @@ func newInt64Int32MapValue(m *map[int64]int32) *int64Int32MapValue {
 }
 
 func (v *int64Int32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -8904,7 +10420,7 @@ func (v *int64Int32MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -8934,6 +10450,14 @@ func (v *int64Int32MapValue) String() string {
 
 func (v *int64Int32MapValue) Type() string { return "map[int64]int32" }
 
+func (v *int64Int32MapValue) mapSeparator() string { return v.sep }
+
+func (v *int64Int32MapValue) clear() { *v.value = make(map[int64]int32) }
+
+func (v *int64Int32MapValue) appendNext() {}
+
+func (v *int64Int32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64Int32MapValue) IsCumulative() bool {
 	return true
 }
@@ -8941,6 +10465,7 @@ func (v *int64Int32MapValue) IsCumulative() bool {
 // -- uintInt32MapValue
 type uintInt32MapValue struct {
 	value *map[uint]int32
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintInt32MapValue)(nil)
@@ -8954,12 +10479,12 @@ func newUintInt32MapValue(m *map[uint]int32) *uintInt32MapValue {
 }
 
 func (v *uintInt32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -8968,7 +10493,7 @@ func (v *uintInt32MapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -8998,6 +10523,14 @@ func (v *uintInt32MapValue) String() string {
 
 func (v *uintInt32MapValue) Type() string { return "map[uint]int32" }
 
+func (v *uintInt32MapValue) mapSeparator() string { return v.sep }
+
+func (v *uintInt32MapValue) clear() { *v.value = make(map[uint]int32) }
+
+func (v *uintInt32MapValue) appendNext() {}
+
+func (v *uintInt32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintInt32MapValue) IsCumulative() bool {
 	return true
 }
@@ -9005,6 +10538,7 @@ func (v *uintInt32MapValue) IsCumulative() bool {
 // -- uint8Int32MapValue
 type uint8Int32MapValue struct {
 	value *map[uint8]int32
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8Int32MapValue)(nil)
@@ -9018,12 +10552,12 @@ func newUint8Int32MapValue(m *map[uint8]int32) *uint8Int32MapValue {
 }
 
 func (v *uint8Int32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -9032,7 +10566,7 @@ func (v *uint8Int32MapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -9062,6 +10596,14 @@ func (v *uint8Int32MapValue) String() string {
 
 func (v *uint8Int32MapValue) Type() string { return "map[uint8]int32" }
 
+func (v *uint8Int32MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8Int32MapValue) clear() { *v.value = make(map[uint8]int32) }
+
+func (v *uint8Int32MapValue) appendNext() {}
+
+func (v *uint8Int32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8Int32MapValue) IsCumulative() bool {
 	return true
 }
@@ -9069,6 +10611,7 @@ func (v *uint8Int32MapValue) IsCumulative() bool {
 // -- uint16Int32MapValue
 type uint16Int32MapValue struct {
 	value *map[uint16]int32
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16Int32MapValue)(nil)
@@ -9082,12 +10625,12 @@ func newUint16Int32MapValue(m *map[uint16]int32) *uint16Int32MapValue {
 }
 
 func (v *uint16Int32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -9096,7 +10639,7 @@ func (v *uint16Int32MapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -9126,6 +10669,14 @@ func (v *uint16Int32MapValue) String() string {
 
 func (v *uint16Int32MapValue) Type() string { return "map[uint16]int32" }
 
+func (v *uint16Int32MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16Int32MapValue) clear() { *v.value = make(map[uint16]int32) }
+
+func (v *uint16Int32MapValue) appendNext() {}
+
+func (v *uint16Int32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16Int32MapValue) IsCumulative() bool {
 	return true
 }
@@ -9133,6 +10684,7 @@ func (v *uint16Int32MapValue) IsCumulative() bool {
 // -- uint32Int32MapValue
 type uint32Int32MapValue struct {
 	value *map[uint32]int32
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32Int32MapValue)(nil)
@@ -9146,12 +10698,12 @@ func newUint32Int32MapValue(m *map[uint32]int32) *uint32Int32MapValue {
 }
 
 func (v *uint32Int32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -9160,7 +10712,7 @@ func (v *uint32Int32MapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -9190,6 +10742,14 @@ func (v *uint32Int32MapValue) String() string {
 
 func (v *uint32Int32MapValue) Type() string { return "map[uint32]int32" }
 
+func (v *uint32Int32MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32Int32MapValue) clear() { *v.value = make(map[uint32]int32) }
+
+func (v *uint32Int32MapValue) appendNext() {}
+
+func (v *uint32Int32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32Int32MapValue) IsCumulative() bool {
 	return true
 }
@@ -9197,6 +10757,7 @@ func (v *uint32Int32MapValue) IsCumulative() bool {
 // -- uint64Int32MapValue
 type uint64Int32MapValue struct {
 	value *map[uint64]int32
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64Int32MapValue)(nil)
@@ -9210,12 +10771,12 @@ func newUint64Int32MapValue(m *map[uint64]int32) *uint64Int32MapValue {
 }
 
 func (v *uint64Int32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -9224,7 +10785,7 @@ func (v *uint64Int32MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -9254,6 +10815,14 @@ func (v *uint64Int32MapValue) String() string {
 
 func (v *uint64Int32MapValue) Type() string { return "map[uint64]int32" }
 
+func (v *uint64Int32MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64Int32MapValue) clear() { *v.value = make(map[uint64]int32) }
+
+func (v *uint64Int32MapValue) appendNext() {}
+
+func (v *uint64Int32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64Int32MapValue) IsCumulative() bool {
 	return true
 }
@@ -9300,6 +10869,7 @@ func (v *int64Value) Type() string { return "int64" }
 type int64SliceValue struct {
 	value   *[]int64
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*int64SliceValue)(nil)
@@ -9313,7 +10883,10 @@ func newInt64SliceValue(slice *[]int64) *int64SliceValue {
 }
 
 func (v *int64SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]int64, len(ss))
 	for i, s := range ss {
@@ -9357,9 +10930,21 @@ func (v *int64SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *int64SliceValue) sliceSeparator() string { return v.sep }
+
+func (v *int64SliceValue) clear() {
+	*v.value = []int64{}
+	v.changed = true
+}
+
+func (v *int64SliceValue) appendNext() { v.changed = true }
+
+func (v *int64SliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringInt64MapValue
 type stringInt64MapValue struct {
 	value *map[string]int64
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringInt64MapValue)(nil)
@@ -9373,16 +10958,16 @@ func newStringInt64MapValue(m *map[string]int64) *stringInt64MapValue {
 }
 
 func (v *stringInt64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -9412,6 +10997,14 @@ func (v *stringInt64MapValue) String() string {
 
 func (v *stringInt64MapValue) Type() string { return "map[string]int64" }
 
+func (v *stringInt64MapValue) mapSeparator() string { return v.sep }
+
+func (v *stringInt64MapValue) clear() { *v.value = make(map[string]int64) }
+
+func (v *stringInt64MapValue) appendNext() {}
+
+func (v *stringInt64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringInt64MapValue) IsCumulative() bool {
 	return true
 }
@@ -9419,6 +11012,7 @@ func (v *stringInt64MapValue) IsCumulative() bool {
 // -- intInt64MapValue
 type intInt64MapValue struct {
 	value *map[int]int64
+	sep   string
 }
 
 var _ RepeatableFlag = (*intInt64MapValue)(nil)
@@ -9432,12 +11026,12 @@ func newIntInt64MapValue(m *map[int]int64) *intInt64MapValue {
 }
 
 func (v *intInt64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -9446,7 +11040,7 @@ func (v *intInt64MapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -9476,6 +11070,14 @@ func (v *intInt64MapValue) String() string {
 
 func (v *intInt64MapValue) Type() string { return "map[int]int64" }
 
+func (v *intInt64MapValue) mapSeparator() string { return v.sep }
+
+func (v *intInt64MapValue) clear() { *v.value = make(map[int]int64) }
+
+func (v *intInt64MapValue) appendNext() {}
+
+func (v *intInt64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intInt64MapValue) IsCumulative() bool {
 	return true
 }
@@ -9483,6 +11085,7 @@ func (v *intInt64MapValue) IsCumulative() bool {
 // -- int8Int64MapValue
 type int8Int64MapValue struct {
 	value *map[int8]int64
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8Int64MapValue)(nil)
@@ -9496,12 +11099,12 @@ func newInt8Int64MapValue(m *map[int8]int64) *int8Int64MapValue {
 }
 
 func (v *int8Int64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -9510,7 +11113,7 @@ func (v *int8Int64MapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -9540,6 +11143,14 @@ func (v *int8Int64MapValue) String() string {
 
 func (v *int8Int64MapValue) Type() string { return "map[int8]int64" }
 
+func (v *int8Int64MapValue) mapSeparator() string { return v.sep }
+
+func (v *int8Int64MapValue) clear() { *v.value = make(map[int8]int64) }
+
+func (v *int8Int64MapValue) appendNext() {}
+
+func (v *int8Int64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8Int64MapValue) IsCumulative() bool {
 	return true
 }
@@ -9547,6 +11158,7 @@ func (v *int8Int64MapValue) IsCumulative() bool {
 // -- int16Int64MapValue
 type int16Int64MapValue struct {
 	value *map[int16]int64
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16Int64MapValue)(nil)
@@ -9560,12 +11172,12 @@ func newInt16Int64MapValue(m *map[int16]int64) *int16Int64MapValue {
 }
 
 func (v *int16Int64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -9574,7 +11186,7 @@ func (v *int16Int64MapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -9604,6 +11216,14 @@ func (v *int16Int64MapValue) String() string {
 
 func (v *int16Int64MapValue) Type() string { return "map[int16]int64" }
 
+func (v *int16Int64MapValue) mapSeparator() string { return v.sep }
+
+func (v *int16Int64MapValue) clear() { *v.value = make(map[int16]int64) }
+
+func (v *int16Int64MapValue) appendNext() {}
+
+func (v *int16Int64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16Int64MapValue) IsCumulative() bool {
 	return true
 }
@@ -9611,6 +11231,7 @@ func (v *int16Int64MapValue) IsCumulative() bool {
 // -- int32Int64MapValue
 type int32Int64MapValue struct {
 	value *map[int32]int64
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32Int64MapValue)(nil)
@@ -9624,12 +11245,12 @@ func newInt32Int64MapValue(m *map[int32]int64) *int32Int64MapValue {
 }
 
 func (v *int32Int64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -9638,7 +11259,7 @@ func (v *int32Int64MapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -9668,6 +11289,14 @@ func (v *int32Int64MapValue) String() string {
 
 func (v *int32Int64MapValue) Type() string { return "map[int32]int64" }
 
+func (v *int32Int64MapValue) mapSeparator() string { return v.sep }
+
+func (v *int32Int64MapValue) clear() { *v.value = make(map[int32]int64) }
+
+func (v *int32Int64MapValue) appendNext() {}
+
+func (v *int32Int64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32Int64MapValue) IsCumulative() bool {
 	return true
 }
@@ -9675,6 +11304,7 @@ func (v *int32Int64MapValue) IsCumulative() bool {
 // -- int64Int64MapValue
 type int64Int64MapValue struct {
 	value *map[int64]int64
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64Int64MapValue)(nil)
@@ -9688,12 +11318,12 @@ func newInt64Int64MapValue(m *map[int64]int64) *int64Int64MapValue {
 }
 
 func (v *int64Int64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -9702,7 +11332,7 @@ func (v *int64Int64MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -9732,6 +11362,14 @@ func (v *int64Int64MapValue) String() string {
 
 func (v *int64Int64MapValue) Type() string { return "map[int64]int64" }
 
+func (v *int64Int64MapValue) mapSeparator() string { return v.sep }
+
+func (v *int64Int64MapValue) clear() { *v.value = make(map[int64]int64) }
+
+func (v *int64Int64MapValue) appendNext() {}
+
+func (v *int64Int64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64Int64MapValue) IsCumulative() bool {
 	return true
 }
@@ -9739,6 +11377,7 @@ func (v *int64Int64MapValue) IsCumulative() bool {
 // -- uintInt64MapValue
 type uintInt64MapValue struct {
 	value *map[uint]int64
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintInt64MapValue)(nil)
@@ -9752,12 +11391,12 @@ func newUintInt64MapValue(m *map[uint]int64) *uintInt64MapValue {
 }
 
 func (v *uintInt64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -9766,7 +11405,7 @@ func (v *uintInt64MapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -9796,6 +11435,14 @@ func (v *uintInt64MapValue) String() string {
 
 func (v *uintInt64MapValue) Type() string { return "map[uint]int64" }
 
+func (v *uintInt64MapValue) mapSeparator() string { return v.sep }
+
+func (v *uintInt64MapValue) clear() { *v.value = make(map[uint]int64) }
+
+func (v *uintInt64MapValue) appendNext() {}
+
+func (v *uintInt64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintInt64MapValue) IsCumulative() bool {
 	return true
 }
@@ -9803,6 +11450,7 @@ func (v *uintInt64MapValue) IsCumulative() bool {
 // -- uint8Int64MapValue
 type uint8Int64MapValue struct {
 	value *map[uint8]int64
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8Int64MapValue)(nil)
@@ -9816,12 +11464,12 @@ func newUint8Int64MapValue(m *map[uint8]int64) *uint8Int64MapValue {
 }
 
 func (v *uint8Int64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -9830,7 +11478,7 @@ func (v *uint8Int64MapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -9860,6 +11508,14 @@ func (v *uint8Int64MapValue) String() string {
 
 func (v *uint8Int64MapValue) Type() string { return "map[uint8]int64" }
 
+func (v *uint8Int64MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8Int64MapValue) clear() { *v.value = make(map[uint8]int64) }
+
+func (v *uint8Int64MapValue) appendNext() {}
+
+func (v *uint8Int64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8Int64MapValue) IsCumulative() bool {
 	return true
 }
@@ -9867,6 +11523,7 @@ func (v *uint8Int64MapValue) IsCumulative() bool {
 // -- uint16Int64MapValue
 type uint16Int64MapValue struct {
 	value *map[uint16]int64
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16Int64MapValue)(nil)
@@ -9880,12 +11537,12 @@ func newUint16Int64MapValue(m *map[uint16]int64) *uint16Int64MapValue {
 }
 
 func (v *uint16Int64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -9894,7 +11551,7 @@ func (v *uint16Int64MapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -9924,6 +11581,14 @@ func (v *uint16Int64MapValue) String() string {
 
 func (v *uint16Int64MapValue) Type() string { return "map[uint16]int64" }
 
+func (v *uint16Int64MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16Int64MapValue) clear() { *v.value = make(map[uint16]int64) }
+
+func (v *uint16Int64MapValue) appendNext() {}
+
+func (v *uint16Int64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16Int64MapValue) IsCumulative() bool {
 	return true
 }
@@ -9931,6 +11596,7 @@ func (v *uint16Int64MapValue) IsCumulative() bool {
 // -- uint32Int64MapValue
 type uint32Int64MapValue struct {
 	value *map[uint32]int64
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32Int64MapValue)(nil)
@@ -9944,12 +11610,12 @@ func newUint32Int64MapValue(m *map[uint32]int64) *uint32Int64MapValue {
 }
 
 func (v *uint32Int64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -9958,7 +11624,7 @@ func (v *uint32Int64MapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -9988,6 +11654,14 @@ func (v *uint32Int64MapValue) String() string {
 
 func (v *uint32Int64MapValue) Type() string { return "map[uint32]int64" }
 
+func (v *uint32Int64MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32Int64MapValue) clear() { *v.value = make(map[uint32]int64) }
+
+func (v *uint32Int64MapValue) appendNext() {}
+
+func (v *uint32Int64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32Int64MapValue) IsCumulative() bool {
 	return true
 }
@@ -9995,6 +11669,7 @@ func (v *uint32Int64MapValue) IsCumulative() bool {
 // -- uint64Int64MapValue
 type uint64Int64MapValue struct {
 	value *map[uint64]int64
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64Int64MapValue)(nil)
@@ -10008,12 +11683,12 @@ func newUint64Int64MapValue(m *map[uint64]int64) *uint64Int64MapValue {
 }
 
 func (v *uint64Int64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -10022,7 +11697,7 @@ func (v *uint64Int64MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -10052,6 +11727,14 @@ func (v *uint64Int64MapValue) String() string {
 
 func (v *uint64Int64MapValue) Type() string { return "map[uint64]int64" }
 
+func (v *uint64Int64MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64Int64MapValue) clear() { *v.value = make(map[uint64]int64) }
+
+func (v *uint64Int64MapValue) appendNext() {}
+
+func (v *uint64Int64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64Int64MapValue) IsCumulative() bool {
 	return true
 }
@@ -10098,6 +11781,7 @@ func (v *float64Value) Type() string { return "float64" }
 type float64SliceValue struct {
 	value   *[]float64
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*float64SliceValue)(nil)
@@ -10111,7 +11795,10 @@ func newFloat64SliceValue(slice *[]float64) *float64SliceValue {
 }
 
 func (v *float64SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]float64, len(ss))
 	for i, s := range ss {
@@ -10155,9 +11842,21 @@ func (v *float64SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *float64SliceValue) sliceSeparator() string { return v.sep }
+
+func (v *float64SliceValue) clear() {
+	*v.value = []float64{}
+	v.changed = true
+}
+
+func (v *float64SliceValue) appendNext() { v.changed = true }
+
+func (v *float64SliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringFloat64MapValue
 type stringFloat64MapValue struct {
 	value *map[string]float64
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringFloat64MapValue)(nil)
@@ -10171,16 +11870,16 @@ func newStringFloat64MapValue(m *map[string]float64) *stringFloat64MapValue {
 }
 
 func (v *stringFloat64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 64)
 	if err != nil {
@@ -10210,6 +11909,14 @@ func (v *stringFloat64MapValue) String() string {
 
 func (v *stringFloat64MapValue) Type() string { return "map[string]float64" }
 
+func (v *stringFloat64MapValue) mapSeparator() string { return v.sep }
+
+func (v *stringFloat64MapValue) clear() { *v.value = make(map[string]float64) }
+
+func (v *stringFloat64MapValue) appendNext() {}
+
+func (v *stringFloat64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringFloat64MapValue) IsCumulative() bool {
 	return true
 }
@@ -10217,6 +11924,7 @@ func (v *stringFloat64MapValue) IsCumulative() bool {
 // -- intFloat64MapValue
 type intFloat64MapValue struct {
 	value *map[int]float64
+	sep   string
 }
 
 var _ RepeatableFlag = (*intFloat64MapValue)(nil)
@@ -10230,12 +11938,12 @@ func newIntFloat64MapValue(m *map[int]float64) *intFloat64MapValue {
 }
 
 func (v *intFloat64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -10244,7 +11952,7 @@ func (v *intFloat64MapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 64)
 	if err != nil {
@@ -10274,6 +11982,14 @@ func (v *intFloat64MapValue) String() string {
 
 func (v *intFloat64MapValue) Type() string { return "map[int]float64" }
 
+func (v *intFloat64MapValue) mapSeparator() string { return v.sep }
+
+func (v *intFloat64MapValue) clear() { *v.value = make(map[int]float64) }
+
+func (v *intFloat64MapValue) appendNext() {}
+
+func (v *intFloat64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intFloat64MapValue) IsCumulative() bool {
 	return true
 }
@@ -10281,6 +11997,7 @@ func (v *intFloat64MapValue) IsCumulative() bool {
 // -- int8Float64MapValue
 type int8Float64MapValue struct {
 	value *map[int8]float64
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8Float64MapValue)(nil)
@@ -10294,12 +12011,12 @@ func newInt8Float64MapValue(m *map[int8]float64) *int8Float64MapValue {
 }
 
 func (v *int8Float64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -10308,7 +12025,7 @@ func (v *int8Float64MapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 64)
 	if err != nil {
@@ -10338,6 +12055,14 @@ func (v *int8Float64MapValue) String() string {
 
 func (v *int8Float64MapValue) Type() string { return "map[int8]float64" }
 
+func (v *int8Float64MapValue) mapSeparator() string { return v.sep }
+
+func (v *int8Float64MapValue) clear() { *v.value = make(map[int8]float64) }
+
+func (v *int8Float64MapValue) appendNext() {}
+
+func (v *int8Float64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8Float64MapValue) IsCumulative() bool {
 	return true
 }
@@ -10345,6 +12070,7 @@ func (v *int8Float64MapValue) IsCumulative() bool {
 // -- int16Float64MapValue
 type int16Float64MapValue struct {
 	value *map[int16]float64
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16Float64MapValue)(nil)
@@ -10358,12 +12084,12 @@ func newInt16Float64MapValue(m *map[int16]float64) *int16Float64MapValue {
 }
 
 func (v *int16Float64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -10372,7 +12098,7 @@ func (v *int16Float64MapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 64)
 	if err != nil {
@@ -10402,6 +12128,14 @@ func (v *int16Float64MapValue) String() string {
 
 func (v *int16Float64MapValue) Type() string { return "map[int16]float64" }
 
+func (v *int16Float64MapValue) mapSeparator() string { return v.sep }
+
+func (v *int16Float64MapValue) clear() { *v.value = make(map[int16]float64) }
+
+func (v *int16Float64MapValue) appendNext() {}
+
+func (v *int16Float64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16Float64MapValue) IsCumulative() bool {
 	return true
 }
@@ -10409,6 +12143,7 @@ func (v *int16Float64MapValue) IsCumulative() bool {
 // -- int32Float64MapValue
 type int32Float64MapValue struct {
 	value *map[int32]float64
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32Float64MapValue)(nil)
@@ -10422,12 +12157,12 @@ func newInt32Float64MapValue(m *map[int32]float64) *int32Float64MapValue {
 }
 
 func (v *int32Float64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -10436,7 +12171,7 @@ func (v *int32Float64MapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 64)
 	if err != nil {
@@ -10466,6 +12201,14 @@ func (v *int32Float64MapValue) String() string {
 
 func (v *int32Float64MapValue) Type() string { return "map[int32]float64" }
 
+func (v *int32Float64MapValue) mapSeparator() string { return v.sep }
+
+func (v *int32Float64MapValue) clear() { *v.value = make(map[int32]float64) }
+
+func (v *int32Float64MapValue) appendNext() {}
+
+func (v *int32Float64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32Float64MapValue) IsCumulative() bool {
 	return true
 }
@@ -10473,6 +12216,7 @@ func (v *int32Float64MapValue) IsCumulative() bool {
 // -- int64Float64MapValue
 type int64Float64MapValue struct {
 	value *map[int64]float64
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64Float64MapValue)(nil)
@@ -10486,12 +12230,12 @@ func newInt64Float64MapValue(m *map[int64]float64) *int64Float64MapValue {
 }
 
 func (v *int64Float64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -10500,7 +12244,7 @@ func (v *int64Float64MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 64)
 	if err != nil {
@@ -10530,6 +12274,14 @@ func (v *int64Float64MapValue) String() string {
 
 func (v *int64Float64MapValue) Type() string { return "map[int64]float64" }
 
+func (v *int64Float64MapValue) mapSeparator() string { return v.sep }
+
+func (v *int64Float64MapValue) clear() { *v.value = make(map[int64]float64) }
+
+func (v *int64Float64MapValue) appendNext() {}
+
+func (v *int64Float64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64Float64MapValue) IsCumulative() bool {
 	return true
 }
@@ -10537,6 +12289,7 @@ func (v *int64Float64MapValue) IsCumulative() bool {
 // -- uintFloat64MapValue
 type uintFloat64MapValue struct {
 	value *map[uint]float64
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintFloat64MapValue)(nil)
@@ -10550,12 +12303,12 @@ func newUintFloat64MapValue(m *map[uint]float64) *uintFloat64MapValue {
 }
 
 func (v *uintFloat64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -10564,7 +12317,7 @@ func (v *uintFloat64MapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 64)
 	if err != nil {
@@ -10594,6 +12347,14 @@ func (v *uintFloat64MapValue) String() string {
 
 func (v *uintFloat64MapValue) Type() string { return "map[uint]float64" }
 
+func (v *uintFloat64MapValue) mapSeparator() string { return v.sep }
+
+func (v *uintFloat64MapValue) clear() { *v.value = make(map[uint]float64) }
+
+func (v *uintFloat64MapValue) appendNext() {}
+
+func (v *uintFloat64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintFloat64MapValue) IsCumulative() bool {
 	return true
 }
@@ -10601,6 +12362,7 @@ func (v *uintFloat64MapValue) IsCumulative() bool {
 // -- uint8Float64MapValue
 type uint8Float64MapValue struct {
 	value *map[uint8]float64
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8Float64MapValue)(nil)
@@ -10614,12 +12376,12 @@ func newUint8Float64MapValue(m *map[uint8]float64) *uint8Float64MapValue {
 }
 
 func (v *uint8Float64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -10628,7 +12390,7 @@ func (v *uint8Float64MapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 64)
 	if err != nil {
@@ -10658,6 +12420,14 @@ func (v *uint8Float64MapValue) String() string {
 
 func (v *uint8Float64MapValue) Type() string { return "map[uint8]float64" }
 
+func (v *uint8Float64MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8Float64MapValue) clear() { *v.value = make(map[uint8]float64) }
+
+func (v *uint8Float64MapValue) appendNext() {}
+
+func (v *uint8Float64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8Float64MapValue) IsCumulative() bool {
 	return true
 }
@@ -10665,6 +12435,7 @@ func (v *uint8Float64MapValue) IsCumulative() bool {
 // -- uint16Float64MapValue
 type uint16Float64MapValue struct {
 	value *map[uint16]float64
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16Float64MapValue)(nil)
@@ -10678,12 +12449,12 @@ func newUint16Float64MapValue(m *map[uint16]float64) *uint16Float64MapValue {
 }
 
 func (v *uint16Float64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -10692,7 +12463,7 @@ func (v *uint16Float64MapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 64)
 	if err != nil {
@@ -10722,6 +12493,14 @@ func (v *uint16Float64MapValue) String() string {
 
 func (v *uint16Float64MapValue) Type() string { return "map[uint16]float64" }
 
+func (v *uint16Float64MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16Float64MapValue) clear() { *v.value = make(map[uint16]float64) }
+
+func (v *uint16Float64MapValue) appendNext() {}
+
+func (v *uint16Float64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16Float64MapValue) IsCumulative() bool {
 	return true
 }
@@ -10729,6 +12508,7 @@ func (v *uint16Float64MapValue) IsCumulative() bool {
 // -- uint32Float64MapValue
 type uint32Float64MapValue struct {
 	value *map[uint32]float64
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32Float64MapValue)(nil)
@@ -10742,12 +12522,12 @@ func newUint32Float64MapValue(m *map[uint32]float64) *uint32Float64MapValue {
 }
 
 func (v *uint32Float64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -10756,7 +12536,7 @@ func (v *uint32Float64MapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 64)
 	if err != nil {
@@ -10786,6 +12566,14 @@ func (v *uint32Float64MapValue) String() string {
 
 func (v *uint32Float64MapValue) Type() string { return "map[uint32]float64" }
 
+func (v *uint32Float64MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32Float64MapValue) clear() { *v.value = make(map[uint32]float64) }
+
+func (v *uint32Float64MapValue) appendNext() {}
+
+func (v *uint32Float64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32Float64MapValue) IsCumulative() bool {
 	return true
 }
@@ -10793,6 +12581,7 @@ func (v *uint32Float64MapValue) IsCumulative() bool {
 // -- uint64Float64MapValue
 type uint64Float64MapValue struct {
 	value *map[uint64]float64
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64Float64MapValue)(nil)
@@ -10806,12 +12595,12 @@ func newUint64Float64MapValue(m *map[uint64]float64) *uint64Float64MapValue {
 }
 
 func (v *uint64Float64MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -10820,7 +12609,7 @@ func (v *uint64Float64MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 64)
 	if err != nil {
@@ -10850,6 +12639,14 @@ func (v *uint64Float64MapValue) String() string {
 
 func (v *uint64Float64MapValue) Type() string { return "map[uint64]float64" }
 
+func (v *uint64Float64MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64Float64MapValue) clear() { *v.value = make(map[uint64]float64) }
+
+func (v *uint64Float64MapValue) appendNext() {}
+
+func (v *uint64Float64MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64Float64MapValue) IsCumulative() bool {
 	return true
 }
@@ -10896,6 +12693,7 @@ func (v *float32Value) Type() string { return "float32" }
 type float32SliceValue struct {
 	value   *[]float32
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*float32SliceValue)(nil)
@@ -10909,7 +12707,10 @@ func newFloat32SliceValue(slice *[]float32) *float32SliceValue {
 }
 
 func (v *float32SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]float32, len(ss))
 	for i, s := range ss {
@@ -10953,9 +12754,21 @@ func (v *float32SliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *float32SliceValue) sliceSeparator() string { return v.sep }
+
+func (v *float32SliceValue) clear() {
+	*v.value = []float32{}
+	v.changed = true
+}
+
+func (v *float32SliceValue) appendNext() { v.changed = true }
+
+func (v *float32SliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringFloat32MapValue
 type stringFloat32MapValue struct {
 	value *map[string]float32
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringFloat32MapValue)(nil)
@@ -10969,16 +12782,16 @@ func newStringFloat32MapValue(m *map[string]float32) *stringFloat32MapValue {
 }
 
 func (v *stringFloat32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 32)
 	if err != nil {
@@ -11008,6 +12821,14 @@ func (v *stringFloat32MapValue) String() string {
 
 func (v *stringFloat32MapValue) Type() string { return "map[string]float32" }
 
+func (v *stringFloat32MapValue) mapSeparator() string { return v.sep }
+
+func (v *stringFloat32MapValue) clear() { *v.value = make(map[string]float32) }
+
+func (v *stringFloat32MapValue) appendNext() {}
+
+func (v *stringFloat32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringFloat32MapValue) IsCumulative() bool {
 	return true
 }
@@ -11015,6 +12836,7 @@ func (v *stringFloat32MapValue) IsCumulative() bool {
 // -- intFloat32MapValue
 type intFloat32MapValue struct {
 	value *map[int]float32
+	sep   string
 }
 
 var _ RepeatableFlag = (*intFloat32MapValue)(nil)
@@ -11028,12 +12850,12 @@ func newIntFloat32MapValue(m *map[int]float32) *intFloat32MapValue {
 }
 
 func (v *intFloat32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -11042,7 +12864,7 @@ func (v *intFloat32MapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 32)
 	if err != nil {
@@ -11072,6 +12894,14 @@ func (v *intFloat32MapValue) String() string {
 
 func (v *intFloat32MapValue) Type() string { return "map[int]float32" }
 
+func (v *intFloat32MapValue) mapSeparator() string { return v.sep }
+
+func (v *intFloat32MapValue) clear() { *v.value = make(map[int]float32) }
+
+func (v *intFloat32MapValue) appendNext() {}
+
+func (v *intFloat32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intFloat32MapValue) IsCumulative() bool {
 	return true
 }
@@ -11079,6 +12909,7 @@ func (v *intFloat32MapValue) IsCumulative() bool {
 // -- int8Float32MapValue
 type int8Float32MapValue struct {
 	value *map[int8]float32
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8Float32MapValue)(nil)
@@ -11092,12 +12923,12 @@ func newInt8Float32MapValue(m *map[int8]float32) *int8Float32MapValue {
 }
 
 func (v *int8Float32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -11106,7 +12937,7 @@ func (v *int8Float32MapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 32)
 	if err != nil {
@@ -11136,6 +12967,14 @@ func (v *int8Float32MapValue) String() string {
 
 func (v *int8Float32MapValue) Type() string { return "map[int8]float32" }
 
+func (v *int8Float32MapValue) mapSeparator() string { return v.sep }
+
+func (v *int8Float32MapValue) clear() { *v.value = make(map[int8]float32) }
+
+func (v *int8Float32MapValue) appendNext() {}
+
+func (v *int8Float32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8Float32MapValue) IsCumulative() bool {
 	return true
 }
@@ -11143,6 +12982,7 @@ func (v *int8Float32MapValue) IsCumulative() bool {
 // -- int16Float32MapValue
 type int16Float32MapValue struct {
 	value *map[int16]float32
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16Float32MapValue)(nil)
@@ -11156,12 +12996,12 @@ func newInt16Float32MapValue(m *map[int16]float32) *int16Float32MapValue {
 }
 
 func (v *int16Float32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -11170,7 +13010,7 @@ func (v *int16Float32MapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 32)
 	if err != nil {
@@ -11200,6 +13040,14 @@ func (v *int16Float32MapValue) String() string {
 
 func (v *int16Float32MapValue) Type() string { return "map[int16]float32" }
 
+func (v *int16Float32MapValue) mapSeparator() string { return v.sep }
+
+func (v *int16Float32MapValue) clear() { *v.value = make(map[int16]float32) }
+
+func (v *int16Float32MapValue) appendNext() {}
+
+func (v *int16Float32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16Float32MapValue) IsCumulative() bool {
 	return true
 }
@@ -11207,6 +13055,7 @@ func (v *int16Float32MapValue) IsCumulative() bool {
 // -- int32Float32MapValue
 type int32Float32MapValue struct {
 	value *map[int32]float32
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32Float32MapValue)(nil)
@@ -11220,12 +13069,12 @@ func newInt32Float32MapValue(m *map[int32]float32) *int32Float32MapValue {
 }
 
 func (v *int32Float32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -11234,7 +13083,7 @@ func (v *int32Float32MapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 32)
 	if err != nil {
@@ -11264,6 +13113,14 @@ func (v *int32Float32MapValue) String() string {
 
 func (v *int32Float32MapValue) Type() string { return "map[int32]float32" }
 
+func (v *int32Float32MapValue) mapSeparator() string { return v.sep }
+
+func (v *int32Float32MapValue) clear() { *v.value = make(map[int32]float32) }
+
+func (v *int32Float32MapValue) appendNext() {}
+
+func (v *int32Float32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32Float32MapValue) IsCumulative() bool {
 	return true
 }
@@ -11271,6 +13128,7 @@ func (v *int32Float32MapValue) IsCumulative() bool {
 // -- int64Float32MapValue
 type int64Float32MapValue struct {
 	value *map[int64]float32
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64Float32MapValue)(nil)
@@ -11284,12 +13142,12 @@ func newInt64Float32MapValue(m *map[int64]float32) *int64Float32MapValue {
 }
 
 func (v *int64Float32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -11298,7 +13156,7 @@ func (v *int64Float32MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 32)
 	if err != nil {
@@ -11328,6 +13186,14 @@ func (v *int64Float32MapValue) String() string {
 
 func (v *int64Float32MapValue) Type() string { return "map[int64]float32" }
 
+func (v *int64Float32MapValue) mapSeparator() string { return v.sep }
+
+func (v *int64Float32MapValue) clear() { *v.value = make(map[int64]float32) }
+
+func (v *int64Float32MapValue) appendNext() {}
+
+func (v *int64Float32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64Float32MapValue) IsCumulative() bool {
 	return true
 }
@@ -11335,6 +13201,7 @@ func (v *int64Float32MapValue) IsCumulative() bool {
 // -- uintFloat32MapValue
 type uintFloat32MapValue struct {
 	value *map[uint]float32
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintFloat32MapValue)(nil)
@@ -11348,12 +13215,12 @@ func newUintFloat32MapValue(m *map[uint]float32) *uintFloat32MapValue {
 }
 
 func (v *uintFloat32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -11362,7 +13229,7 @@ func (v *uintFloat32MapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 32)
 	if err != nil {
@@ -11392,6 +13259,14 @@ func (v *uintFloat32MapValue) String() string {
 
 func (v *uintFloat32MapValue) Type() string { return "map[uint]float32" }
 
+func (v *uintFloat32MapValue) mapSeparator() string { return v.sep }
+
+func (v *uintFloat32MapValue) clear() { *v.value = make(map[uint]float32) }
+
+func (v *uintFloat32MapValue) appendNext() {}
+
+func (v *uintFloat32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintFloat32MapValue) IsCumulative() bool {
 	return true
 }
@@ -11399,6 +13274,7 @@ func (v *uintFloat32MapValue) IsCumulative() bool {
 // -- uint8Float32MapValue
 type uint8Float32MapValue struct {
 	value *map[uint8]float32
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8Float32MapValue)(nil)
@@ -11412,12 +13288,12 @@ func newUint8Float32MapValue(m *map[uint8]float32) *uint8Float32MapValue {
 }
 
 func (v *uint8Float32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -11426,7 +13302,7 @@ func (v *uint8Float32MapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 32)
 	if err != nil {
@@ -11456,6 +13332,14 @@ func (v *uint8Float32MapValue) String() string {
 
 func (v *uint8Float32MapValue) Type() string { return "map[uint8]float32" }
 
+func (v *uint8Float32MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8Float32MapValue) clear() { *v.value = make(map[uint8]float32) }
+
+func (v *uint8Float32MapValue) appendNext() {}
+
+func (v *uint8Float32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8Float32MapValue) IsCumulative() bool {
 	return true
 }
@@ -11463,6 +13347,7 @@ func (v *uint8Float32MapValue) IsCumulative() bool {
 // -- uint16Float32MapValue
 type uint16Float32MapValue struct {
 	value *map[uint16]float32
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16Float32MapValue)(nil)
@@ -11476,12 +13361,12 @@ func newUint16Float32MapValue(m *map[uint16]float32) *uint16Float32MapValue {
 }
 
 func (v *uint16Float32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -11490,7 +13375,7 @@ func (v *uint16Float32MapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 32)
 	if err != nil {
@@ -11520,6 +13405,14 @@ func (v *uint16Float32MapValue) String() string {
 
 func (v *uint16Float32MapValue) Type() string { return "map[uint16]float32" }
 
+func (v *uint16Float32MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16Float32MapValue) clear() { *v.value = make(map[uint16]float32) }
+
+func (v *uint16Float32MapValue) appendNext() {}
+
+func (v *uint16Float32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16Float32MapValue) IsCumulative() bool {
 	return true
 }
@@ -11527,6 +13420,7 @@ func (v *uint16Float32MapValue) IsCumulative() bool {
 // -- uint32Float32MapValue
 type uint32Float32MapValue struct {
 	value *map[uint32]float32
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32Float32MapValue)(nil)
@@ -11540,12 +13434,12 @@ func newUint32Float32MapValue(m *map[uint32]float32) *uint32Float32MapValue {
 }
 
 func (v *uint32Float32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -11554,7 +13448,7 @@ func (v *uint32Float32MapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 32)
 	if err != nil {
@@ -11584,6 +13478,14 @@ func (v *uint32Float32MapValue) String() string {
 
 func (v *uint32Float32MapValue) Type() string { return "map[uint32]float32" }
 
+func (v *uint32Float32MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32Float32MapValue) clear() { *v.value = make(map[uint32]float32) }
+
+func (v *uint32Float32MapValue) appendNext() {}
+
+func (v *uint32Float32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32Float32MapValue) IsCumulative() bool {
 	return true
 }
@@ -11591,6 +13493,7 @@ func (v *uint32Float32MapValue) IsCumulative() bool {
 // -- uint64Float32MapValue
 type uint64Float32MapValue struct {
 	value *map[uint64]float32
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64Float32MapValue)(nil)
@@ -11604,12 +13507,12 @@ func newUint64Float32MapValue(m *map[uint64]float32) *uint64Float32MapValue {
 }
 
 func (v *uint64Float32MapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -11618,7 +13521,7 @@ func (v *uint64Float32MapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := strconv.ParseFloat(s, 32)
 	if err != nil {
@@ -11648,6 +13551,14 @@ func (v *uint64Float32MapValue) String() string {
 
 func (v *uint64Float32MapValue) Type() string { return "map[uint64]float32" }
 
+func (v *uint64Float32MapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64Float32MapValue) clear() { *v.value = make(map[uint64]float32) }
+
+func (v *uint64Float32MapValue) appendNext() {}
+
+func (v *uint64Float32MapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64Float32MapValue) IsCumulative() bool {
 	return true
 }
@@ -11694,6 +13605,7 @@ func (v *durationValue) Type() string { return "duration" }
 type durationSliceValue struct {
 	value   *[]time.Duration
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*durationSliceValue)(nil)
@@ -11707,7 +13619,10 @@ func newDurationSliceValue(slice *[]time.Duration) *durationSliceValue {
 }
 
 func (v *durationSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]time.Duration, len(ss))
 	for i, s := range ss {
@@ -11751,9 +13666,21 @@ func (v *durationSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *durationSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *durationSliceValue) clear() {
+	*v.value = []time.Duration{}
+	v.changed = true
+}
+
+func (v *durationSliceValue) appendNext() { v.changed = true }
+
+func (v *durationSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringDurationMapValue
 type stringDurationMapValue struct {
 	value *map[string]time.Duration
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringDurationMapValue)(nil)
@@ -11767,16 +13694,16 @@ func newStringDurationMapValue(m *map[string]time.Duration) *stringDurationMapVa
 }
 
 func (v *stringDurationMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := time.ParseDuration(s)
 	if err != nil {
@@ -11806,6 +13733,14 @@ func (v *stringDurationMapValue) String() string {
 
 func (v *stringDurationMapValue) Type() string { return "map[string]time.Duration" }
 
+func (v *stringDurationMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringDurationMapValue) clear() { *v.value = make(map[string]time.Duration) }
+
+func (v *stringDurationMapValue) appendNext() {}
+
+func (v *stringDurationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringDurationMapValue) IsCumulative() bool {
 	return true
 }
@@ -11813,6 +13748,7 @@ func (v *stringDurationMapValue) IsCumulative() bool {
 // -- intDurationMapValue
 type intDurationMapValue struct {
 	value *map[int]time.Duration
+	sep   string
 }
 
 var _ RepeatableFlag = (*intDurationMapValue)(nil)
@@ -11826,12 +13762,12 @@ func newIntDurationMapValue(m *map[int]time.Duration) *intDurationMapValue {
 }
 
 func (v *intDurationMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -11840,7 +13776,7 @@ func (v *intDurationMapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := time.ParseDuration(s)
 	if err != nil {
@@ -11870,6 +13806,14 @@ func (v *intDurationMapValue) String() string {
 
 func (v *intDurationMapValue) Type() string { return "map[int]time.Duration" }
 
+func (v *intDurationMapValue) mapSeparator() string { return v.sep }
+
+func (v *intDurationMapValue) clear() { *v.value = make(map[int]time.Duration) }
+
+func (v *intDurationMapValue) appendNext() {}
+
+func (v *intDurationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intDurationMapValue) IsCumulative() bool {
 	return true
 }
@@ -11877,6 +13821,7 @@ func (v *intDurationMapValue) IsCumulative() bool {
 // -- int8DurationMapValue
 type int8DurationMapValue struct {
 	value *map[int8]time.Duration
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8DurationMapValue)(nil)
@@ -11890,12 +13835,12 @@ func newInt8DurationMapValue(m *map[int8]time.Duration) *int8DurationMapValue {
 }
 
 func (v *int8DurationMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -11904,7 +13849,7 @@ func (v *int8DurationMapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := time.ParseDuration(s)
 	if err != nil {
@@ -11934,6 +13879,14 @@ func (v *int8DurationMapValue) String() string {
 
 func (v *int8DurationMapValue) Type() string { return "map[int8]time.Duration" }
 
+func (v *int8DurationMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8DurationMapValue) clear() { *v.value = make(map[int8]time.Duration) }
+
+func (v *int8DurationMapValue) appendNext() {}
+
+func (v *int8DurationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8DurationMapValue) IsCumulative() bool {
 	return true
 }
@@ -11941,6 +13894,7 @@ func (v *int8DurationMapValue) IsCumulative() bool {
 // -- int16DurationMapValue
 type int16DurationMapValue struct {
 	value *map[int16]time.Duration
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16DurationMapValue)(nil)
@@ -11954,12 +13908,12 @@ func newInt16DurationMapValue(m *map[int16]time.Duration) *int16DurationMapValue
 }
 
 func (v *int16DurationMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -11968,7 +13922,7 @@ func (v *int16DurationMapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := time.ParseDuration(s)
 	if err != nil {
@@ -11998,6 +13952,14 @@ func (v *int16DurationMapValue) String() string {
 
 func (v *int16DurationMapValue) Type() string { return "map[int16]time.Duration" }
 
+func (v *int16DurationMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16DurationMapValue) clear() { *v.value = make(map[int16]time.Duration) }
+
+func (v *int16DurationMapValue) appendNext() {}
+
+func (v *int16DurationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16DurationMapValue) IsCumulative() bool {
 	return true
 }
@@ -12005,6 +13967,7 @@ func (v *int16DurationMapValue) IsCumulative() bool {
 // -- int32DurationMapValue
 type int32DurationMapValue struct {
 	value *map[int32]time.Duration
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32DurationMapValue)(nil)
@@ -12018,12 +13981,12 @@ func newInt32DurationMapValue(m *map[int32]time.Duration) *int32DurationMapValue
 }
 
 func (v *int32DurationMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -12032,7 +13995,7 @@ func (v *int32DurationMapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := time.ParseDuration(s)
 	if err != nil {
@@ -12062,6 +14025,14 @@ func (v *int32DurationMapValue) String() string {
 
 func (v *int32DurationMapValue) Type() string { return "map[int32]time.Duration" }
 
+func (v *int32DurationMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32DurationMapValue) clear() { *v.value = make(map[int32]time.Duration) }
+
+func (v *int32DurationMapValue) appendNext() {}
+
+func (v *int32DurationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32DurationMapValue) IsCumulative() bool {
 	return true
 }
@@ -12069,6 +14040,7 @@ func (v *int32DurationMapValue) IsCumulative() bool {
 // -- int64DurationMapValue
 type int64DurationMapValue struct {
 	value *map[int64]time.Duration
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64DurationMapValue)(nil)
@@ -12082,12 +14054,12 @@ func newInt64DurationMapValue(m *map[int64]time.Duration) *int64DurationMapValue
 }
 
 func (v *int64DurationMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -12096,7 +14068,7 @@ func (v *int64DurationMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := time.ParseDuration(s)
 	if err != nil {
@@ -12126,6 +14098,14 @@ func (v *int64DurationMapValue) String() string {
 
 func (v *int64DurationMapValue) Type() string { return "map[int64]time.Duration" }
 
+func (v *int64DurationMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64DurationMapValue) clear() { *v.value = make(map[int64]time.Duration) }
+
+func (v *int64DurationMapValue) appendNext() {}
+
+func (v *int64DurationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64DurationMapValue) IsCumulative() bool {
 	return true
 }
@@ -12133,6 +14113,7 @@ func (v *int64DurationMapValue) IsCumulative() bool {
 // -- uintDurationMapValue
 type uintDurationMapValue struct {
 	value *map[uint]time.Duration
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintDurationMapValue)(nil)
@@ -12146,12 +14127,12 @@ func newUintDurationMapValue(m *map[uint]time.Duration) *uintDurationMapValue {
 }
 
 func (v *uintDurationMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -12160,7 +14141,7 @@ func (v *uintDurationMapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := time.ParseDuration(s)
 	if err != nil {
@@ -12190,6 +14171,14 @@ func (v *uintDurationMapValue) String() string {
 
 func (v *uintDurationMapValue) Type() string { return "map[uint]time.Duration" }
 
+func (v *uintDurationMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintDurationMapValue) clear() { *v.value = make(map[uint]time.Duration) }
+
+func (v *uintDurationMapValue) appendNext() {}
+
+func (v *uintDurationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintDurationMapValue) IsCumulative() bool {
 	return true
 }
@@ -12197,6 +14186,7 @@ func (v *uintDurationMapValue) IsCumulative() bool {
 // -- uint8DurationMapValue
 type uint8DurationMapValue struct {
 	value *map[uint8]time.Duration
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8DurationMapValue)(nil)
@@ -12210,12 +14200,12 @@ func newUint8DurationMapValue(m *map[uint8]time.Duration) *uint8DurationMapValue
 }
 
 func (v *uint8DurationMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -12224,7 +14214,7 @@ func (v *uint8DurationMapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := time.ParseDuration(s)
 	if err != nil {
@@ -12254,6 +14244,14 @@ func (v *uint8DurationMapValue) String() string {
 
 func (v *uint8DurationMapValue) Type() string { return "map[uint8]time.Duration" }
 
+func (v *uint8DurationMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8DurationMapValue) clear() { *v.value = make(map[uint8]time.Duration) }
+
+func (v *uint8DurationMapValue) appendNext() {}
+
+func (v *uint8DurationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8DurationMapValue) IsCumulative() bool {
 	return true
 }
@@ -12261,6 +14259,7 @@ func (v *uint8DurationMapValue) IsCumulative() bool {
 // -- uint16DurationMapValue
 type uint16DurationMapValue struct {
 	value *map[uint16]time.Duration
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16DurationMapValue)(nil)
@@ -12274,12 +14273,12 @@ func newUint16DurationMapValue(m *map[uint16]time.Duration) *uint16DurationMapVa
 }
 
 func (v *uint16DurationMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -12288,7 +14287,7 @@ func (v *uint16DurationMapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := time.ParseDuration(s)
 	if err != nil {
@@ -12318,6 +14317,14 @@ func (v *uint16DurationMapValue) String() string {
 
 func (v *uint16DurationMapValue) Type() string { return "map[uint16]time.Duration" }
 
+func (v *uint16DurationMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16DurationMapValue) clear() { *v.value = make(map[uint16]time.Duration) }
+
+func (v *uint16DurationMapValue) appendNext() {}
+
+func (v *uint16DurationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16DurationMapValue) IsCumulative() bool {
 	return true
 }
@@ -12325,6 +14332,7 @@ func (v *uint16DurationMapValue) IsCumulative() bool {
 // -- uint32DurationMapValue
 type uint32DurationMapValue struct {
 	value *map[uint32]time.Duration
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32DurationMapValue)(nil)
@@ -12338,12 +14346,12 @@ func newUint32DurationMapValue(m *map[uint32]time.Duration) *uint32DurationMapVa
 }
 
 func (v *uint32DurationMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -12352,7 +14360,7 @@ func (v *uint32DurationMapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := time.ParseDuration(s)
 	if err != nil {
@@ -12382,6 +14390,14 @@ func (v *uint32DurationMapValue) String() string {
 
 func (v *uint32DurationMapValue) Type() string { return "map[uint32]time.Duration" }
 
+func (v *uint32DurationMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32DurationMapValue) clear() { *v.value = make(map[uint32]time.Duration) }
+
+func (v *uint32DurationMapValue) appendNext() {}
+
+func (v *uint32DurationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32DurationMapValue) IsCumulative() bool {
 	return true
 }
@@ -12389,6 +14405,7 @@ func (v *uint32DurationMapValue) IsCumulative() bool {
 // -- uint64DurationMapValue
 type uint64DurationMapValue struct {
 	value *map[uint64]time.Duration
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64DurationMapValue)(nil)
@@ -12402,12 +14419,12 @@ func newUint64DurationMapValue(m *map[uint64]time.Duration) *uint64DurationMapVa
 }
 
 func (v *uint64DurationMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -12416,7 +14433,7 @@ func (v *uint64DurationMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := time.ParseDuration(s)
 	if err != nil {
@@ -12446,6 +14463,14 @@ func (v *uint64DurationMapValue) String() string {
 
 func (v *uint64DurationMapValue) Type() string { return "map[uint64]time.Duration" }
 
+func (v *uint64DurationMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64DurationMapValue) clear() { *v.value = make(map[uint64]time.Duration) }
+
+func (v *uint64DurationMapValue) appendNext() {}
+
+func (v *uint64DurationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64DurationMapValue) IsCumulative() bool {
 	return true
 }
@@ -12492,6 +14517,7 @@ func (v *ipValue) Type() string { return "ip" }
 type ipSliceValue struct {
 	value   *[]net.IP
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*ipSliceValue)(nil)
@@ -12505,7 +14531,10 @@ func newIPSliceValue(slice *[]net.IP) *ipSliceValue {
 }
 
 func (v *ipSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]net.IP, len(ss))
 	for i, s := range ss {
@@ -12549,9 +14578,21 @@ func (v *ipSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *ipSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *ipSliceValue) clear() {
+	*v.value = []net.IP{}
+	v.changed = true
+}
+
+func (v *ipSliceValue) appendNext() { v.changed = true }
+
+func (v *ipSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringIPMapValue
 type stringIPMapValue struct {
 	value *map[string]net.IP
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringIPMapValue)(nil)
@@ -12565,16 +14606,16 @@ func newStringIPMapValue(m *map[string]net.IP) *stringIPMapValue {
 }
 
 func (v *stringIPMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIP(s)
 	if err != nil {
@@ -12604,6 +14645,14 @@ func (v *stringIPMapValue) String() string {
 
 func (v *stringIPMapValue) Type() string { return "map[string]net.IP" }
 
+func (v *stringIPMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringIPMapValue) clear() { *v.value = make(map[string]net.IP) }
+
+func (v *stringIPMapValue) appendNext() {}
+
+func (v *stringIPMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringIPMapValue) IsCumulative() bool {
 	return true
 }
@@ -12611,6 +14660,7 @@ func (v *stringIPMapValue) IsCumulative() bool {
 // -- intIPMapValue
 type intIPMapValue struct {
 	value *map[int]net.IP
+	sep   string
 }
 
 var _ RepeatableFlag = (*intIPMapValue)(nil)
@@ -12624,12 +14674,12 @@ func newIntIPMapValue(m *map[int]net.IP) *intIPMapValue {
 }
 
 func (v *intIPMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -12638,7 +14688,7 @@ func (v *intIPMapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIP(s)
 	if err != nil {
@@ -12668,6 +14718,14 @@ func (v *intIPMapValue) String() string {
 
 func (v *intIPMapValue) Type() string { return "map[int]net.IP" }
 
+func (v *intIPMapValue) mapSeparator() string { return v.sep }
+
+func (v *intIPMapValue) clear() { *v.value = make(map[int]net.IP) }
+
+func (v *intIPMapValue) appendNext() {}
+
+func (v *intIPMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intIPMapValue) IsCumulative() bool {
 	return true
 }
@@ -12675,6 +14733,7 @@ func (v *intIPMapValue) IsCumulative() bool {
 // -- int8IPMapValue
 type int8IPMapValue struct {
 	value *map[int8]net.IP
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8IPMapValue)(nil)
@@ -12688,12 +14747,12 @@ func newInt8IPMapValue(m *map[int8]net.IP) *int8IPMapValue {
 }
 
 func (v *int8IPMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -12702,7 +14761,7 @@ func (v *int8IPMapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIP(s)
 	if err != nil {
@@ -12732,6 +14791,14 @@ func (v *int8IPMapValue) String() string {
 
 func (v *int8IPMapValue) Type() string { return "map[int8]net.IP" }
 
+func (v *int8IPMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8IPMapValue) clear() { *v.value = make(map[int8]net.IP) }
+
+func (v *int8IPMapValue) appendNext() {}
+
+func (v *int8IPMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8IPMapValue) IsCumulative() bool {
 	return true
 }
@@ -12739,6 +14806,7 @@ func (v *int8IPMapValue) IsCumulative() bool {
 // -- int16IPMapValue
 type int16IPMapValue struct {
 	value *map[int16]net.IP
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16IPMapValue)(nil)
@@ -12752,12 +14820,12 @@ func newInt16IPMapValue(m *map[int16]net.IP) *int16IPMapValue {
 }
 
 func (v *int16IPMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -12766,7 +14834,7 @@ func (v *int16IPMapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIP(s)
 	if err != nil {
@@ -12796,6 +14864,14 @@ func (v *int16IPMapValue) String() string {
 
 func (v *int16IPMapValue) Type() string { return "map[int16]net.IP" }
 
+func (v *int16IPMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16IPMapValue) clear() { *v.value = make(map[int16]net.IP) }
+
+func (v *int16IPMapValue) appendNext() {}
+
+func (v *int16IPMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16IPMapValue) IsCumulative() bool {
 	return true
 }
@@ -12803,6 +14879,7 @@ func (v *int16IPMapValue) IsCumulative() bool {
 // -- int32IPMapValue
 type int32IPMapValue struct {
 	value *map[int32]net.IP
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32IPMapValue)(nil)
@@ -12816,12 +14893,12 @@ func newInt32IPMapValue(m *map[int32]net.IP) *int32IPMapValue {
 }
 
 func (v *int32IPMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -12830,7 +14907,7 @@ func (v *int32IPMapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIP(s)
 	if err != nil {
@@ -12860,6 +14937,14 @@ func (v *int32IPMapValue) String() string {
 
 func (v *int32IPMapValue) Type() string { return "map[int32]net.IP" }
 
+func (v *int32IPMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32IPMapValue) clear() { *v.value = make(map[int32]net.IP) }
+
+func (v *int32IPMapValue) appendNext() {}
+
+func (v *int32IPMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32IPMapValue) IsCumulative() bool {
 	return true
 }
@@ -12867,6 +14952,7 @@ func (v *int32IPMapValue) IsCumulative() bool {
 // -- int64IPMapValue
 type int64IPMapValue struct {
 	value *map[int64]net.IP
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64IPMapValue)(nil)
@@ -12880,12 +14966,12 @@ func newInt64IPMapValue(m *map[int64]net.IP) *int64IPMapValue {
 }
 
 func (v *int64IPMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -12894,7 +14980,7 @@ func (v *int64IPMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIP(s)
 	if err != nil {
@@ -12924,6 +15010,14 @@ func (v *int64IPMapValue) String() string {
 
 func (v *int64IPMapValue) Type() string { return "map[int64]net.IP" }
 
+func (v *int64IPMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64IPMapValue) clear() { *v.value = make(map[int64]net.IP) }
+
+func (v *int64IPMapValue) appendNext() {}
+
+func (v *int64IPMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64IPMapValue) IsCumulative() bool {
 	return true
 }
@@ -12931,6 +15025,7 @@ func (v *int64IPMapValue) IsCumulative() bool {
 // -- uintIPMapValue
 type uintIPMapValue struct {
 	value *map[uint]net.IP
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintIPMapValue)(nil)
@@ -12944,12 +15039,12 @@ func newUintIPMapValue(m *map[uint]net.IP) *uintIPMapValue {
 }
 
 func (v *uintIPMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -12958,7 +15053,7 @@ func (v *uintIPMapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIP(s)
 	if err != nil {
@@ -12988,6 +15083,14 @@ func (v *uintIPMapValue) String() string {
 
 func (v *uintIPMapValue) Type() string { return "map[uint]net.IP" }
 
+func (v *uintIPMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintIPMapValue) clear() { *v.value = make(map[uint]net.IP) }
+
+func (v *uintIPMapValue) appendNext() {}
+
+func (v *uintIPMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintIPMapValue) IsCumulative() bool {
 	return true
 }
@@ -12995,6 +15098,7 @@ func (v *uintIPMapValue) IsCumulative() bool {
 // -- uint8IPMapValue
 type uint8IPMapValue struct {
 	value *map[uint8]net.IP
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8IPMapValue)(nil)
@@ -13008,12 +15112,12 @@ func newUint8IPMapValue(m *map[uint8]net.IP) *uint8IPMapValue {
 }
 
 func (v *uint8IPMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -13022,7 +15126,7 @@ func (v *uint8IPMapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIP(s)
 	if err != nil {
@@ -13052,6 +15156,14 @@ func (v *uint8IPMapValue) String() string {
 
 func (v *uint8IPMapValue) Type() string { return "map[uint8]net.IP" }
 
+func (v *uint8IPMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8IPMapValue) clear() { *v.value = make(map[uint8]net.IP) }
+
+func (v *uint8IPMapValue) appendNext() {}
+
+func (v *uint8IPMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8IPMapValue) IsCumulative() bool {
 	return true
 }
@@ -13059,6 +15171,7 @@ func (v *uint8IPMapValue) IsCumulative() bool {
 // -- uint16IPMapValue
 type uint16IPMapValue struct {
 	value *map[uint16]net.IP
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16IPMapValue)(nil)
@@ -13072,12 +15185,12 @@ func newUint16IPMapValue(m *map[uint16]net.IP) *uint16IPMapValue {
 }
 
 func (v *uint16IPMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -13086,7 +15199,7 @@ func (v *uint16IPMapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIP(s)
 	if err != nil {
@@ -13116,6 +15229,14 @@ func (v *uint16IPMapValue) String() string {
 
 func (v *uint16IPMapValue) Type() string { return "map[uint16]net.IP" }
 
+func (v *uint16IPMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16IPMapValue) clear() { *v.value = make(map[uint16]net.IP) }
+
+func (v *uint16IPMapValue) appendNext() {}
+
+func (v *uint16IPMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16IPMapValue) IsCumulative() bool {
 	return true
 }
@@ -13123,6 +15244,7 @@ func (v *uint16IPMapValue) IsCumulative() bool {
 // -- uint32IPMapValue
 type uint32IPMapValue struct {
 	value *map[uint32]net.IP
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32IPMapValue)(nil)
@@ -13136,12 +15258,12 @@ func newUint32IPMapValue(m *map[uint32]net.IP) *uint32IPMapValue {
 }
 
 func (v *uint32IPMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -13150,7 +15272,7 @@ func (v *uint32IPMapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIP(s)
 	if err != nil {
@@ -13180,6 +15302,14 @@ func (v *uint32IPMapValue) String() string {
 
 func (v *uint32IPMapValue) Type() string { return "map[uint32]net.IP" }
 
+func (v *uint32IPMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32IPMapValue) clear() { *v.value = make(map[uint32]net.IP) }
+
+func (v *uint32IPMapValue) appendNext() {}
+
+func (v *uint32IPMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32IPMapValue) IsCumulative() bool {
 	return true
 }
@@ -13187,6 +15317,7 @@ func (v *uint32IPMapValue) IsCumulative() bool {
 // -- uint64IPMapValue
 type uint64IPMapValue struct {
 	value *map[uint64]net.IP
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64IPMapValue)(nil)
@@ -13200,12 +15331,12 @@ func newUint64IPMapValue(m *map[uint64]net.IP) *uint64IPMapValue {
 }
 
 func (v *uint64IPMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -13214,7 +15345,7 @@ func (v *uint64IPMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIP(s)
 	if err != nil {
@@ -13244,6 +15375,14 @@ func (v *uint64IPMapValue) String() string {
 
 func (v *uint64IPMapValue) Type() string { return "map[uint64]net.IP" }
 
+func (v *uint64IPMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64IPMapValue) clear() { *v.value = make(map[uint64]net.IP) }
+
+func (v *uint64IPMapValue) appendNext() {}
+
+func (v *uint64IPMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64IPMapValue) IsCumulative() bool {
 	return true
 }
@@ -13290,6 +15429,7 @@ func (v *hexBytesValue) Type() string { return "hexBytes" }
 type hexBytesSliceValue struct {
 	value   *[]HexBytes
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*hexBytesSliceValue)(nil)
@@ -13303,7 +15443,10 @@ func newHexBytesSliceValue(slice *[]HexBytes) *hexBytesSliceValue {
 }
 
 func (v *hexBytesSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]HexBytes, len(ss))
 	for i, s := range ss {
@@ -13347,9 +15490,21 @@ func (v *hexBytesSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *hexBytesSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *hexBytesSliceValue) clear() {
+	*v.value = []HexBytes{}
+	v.changed = true
+}
+
+func (v *hexBytesSliceValue) appendNext() { v.changed = true }
+
+func (v *hexBytesSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringHexBytesMapValue
 type stringHexBytesMapValue struct {
 	value *map[string]HexBytes
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringHexBytesMapValue)(nil)
@@ -13363,16 +15518,16 @@ func newStringHexBytesMapValue(m *map[string]HexBytes) *stringHexBytesMapValue {
 }
 
 func (v *stringHexBytesMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := hex.DecodeString(s)
 	if err != nil {
@@ -13402,6 +15557,14 @@ func (v *stringHexBytesMapValue) String() string {
 
 func (v *stringHexBytesMapValue) Type() string { return "map[string]HexBytes" }
 
+func (v *stringHexBytesMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringHexBytesMapValue) clear() { *v.value = make(map[string]HexBytes) }
+
+func (v *stringHexBytesMapValue) appendNext() {}
+
+func (v *stringHexBytesMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringHexBytesMapValue) IsCumulative() bool {
 	return true
 }
@@ -13409,6 +15572,7 @@ func (v *stringHexBytesMapValue) IsCumulative() bool {
 // -- intHexBytesMapValue
 type intHexBytesMapValue struct {
 	value *map[int]HexBytes
+	sep   string
 }
 
 var _ RepeatableFlag = (*intHexBytesMapValue)(nil)
@@ -13422,12 +15586,12 @@ func newIntHexBytesMapValue(m *map[int]HexBytes) *intHexBytesMapValue {
 }
 
 func (v *intHexBytesMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -13436,7 +15600,7 @@ func (v *intHexBytesMapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := hex.DecodeString(s)
 	if err != nil {
@@ -13466,6 +15630,14 @@ func (v *intHexBytesMapValue) String() string {
 
 func (v *intHexBytesMapValue) Type() string { return "map[int]HexBytes" }
 
+func (v *intHexBytesMapValue) mapSeparator() string { return v.sep }
+
+func (v *intHexBytesMapValue) clear() { *v.value = make(map[int]HexBytes) }
+
+func (v *intHexBytesMapValue) appendNext() {}
+
+func (v *intHexBytesMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intHexBytesMapValue) IsCumulative() bool {
 	return true
 }
@@ -13473,6 +15645,7 @@ func (v *intHexBytesMapValue) IsCumulative() bool {
 // -- int8HexBytesMapValue
 type int8HexBytesMapValue struct {
 	value *map[int8]HexBytes
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8HexBytesMapValue)(nil)
@@ -13486,12 +15659,12 @@ func newInt8HexBytesMapValue(m *map[int8]HexBytes) *int8HexBytesMapValue {
 }
 
 func (v *int8HexBytesMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -13500,7 +15673,7 @@ func (v *int8HexBytesMapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := hex.DecodeString(s)
 	if err != nil {
@@ -13530,6 +15703,14 @@ func (v *int8HexBytesMapValue) String() string {
 
 func (v *int8HexBytesMapValue) Type() string { return "map[int8]HexBytes" }
 
+func (v *int8HexBytesMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8HexBytesMapValue) clear() { *v.value = make(map[int8]HexBytes) }
+
+func (v *int8HexBytesMapValue) appendNext() {}
+
+func (v *int8HexBytesMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8HexBytesMapValue) IsCumulative() bool {
 	return true
 }
@@ -13537,6 +15718,7 @@ func (v *int8HexBytesMapValue) IsCumulative() bool {
 // -- int16HexBytesMapValue
 type int16HexBytesMapValue struct {
 	value *map[int16]HexBytes
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16HexBytesMapValue)(nil)
@@ -13550,12 +15732,12 @@ func newInt16HexBytesMapValue(m *map[int16]HexBytes) *int16HexBytesMapValue {
 }
 
 func (v *int16HexBytesMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -13564,7 +15746,7 @@ func (v *int16HexBytesMapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := hex.DecodeString(s)
 	if err != nil {
@@ -13594,6 +15776,14 @@ func (v *int16HexBytesMapValue) String() string {
 
 func (v *int16HexBytesMapValue) Type() string { return "map[int16]HexBytes" }
 
+func (v *int16HexBytesMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16HexBytesMapValue) clear() { *v.value = make(map[int16]HexBytes) }
+
+func (v *int16HexBytesMapValue) appendNext() {}
+
+func (v *int16HexBytesMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16HexBytesMapValue) IsCumulative() bool {
 	return true
 }
@@ -13601,6 +15791,7 @@ func (v *int16HexBytesMapValue) IsCumulative() bool {
 // -- int32HexBytesMapValue
 type int32HexBytesMapValue struct {
 	value *map[int32]HexBytes
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32HexBytesMapValue)(nil)
@@ -13614,12 +15805,12 @@ func newInt32HexBytesMapValue(m *map[int32]HexBytes) *int32HexBytesMapValue {
 }
 
 func (v *int32HexBytesMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -13628,7 +15819,7 @@ func (v *int32HexBytesMapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := hex.DecodeString(s)
 	if err != nil {
@@ -13658,6 +15849,14 @@ func (v *int32HexBytesMapValue) String() string {
 
 func (v *int32HexBytesMapValue) Type() string { return "map[int32]HexBytes" }
 
+func (v *int32HexBytesMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32HexBytesMapValue) clear() { *v.value = make(map[int32]HexBytes) }
+
+func (v *int32HexBytesMapValue) appendNext() {}
+
+func (v *int32HexBytesMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32HexBytesMapValue) IsCumulative() bool {
 	return true
 }
@@ -13665,6 +15864,7 @@ func (v *int32HexBytesMapValue) IsCumulative() bool {
 // -- int64HexBytesMapValue
 type int64HexBytesMapValue struct {
 	value *map[int64]HexBytes
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64HexBytesMapValue)(nil)
@@ -13678,12 +15878,12 @@ func newInt64HexBytesMapValue(m *map[int64]HexBytes) *int64HexBytesMapValue {
 }
 
 func (v *int64HexBytesMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -13692,7 +15892,7 @@ func (v *int64HexBytesMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := hex.DecodeString(s)
 	if err != nil {
@@ -13722,6 +15922,14 @@ func (v *int64HexBytesMapValue) String() string {
 
 func (v *int64HexBytesMapValue) Type() string { return "map[int64]HexBytes" }
 
+func (v *int64HexBytesMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64HexBytesMapValue) clear() { *v.value = make(map[int64]HexBytes) }
+
+func (v *int64HexBytesMapValue) appendNext() {}
+
+func (v *int64HexBytesMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64HexBytesMapValue) IsCumulative() bool {
 	return true
 }
@@ -13729,6 +15937,7 @@ func (v *int64HexBytesMapValue) IsCumulative() bool {
 // -- uintHexBytesMapValue
 type uintHexBytesMapValue struct {
 	value *map[uint]HexBytes
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintHexBytesMapValue)(nil)
@@ -13742,12 +15951,12 @@ func newUintHexBytesMapValue(m *map[uint]HexBytes) *uintHexBytesMapValue {
 }
 
 func (v *uintHexBytesMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -13756,7 +15965,7 @@ func (v *uintHexBytesMapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := hex.DecodeString(s)
 	if err != nil {
@@ -13786,6 +15995,14 @@ func (v *uintHexBytesMapValue) String() string {
 
 func (v *uintHexBytesMapValue) Type() string { return "map[uint]HexBytes" }
 
+func (v *uintHexBytesMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintHexBytesMapValue) clear() { *v.value = make(map[uint]HexBytes) }
+
+func (v *uintHexBytesMapValue) appendNext() {}
+
+func (v *uintHexBytesMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintHexBytesMapValue) IsCumulative() bool {
 	return true
 }
@@ -13793,6 +16010,7 @@ func (v *uintHexBytesMapValue) IsCumulative() bool {
 // -- uint8HexBytesMapValue
 type uint8HexBytesMapValue struct {
 	value *map[uint8]HexBytes
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8HexBytesMapValue)(nil)
@@ -13806,12 +16024,12 @@ func newUint8HexBytesMapValue(m *map[uint8]HexBytes) *uint8HexBytesMapValue {
 }
 
 func (v *uint8HexBytesMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -13820,7 +16038,7 @@ func (v *uint8HexBytesMapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := hex.DecodeString(s)
 	if err != nil {
@@ -13850,6 +16068,14 @@ func (v *uint8HexBytesMapValue) String() string {
 
 func (v *uint8HexBytesMapValue) Type() string { return "map[uint8]HexBytes" }
 
+func (v *uint8HexBytesMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8HexBytesMapValue) clear() { *v.value = make(map[uint8]HexBytes) }
+
+func (v *uint8HexBytesMapValue) appendNext() {}
+
+func (v *uint8HexBytesMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8HexBytesMapValue) IsCumulative() bool {
 	return true
 }
@@ -13857,6 +16083,7 @@ func (v *uint8HexBytesMapValue) IsCumulative() bool {
 // -- uint16HexBytesMapValue
 type uint16HexBytesMapValue struct {
 	value *map[uint16]HexBytes
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16HexBytesMapValue)(nil)
@@ -13870,12 +16097,12 @@ func newUint16HexBytesMapValue(m *map[uint16]HexBytes) *uint16HexBytesMapValue {
 }
 
 func (v *uint16HexBytesMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -13884,7 +16111,7 @@ func (v *uint16HexBytesMapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := hex.DecodeString(s)
 	if err != nil {
@@ -13914,6 +16141,14 @@ func (v *uint16HexBytesMapValue) String() string {
 
 func (v *uint16HexBytesMapValue) Type() string { return "map[uint16]HexBytes" }
 
+func (v *uint16HexBytesMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16HexBytesMapValue) clear() { *v.value = make(map[uint16]HexBytes) }
+
+func (v *uint16HexBytesMapValue) appendNext() {}
+
+func (v *uint16HexBytesMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16HexBytesMapValue) IsCumulative() bool {
 	return true
 }
@@ -13921,6 +16156,7 @@ func (v *uint16HexBytesMapValue) IsCumulative() bool {
 // -- uint32HexBytesMapValue
 type uint32HexBytesMapValue struct {
 	value *map[uint32]HexBytes
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32HexBytesMapValue)(nil)
@@ -13934,12 +16170,12 @@ func newUint32HexBytesMapValue(m *map[uint32]HexBytes) *uint32HexBytesMapValue {
 }
 
 func (v *uint32HexBytesMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -13948,7 +16184,7 @@ func (v *uint32HexBytesMapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := hex.DecodeString(s)
 	if err != nil {
@@ -13978,6 +16214,14 @@ func (v *uint32HexBytesMapValue) String() string {
 
 func (v *uint32HexBytesMapValue) Type() string { return "map[uint32]HexBytes" }
 
+func (v *uint32HexBytesMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32HexBytesMapValue) clear() { *v.value = make(map[uint32]HexBytes) }
+
+func (v *uint32HexBytesMapValue) appendNext() {}
+
+func (v *uint32HexBytesMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32HexBytesMapValue) IsCumulative() bool {
 	return true
 }
@@ -13985,6 +16229,7 @@ func (v *uint32HexBytesMapValue) IsCumulative() bool {
 // -- uint64HexBytesMapValue
 type uint64HexBytesMapValue struct {
 	value *map[uint64]HexBytes
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64HexBytesMapValue)(nil)
@@ -13998,12 +16243,12 @@ func newUint64HexBytesMapValue(m *map[uint64]HexBytes) *uint64HexBytesMapValue {
 }
 
 func (v *uint64HexBytesMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -14012,7 +16257,7 @@ func (v *uint64HexBytesMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := hex.DecodeString(s)
 	if err != nil {
@@ -14042,7 +16287,15 @@ func (v *uint64HexBytesMapValue) String() string {
 
 func (v *uint64HexBytesMapValue) Type() string { return "map[uint64]HexBytes" }
 
-func (v *uint64HexBytesMapValue) IsCumulative() bool {
+func (v *uint64HexBytesMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64HexBytesMapValue) clear() { *v.value = make(map[uint64]HexBytes) }
+
+func (v *uint64HexBytesMapValue) appendNext() {}
+
+func (v *uint64HexBytesMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint64HexBytesMapValue) IsCumulative() bool {
 	return true
 }
 
@@ -14088,6 +16341,7 @@ func (v *regexpValue) Type() string { return "regexp" }
 type regexpSliceValue struct {
 	value   *[]*regexp.Regexp
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*regexpSliceValue)(nil)
@@ -14101,7 +16355,10 @@ func newRegexpSliceValue(slice *[]*regexp.Regexp) *regexpSliceValue {
 }
 
 func (v *regexpSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]*regexp.Regexp, len(ss))
 	for i, s := range ss {
@@ -14145,9 +16402,21 @@ func (v *regexpSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *regexpSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *regexpSliceValue) clear() {
+	*v.value = []*regexp.Regexp{}
+	v.changed = true
+}
+
+func (v *regexpSliceValue) appendNext() { v.changed = true }
+
+func (v *regexpSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringRegexpMapValue
 type stringRegexpMapValue struct {
 	value *map[string]*regexp.Regexp
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringRegexpMapValue)(nil)
@@ -14161,16 +16430,16 @@ func newStringRegexpMapValue(m *map[string]*regexp.Regexp) *stringRegexpMapValue
 }
 
 func (v *stringRegexpMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := regexp.Compile(s)
 	if err != nil {
@@ -14200,6 +16469,14 @@ func (v *stringRegexpMapValue) String() string {
 
 func (v *stringRegexpMapValue) Type() string { return "map[string]*regexp.Regexp" }
 
+func (v *stringRegexpMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringRegexpMapValue) clear() { *v.value = make(map[string]*regexp.Regexp) }
+
+func (v *stringRegexpMapValue) appendNext() {}
+
+func (v *stringRegexpMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringRegexpMapValue) IsCumulative() bool {
 	return true
 }
@@ -14207,6 +16484,7 @@ func (v *stringRegexpMapValue) IsCumulative() bool {
 // -- intRegexpMapValue
 type intRegexpMapValue struct {
 	value *map[int]*regexp.Regexp
+	sep   string
 }
 
 var _ RepeatableFlag = (*intRegexpMapValue)(nil)
@@ -14220,12 +16498,12 @@ func newIntRegexpMapValue(m *map[int]*regexp.Regexp) *intRegexpMapValue {
 }
 
 func (v *intRegexpMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -14234,7 +16512,7 @@ func (v *intRegexpMapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := regexp.Compile(s)
 	if err != nil {
@@ -14264,6 +16542,14 @@ func (v *intRegexpMapValue) String() string {
 
 func (v *intRegexpMapValue) Type() string { return "map[int]*regexp.Regexp" }
 
+func (v *intRegexpMapValue) mapSeparator() string { return v.sep }
+
+func (v *intRegexpMapValue) clear() { *v.value = make(map[int]*regexp.Regexp) }
+
+func (v *intRegexpMapValue) appendNext() {}
+
+func (v *intRegexpMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intRegexpMapValue) IsCumulative() bool {
 	return true
 }
@@ -14271,6 +16557,7 @@ func (v *intRegexpMapValue) IsCumulative() bool {
 // -- int8RegexpMapValue
 type int8RegexpMapValue struct {
 	value *map[int8]*regexp.Regexp
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8RegexpMapValue)(nil)
@@ -14284,12 +16571,12 @@ func newInt8RegexpMapValue(m *map[int8]*regexp.Regexp) *int8RegexpMapValue {
 }
 
 func (v *int8RegexpMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -14298,7 +16585,7 @@ func (v *int8RegexpMapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := regexp.Compile(s)
 	if err != nil {
@@ -14328,6 +16615,14 @@ func (v *int8RegexpMapValue) String() string {
 
 func (v *int8RegexpMapValue) Type() string { return "map[int8]*regexp.Regexp" }
 
+func (v *int8RegexpMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8RegexpMapValue) clear() { *v.value = make(map[int8]*regexp.Regexp) }
+
+func (v *int8RegexpMapValue) appendNext() {}
+
+func (v *int8RegexpMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8RegexpMapValue) IsCumulative() bool {
 	return true
 }
@@ -14335,6 +16630,7 @@ func (v *int8RegexpMapValue) IsCumulative() bool {
 // -- int16RegexpMapValue
 type int16RegexpMapValue struct {
 	value *map[int16]*regexp.Regexp
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16RegexpMapValue)(nil)
@@ -14348,12 +16644,12 @@ func newInt16RegexpMapValue(m *map[int16]*regexp.Regexp) *int16RegexpMapValue {
 }
 
 func (v *int16RegexpMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -14362,7 +16658,7 @@ func (v *int16RegexpMapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := regexp.Compile(s)
 	if err != nil {
@@ -14392,6 +16688,14 @@ func (v *int16RegexpMapValue) String() string {
 
 func (v *int16RegexpMapValue) Type() string { return "map[int16]*regexp.Regexp" }
 
+func (v *int16RegexpMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16RegexpMapValue) clear() { *v.value = make(map[int16]*regexp.Regexp) }
+
+func (v *int16RegexpMapValue) appendNext() {}
+
+func (v *int16RegexpMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16RegexpMapValue) IsCumulative() bool {
 	return true
 }
@@ -14399,6 +16703,7 @@ func (v *int16RegexpMapValue) IsCumulative() bool {
 // -- int32RegexpMapValue
 type int32RegexpMapValue struct {
 	value *map[int32]*regexp.Regexp
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32RegexpMapValue)(nil)
@@ -14412,12 +16717,12 @@ func newInt32RegexpMapValue(m *map[int32]*regexp.Regexp) *int32RegexpMapValue {
 }
 
 func (v *int32RegexpMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -14426,7 +16731,7 @@ func (v *int32RegexpMapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := regexp.Compile(s)
 	if err != nil {
@@ -14456,6 +16761,14 @@ func (v *int32RegexpMapValue) String() string {
 
 func (v *int32RegexpMapValue) Type() string { return "map[int32]*regexp.Regexp" }
 
+func (v *int32RegexpMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32RegexpMapValue) clear() { *v.value = make(map[int32]*regexp.Regexp) }
+
+func (v *int32RegexpMapValue) appendNext() {}
+
+func (v *int32RegexpMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32RegexpMapValue) IsCumulative() bool {
 	return true
 }
@@ -14463,6 +16776,7 @@ func (v *int32RegexpMapValue) IsCumulative() bool {
 // -- int64RegexpMapValue
 type int64RegexpMapValue struct {
 	value *map[int64]*regexp.Regexp
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64RegexpMapValue)(nil)
@@ -14476,12 +16790,12 @@ func newInt64RegexpMapValue(m *map[int64]*regexp.Regexp) *int64RegexpMapValue {
 }
 
 func (v *int64RegexpMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -14490,7 +16804,7 @@ func (v *int64RegexpMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := regexp.Compile(s)
 	if err != nil {
@@ -14520,6 +16834,14 @@ func (v *int64RegexpMapValue) String() string {
 
 func (v *int64RegexpMapValue) Type() string { return "map[int64]*regexp.Regexp" }
 
+func (v *int64RegexpMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64RegexpMapValue) clear() { *v.value = make(map[int64]*regexp.Regexp) }
+
+func (v *int64RegexpMapValue) appendNext() {}
+
+func (v *int64RegexpMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64RegexpMapValue) IsCumulative() bool {
 	return true
 }
@@ -14527,6 +16849,7 @@ func (v *int64RegexpMapValue) IsCumulative() bool {
 // -- uintRegexpMapValue
 type uintRegexpMapValue struct {
 	value *map[uint]*regexp.Regexp
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintRegexpMapValue)(nil)
@@ -14540,12 +16863,12 @@ func newUintRegexpMapValue(m *map[uint]*regexp.Regexp) *uintRegexpMapValue {
 }
 
 func (v *uintRegexpMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -14554,7 +16877,7 @@ func (v *uintRegexpMapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := regexp.Compile(s)
 	if err != nil {
@@ -14584,6 +16907,14 @@ func (v *uintRegexpMapValue) String() string {
 
 func (v *uintRegexpMapValue) Type() string { return "map[uint]*regexp.Regexp" }
 
+func (v *uintRegexpMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintRegexpMapValue) clear() { *v.value = make(map[uint]*regexp.Regexp) }
+
+func (v *uintRegexpMapValue) appendNext() {}
+
+func (v *uintRegexpMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintRegexpMapValue) IsCumulative() bool {
 	return true
 }
@@ -14591,6 +16922,7 @@ func (v *uintRegexpMapValue) IsCumulative() bool {
 // -- uint8RegexpMapValue
 type uint8RegexpMapValue struct {
 	value *map[uint8]*regexp.Regexp
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8RegexpMapValue)(nil)
@@ -14604,12 +16936,12 @@ func newUint8RegexpMapValue(m *map[uint8]*regexp.Regexp) *uint8RegexpMapValue {
 }
 
 func (v *uint8RegexpMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -14618,7 +16950,7 @@ func (v *uint8RegexpMapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := regexp.Compile(s)
 	if err != nil {
@@ -14648,6 +16980,14 @@ func (v *uint8RegexpMapValue) String() string {
 
 func (v *uint8RegexpMapValue) Type() string { return "map[uint8]*regexp.Regexp" }
 
+func (v *uint8RegexpMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8RegexpMapValue) clear() { *v.value = make(map[uint8]*regexp.Regexp) }
+
+func (v *uint8RegexpMapValue) appendNext() {}
+
+func (v *uint8RegexpMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8RegexpMapValue) IsCumulative() bool {
 	return true
 }
@@ -14655,6 +16995,7 @@ func (v *uint8RegexpMapValue) IsCumulative() bool {
 // -- uint16RegexpMapValue
 type uint16RegexpMapValue struct {
 	value *map[uint16]*regexp.Regexp
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16RegexpMapValue)(nil)
@@ -14668,12 +17009,12 @@ func newUint16RegexpMapValue(m *map[uint16]*regexp.Regexp) *uint16RegexpMapValue
 }
 
 func (v *uint16RegexpMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -14682,7 +17023,7 @@ func (v *uint16RegexpMapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := regexp.Compile(s)
 	if err != nil {
@@ -14712,6 +17053,14 @@ func (v *uint16RegexpMapValue) String() string {
 
 func (v *uint16RegexpMapValue) Type() string { return "map[uint16]*regexp.Regexp" }
 
+func (v *uint16RegexpMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16RegexpMapValue) clear() { *v.value = make(map[uint16]*regexp.Regexp) }
+
+func (v *uint16RegexpMapValue) appendNext() {}
+
+func (v *uint16RegexpMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16RegexpMapValue) IsCumulative() bool {
 	return true
 }
@@ -14719,6 +17068,7 @@ func (v *uint16RegexpMapValue) IsCumulative() bool {
 // -- uint32RegexpMapValue
 type uint32RegexpMapValue struct {
 	value *map[uint32]*regexp.Regexp
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32RegexpMapValue)(nil)
@@ -14732,12 +17082,12 @@ func newUint32RegexpMapValue(m *map[uint32]*regexp.Regexp) *uint32RegexpMapValue
 }
 
 func (v *uint32RegexpMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -14746,7 +17096,7 @@ func (v *uint32RegexpMapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := regexp.Compile(s)
 	if err != nil {
@@ -14776,6 +17126,14 @@ func (v *uint32RegexpMapValue) String() string {
 
 func (v *uint32RegexpMapValue) Type() string { return "map[uint32]*regexp.Regexp" }
 
+func (v *uint32RegexpMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32RegexpMapValue) clear() { *v.value = make(map[uint32]*regexp.Regexp) }
+
+func (v *uint32RegexpMapValue) appendNext() {}
+
+func (v *uint32RegexpMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32RegexpMapValue) IsCumulative() bool {
 	return true
 }
@@ -14783,6 +17141,7 @@ func (v *uint32RegexpMapValue) IsCumulative() bool {
 // -- uint64RegexpMapValue
 type uint64RegexpMapValue struct {
 	value *map[uint64]*regexp.Regexp
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64RegexpMapValue)(nil)
@@ -14796,12 +17155,12 @@ func newUint64RegexpMapValue(m *map[uint64]*regexp.Regexp) *uint64RegexpMapValue
 }
 
 func (v *uint64RegexpMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -14810,7 +17169,7 @@ func (v *uint64RegexpMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := regexp.Compile(s)
 	if err != nil {
@@ -14840,6 +17199,14 @@ func (v *uint64RegexpMapValue) String() string {
 
 func (v *uint64RegexpMapValue) Type() string { return "map[uint64]*regexp.Regexp" }
 
+func (v *uint64RegexpMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64RegexpMapValue) clear() { *v.value = make(map[uint64]*regexp.Regexp) }
+
+func (v *uint64RegexpMapValue) appendNext() {}
+
+func (v *uint64RegexpMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64RegexpMapValue) IsCumulative() bool {
 	return true
 }
@@ -14886,6 +17253,7 @@ func (v *tcpAddrValue) Type() string { return "tcpAddr" }
 type tcpAddrSliceValue struct {
 	value   *[]net.TCPAddr
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*tcpAddrSliceValue)(nil)
@@ -14899,7 +17267,10 @@ func newTCPAddrSliceValue(slice *[]net.TCPAddr) *tcpAddrSliceValue {
 }
 
 func (v *tcpAddrSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]net.TCPAddr, len(ss))
 	for i, s := range ss {
@@ -14943,6 +17314,17 @@ func (v *tcpAddrSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *tcpAddrSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *tcpAddrSliceValue) clear() {
+	*v.value = []net.TCPAddr{}
+	v.changed = true
+}
+
+func (v *tcpAddrSliceValue) appendNext() { v.changed = true }
+
+func (v *tcpAddrSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- net.IPNet Value
 type ipNetValue struct {
 	value *net.IPNet
@@ -14985,6 +17367,7 @@ func (v *ipNetValue) Type() string { return "ipNet" }
 type ipNetSliceValue struct {
 	value   *[]net.IPNet
 	changed bool
+	sep     string
 }
 
 var _ RepeatableFlag = (*ipNetSliceValue)(nil)
@@ -14998,7 +17381,10 @@ func newIPNetSliceValue(slice *[]net.IPNet) *ipNetSliceValue {
 }
 
 func (v *ipNetSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
 
 	out := make([]net.IPNet, len(ss))
 	for i, s := range ss {
@@ -15042,9 +17428,21 @@ func (v *ipNetSliceValue) IsCumulative() bool {
 	return true
 }
 
+func (v *ipNetSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *ipNetSliceValue) clear() {
+	*v.value = []net.IPNet{}
+	v.changed = true
+}
+
+func (v *ipNetSliceValue) appendNext() { v.changed = true }
+
+func (v *ipNetSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
 // -- stringIPNetMapValue
 type stringIPNetMapValue struct {
 	value *map[string]net.IPNet
+	sep   string
 }
 
 var _ RepeatableFlag = (*stringIPNetMapValue)(nil)
@@ -15058,16 +17456,16 @@ func newStringIPNetMapValue(m *map[string]net.IPNet) *stringIPNetMapValue {
 }
 
 func (v *stringIPNetMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	key := s
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIPNet(s)
 	if err != nil {
@@ -15097,6 +17495,14 @@ func (v *stringIPNetMapValue) String() string {
 
 func (v *stringIPNetMapValue) Type() string { return "map[string]net.IPNet" }
 
+func (v *stringIPNetMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringIPNetMapValue) clear() { *v.value = make(map[string]net.IPNet) }
+
+func (v *stringIPNetMapValue) appendNext() {}
+
+func (v *stringIPNetMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *stringIPNetMapValue) IsCumulative() bool {
 	return true
 }
@@ -15104,6 +17510,7 @@ func (v *stringIPNetMapValue) IsCumulative() bool {
 // -- intIPNetMapValue
 type intIPNetMapValue struct {
 	value *map[int]net.IPNet
+	sep   string
 }
 
 var _ RepeatableFlag = (*intIPNetMapValue)(nil)
@@ -15117,12 +17524,12 @@ func newIntIPNetMapValue(m *map[int]net.IPNet) *intIPNetMapValue {
 }
 
 func (v *intIPNetMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -15131,7 +17538,7 @@ func (v *intIPNetMapValue) Set(s string) error {
 
 	key := (int)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIPNet(s)
 	if err != nil {
@@ -15161,6 +17568,14 @@ func (v *intIPNetMapValue) String() string {
 
 func (v *intIPNetMapValue) Type() string { return "map[int]net.IPNet" }
 
+func (v *intIPNetMapValue) mapSeparator() string { return v.sep }
+
+func (v *intIPNetMapValue) clear() { *v.value = make(map[int]net.IPNet) }
+
+func (v *intIPNetMapValue) appendNext() {}
+
+func (v *intIPNetMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *intIPNetMapValue) IsCumulative() bool {
 	return true
 }
@@ -15168,6 +17583,7 @@ func (v *intIPNetMapValue) IsCumulative() bool {
 // -- int8IPNetMapValue
 type int8IPNetMapValue struct {
 	value *map[int8]net.IPNet
+	sep   string
 }
 
 var _ RepeatableFlag = (*int8IPNetMapValue)(nil)
@@ -15181,12 +17597,12 @@ func newInt8IPNetMapValue(m *map[int8]net.IPNet) *int8IPNetMapValue {
 }
 
 func (v *int8IPNetMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 8)
 	if err != nil {
@@ -15195,7 +17611,7 @@ func (v *int8IPNetMapValue) Set(s string) error {
 
 	key := (int8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIPNet(s)
 	if err != nil {
@@ -15225,6 +17641,14 @@ func (v *int8IPNetMapValue) String() string {
 
 func (v *int8IPNetMapValue) Type() string { return "map[int8]net.IPNet" }
 
+func (v *int8IPNetMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8IPNetMapValue) clear() { *v.value = make(map[int8]net.IPNet) }
+
+func (v *int8IPNetMapValue) appendNext() {}
+
+func (v *int8IPNetMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int8IPNetMapValue) IsCumulative() bool {
 	return true
 }
@@ -15232,6 +17656,7 @@ func (v *int8IPNetMapValue) IsCumulative() bool {
 // -- int16IPNetMapValue
 type int16IPNetMapValue struct {
 	value *map[int16]net.IPNet
+	sep   string
 }
 
 var _ RepeatableFlag = (*int16IPNetMapValue)(nil)
@@ -15245,12 +17670,12 @@ func newInt16IPNetMapValue(m *map[int16]net.IPNet) *int16IPNetMapValue {
 }
 
 func (v *int16IPNetMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 16)
 	if err != nil {
@@ -15259,7 +17684,7 @@ func (v *int16IPNetMapValue) Set(s string) error {
 
 	key := (int16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIPNet(s)
 	if err != nil {
@@ -15289,6 +17714,14 @@ func (v *int16IPNetMapValue) String() string {
 
 func (v *int16IPNetMapValue) Type() string { return "map[int16]net.IPNet" }
 
+func (v *int16IPNetMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16IPNetMapValue) clear() { *v.value = make(map[int16]net.IPNet) }
+
+func (v *int16IPNetMapValue) appendNext() {}
+
+func (v *int16IPNetMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int16IPNetMapValue) IsCumulative() bool {
 	return true
 }
@@ -15296,6 +17729,7 @@ func (v *int16IPNetMapValue) IsCumulative() bool {
 // -- int32IPNetMapValue
 type int32IPNetMapValue struct {
 	value *map[int32]net.IPNet
+	sep   string
 }
 
 var _ RepeatableFlag = (*int32IPNetMapValue)(nil)
@@ -15309,12 +17743,12 @@ func newInt32IPNetMapValue(m *map[int32]net.IPNet) *int32IPNetMapValue {
 }
 
 func (v *int32IPNetMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 32)
 	if err != nil {
@@ -15323,7 +17757,7 @@ func (v *int32IPNetMapValue) Set(s string) error {
 
 	key := (int32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIPNet(s)
 	if err != nil {
@@ -15353,6 +17787,14 @@ func (v *int32IPNetMapValue) String() string {
 
 func (v *int32IPNetMapValue) Type() string { return "map[int32]net.IPNet" }
 
+func (v *int32IPNetMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32IPNetMapValue) clear() { *v.value = make(map[int32]net.IPNet) }
+
+func (v *int32IPNetMapValue) appendNext() {}
+
+func (v *int32IPNetMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int32IPNetMapValue) IsCumulative() bool {
 	return true
 }
@@ -15360,6 +17802,7 @@ func (v *int32IPNetMapValue) IsCumulative() bool {
 // -- int64IPNetMapValue
 type int64IPNetMapValue struct {
 	value *map[int64]net.IPNet
+	sep   string
 }
 
 var _ RepeatableFlag = (*int64IPNetMapValue)(nil)
@@ -15373,12 +17816,12 @@ func newInt64IPNetMapValue(m *map[int64]net.IPNet) *int64IPNetMapValue {
 }
 
 func (v *int64IPNetMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseInt(s, 0, 64)
 	if err != nil {
@@ -15387,7 +17830,7 @@ func (v *int64IPNetMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIPNet(s)
 	if err != nil {
@@ -15417,6 +17860,14 @@ func (v *int64IPNetMapValue) String() string {
 
 func (v *int64IPNetMapValue) Type() string { return "map[int64]net.IPNet" }
 
+func (v *int64IPNetMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64IPNetMapValue) clear() { *v.value = make(map[int64]net.IPNet) }
+
+func (v *int64IPNetMapValue) appendNext() {}
+
+func (v *int64IPNetMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *int64IPNetMapValue) IsCumulative() bool {
 	return true
 }
@@ -15424,6 +17875,7 @@ func (v *int64IPNetMapValue) IsCumulative() bool {
 // -- uintIPNetMapValue
 type uintIPNetMapValue struct {
 	value *map[uint]net.IPNet
+	sep   string
 }
 
 var _ RepeatableFlag = (*uintIPNetMapValue)(nil)
@@ -15437,12 +17889,12 @@ func newUintIPNetMapValue(m *map[uint]net.IPNet) *uintIPNetMapValue {
 }
 
 func (v *uintIPNetMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -15451,7 +17903,7 @@ func (v *uintIPNetMapValue) Set(s string) error {
 
 	key := (uint)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIPNet(s)
 	if err != nil {
@@ -15481,6 +17933,14 @@ func (v *uintIPNetMapValue) String() string {
 
 func (v *uintIPNetMapValue) Type() string { return "map[uint]net.IPNet" }
 
+func (v *uintIPNetMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintIPNetMapValue) clear() { *v.value = make(map[uint]net.IPNet) }
+
+func (v *uintIPNetMapValue) appendNext() {}
+
+func (v *uintIPNetMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uintIPNetMapValue) IsCumulative() bool {
 	return true
 }
@@ -15488,6 +17948,7 @@ func (v *uintIPNetMapValue) IsCumulative() bool {
 // -- uint8IPNetMapValue
 type uint8IPNetMapValue struct {
 	value *map[uint8]net.IPNet
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint8IPNetMapValue)(nil)
@@ -15501,12 +17962,12 @@ func newUint8IPNetMapValue(m *map[uint8]net.IPNet) *uint8IPNetMapValue {
 }
 
 func (v *uint8IPNetMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 8)
 	if err != nil {
@@ -15515,7 +17976,7 @@ func (v *uint8IPNetMapValue) Set(s string) error {
 
 	key := (uint8)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIPNet(s)
 	if err != nil {
@@ -15545,6 +18006,14 @@ func (v *uint8IPNetMapValue) String() string {
 
 func (v *uint8IPNetMapValue) Type() string { return "map[uint8]net.IPNet" }
 
+func (v *uint8IPNetMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8IPNetMapValue) clear() { *v.value = make(map[uint8]net.IPNet) }
+
+func (v *uint8IPNetMapValue) appendNext() {}
+
+func (v *uint8IPNetMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint8IPNetMapValue) IsCumulative() bool {
 	return true
 }
@@ -15552,6 +18021,7 @@ func (v *uint8IPNetMapValue) IsCumulative() bool {
 // -- uint16IPNetMapValue
 type uint16IPNetMapValue struct {
 	value *map[uint16]net.IPNet
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint16IPNetMapValue)(nil)
@@ -15565,12 +18035,12 @@ func newUint16IPNetMapValue(m *map[uint16]net.IPNet) *uint16IPNetMapValue {
 }
 
 func (v *uint16IPNetMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 16)
 	if err != nil {
@@ -15579,7 +18049,7 @@ func (v *uint16IPNetMapValue) Set(s string) error {
 
 	key := (uint16)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIPNet(s)
 	if err != nil {
@@ -15609,6 +18079,14 @@ func (v *uint16IPNetMapValue) String() string {
 
 func (v *uint16IPNetMapValue) Type() string { return "map[uint16]net.IPNet" }
 
+func (v *uint16IPNetMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16IPNetMapValue) clear() { *v.value = make(map[uint16]net.IPNet) }
+
+func (v *uint16IPNetMapValue) appendNext() {}
+
+func (v *uint16IPNetMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint16IPNetMapValue) IsCumulative() bool {
 	return true
 }
@@ -15616,6 +18094,7 @@ func (v *uint16IPNetMapValue) IsCumulative() bool {
 // -- uint32IPNetMapValue
 type uint32IPNetMapValue struct {
 	value *map[uint32]net.IPNet
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint32IPNetMapValue)(nil)
@@ -15629,12 +18108,12 @@ func newUint32IPNetMapValue(m *map[uint32]net.IPNet) *uint32IPNetMapValue {
 }
 
 func (v *uint32IPNetMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 32)
 	if err != nil {
@@ -15643,7 +18122,7 @@ func (v *uint32IPNetMapValue) Set(s string) error {
 
 	key := (uint32)(parsedKey)
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIPNet(s)
 	if err != nil {
@@ -15673,6 +18152,14 @@ func (v *uint32IPNetMapValue) String() string {
 
 func (v *uint32IPNetMapValue) Type() string { return "map[uint32]net.IPNet" }
 
+func (v *uint32IPNetMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32IPNetMapValue) clear() { *v.value = make(map[uint32]net.IPNet) }
+
+func (v *uint32IPNetMapValue) appendNext() {}
+
+func (v *uint32IPNetMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint32IPNetMapValue) IsCumulative() bool {
 	return true
 }
@@ -15680,6 +18167,7 @@ func (v *uint32IPNetMapValue) IsCumulative() bool {
 // -- uint64IPNetMapValue
 type uint64IPNetMapValue struct {
 	value *map[uint64]net.IPNet
+	sep   string
 }
 
 var _ RepeatableFlag = (*uint64IPNetMapValue)(nil)
@@ -15693,12 +18181,12 @@ func newUint64IPNetMapValue(m *map[uint64]net.IPNet) *uint64IPNetMapValue {
 }
 
 func (v *uint64IPNetMapValue) Set(s string) error {
-	ss := strings.Split(s, ":")
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
 	}
 
-	s = ss[0]
+	s = rawKey
 
 	parsedKey, err := strconv.ParseUint(s, 0, 64)
 	if err != nil {
@@ -15707,7 +18195,7 @@ func (v *uint64IPNetMapValue) Set(s string) error {
 
 	key := parsedKey
 
-	s = ss[1]
+	s = rawVal
 
 	parsedVal, err := parseIPNet(s)
 	if err != nil {
@@ -15737,6 +18225,11041 @@ func (v *uint64IPNetMapValue) String() string {
 
 func (v *uint64IPNetMapValue) Type() string { return "map[uint64]net.IPNet" }
 
+func (v *uint64IPNetMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64IPNetMapValue) clear() { *v.value = make(map[uint64]net.IPNet) }
+
+func (v *uint64IPNetMapValue) appendNext() {}
+
+func (v *uint64IPNetMapValue) setMapSeparator(sep string) { v.sep = sep }
+
 func (v *uint64IPNetMapValue) IsCumulative() bool {
 	return true
 }
+
+// -- *url.URL Value
+type urlValue struct {
+	value **url.URL
+}
+
+var _ Value = (*urlValue)(nil)
+var _ Getter = (*urlValue)(nil)
+
+func newURLValue(p **url.URL) *urlValue {
+	return &urlValue{value: p}
+}
+
+func (v *urlValue) Set(s string) error {
+	parsed, err := url.Parse(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *urlValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *urlValue) String() string {
+	if v != nil && v.value != nil {
+		return (*v.value).String()
+	}
+	return ""
+}
+
+func (v *urlValue) Type() string { return "url" }
+
+// -- *url.URLSlice Value
+
+type urlSliceValue struct {
+	value   *[]*url.URL
+	changed bool
+	sep     string
+}
+
+var _ RepeatableFlag = (*urlSliceValue)(nil)
+var _ Value = (*urlSliceValue)(nil)
+var _ Getter = (*urlSliceValue)(nil)
+
+func newURLSliceValue(slice *[]*url.URL) *urlSliceValue {
+	return &urlSliceValue{
+		value: slice,
+	}
+}
+
+func (v *urlSliceValue) Set(raw string) error {
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
+
+	out := make([]*url.URL, len(ss))
+	for i, s := range ss {
+		parsed, err := url.Parse(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *urlSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]*url.URL)(nil)
+}
+
+func (v *urlSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newURLValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *urlSliceValue) Type() string { return "urlSlice" }
+
+func (v *urlSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *urlSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *urlSliceValue) clear() {
+	*v.value = []*url.URL{}
+	v.changed = true
+}
+
+func (v *urlSliceValue) appendNext() { v.changed = true }
+
+func (v *urlSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
+// -- stringURLMapValue
+type stringURLMapValue struct {
+	value *map[string]*url.URL
+	sep   string
+}
+
+var _ RepeatableFlag = (*stringURLMapValue)(nil)
+var _ Value = (*stringURLMapValue)(nil)
+var _ Getter = (*stringURLMapValue)(nil)
+
+func newStringURLMapValue(m *map[string]*url.URL) *stringURLMapValue {
+	return &stringURLMapValue{
+		value: m,
+	}
+}
+
+func (v *stringURLMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	key := s
+
+	s = rawVal
+
+	parsedVal, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringURLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringURLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringURLMapValue) Type() string { return "map[string]*url.URL" }
+
+func (v *stringURLMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringURLMapValue) clear() { *v.value = make(map[string]*url.URL) }
+
+func (v *stringURLMapValue) appendNext() {}
+
+func (v *stringURLMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *stringURLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intURLMapValue
+type intURLMapValue struct {
+	value *map[int]*url.URL
+	sep   string
+}
+
+var _ RepeatableFlag = (*intURLMapValue)(nil)
+var _ Value = (*intURLMapValue)(nil)
+var _ Getter = (*intURLMapValue)(nil)
+
+func newIntURLMapValue(m *map[int]*url.URL) *intURLMapValue {
+	return &intURLMapValue{
+		value: m,
+	}
+}
+
+func (v *intURLMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intURLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intURLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intURLMapValue) Type() string { return "map[int]*url.URL" }
+
+func (v *intURLMapValue) mapSeparator() string { return v.sep }
+
+func (v *intURLMapValue) clear() { *v.value = make(map[int]*url.URL) }
+
+func (v *intURLMapValue) appendNext() {}
+
+func (v *intURLMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *intURLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8URLMapValue
+type int8URLMapValue struct {
+	value *map[int8]*url.URL
+	sep   string
+}
+
+var _ RepeatableFlag = (*int8URLMapValue)(nil)
+var _ Value = (*int8URLMapValue)(nil)
+var _ Getter = (*int8URLMapValue)(nil)
+
+func newInt8URLMapValue(m *map[int8]*url.URL) *int8URLMapValue {
+	return &int8URLMapValue{
+		value: m,
+	}
+}
+
+func (v *int8URLMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8URLMapValue) Type() string { return "map[int8]*url.URL" }
+
+func (v *int8URLMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8URLMapValue) clear() { *v.value = make(map[int8]*url.URL) }
+
+func (v *int8URLMapValue) appendNext() {}
+
+func (v *int8URLMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int8URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16URLMapValue
+type int16URLMapValue struct {
+	value *map[int16]*url.URL
+	sep   string
+}
+
+var _ RepeatableFlag = (*int16URLMapValue)(nil)
+var _ Value = (*int16URLMapValue)(nil)
+var _ Getter = (*int16URLMapValue)(nil)
+
+func newInt16URLMapValue(m *map[int16]*url.URL) *int16URLMapValue {
+	return &int16URLMapValue{
+		value: m,
+	}
+}
+
+func (v *int16URLMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16URLMapValue) Type() string { return "map[int16]*url.URL" }
+
+func (v *int16URLMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16URLMapValue) clear() { *v.value = make(map[int16]*url.URL) }
+
+func (v *int16URLMapValue) appendNext() {}
+
+func (v *int16URLMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int16URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32URLMapValue
+type int32URLMapValue struct {
+	value *map[int32]*url.URL
+	sep   string
+}
+
+var _ RepeatableFlag = (*int32URLMapValue)(nil)
+var _ Value = (*int32URLMapValue)(nil)
+var _ Getter = (*int32URLMapValue)(nil)
+
+func newInt32URLMapValue(m *map[int32]*url.URL) *int32URLMapValue {
+	return &int32URLMapValue{
+		value: m,
+	}
+}
+
+func (v *int32URLMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32URLMapValue) Type() string { return "map[int32]*url.URL" }
+
+func (v *int32URLMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32URLMapValue) clear() { *v.value = make(map[int32]*url.URL) }
+
+func (v *int32URLMapValue) appendNext() {}
+
+func (v *int32URLMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int32URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64URLMapValue
+type int64URLMapValue struct {
+	value *map[int64]*url.URL
+	sep   string
+}
+
+var _ RepeatableFlag = (*int64URLMapValue)(nil)
+var _ Value = (*int64URLMapValue)(nil)
+var _ Getter = (*int64URLMapValue)(nil)
+
+func newInt64URLMapValue(m *map[int64]*url.URL) *int64URLMapValue {
+	return &int64URLMapValue{
+		value: m,
+	}
+}
+
+func (v *int64URLMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64URLMapValue) Type() string { return "map[int64]*url.URL" }
+
+func (v *int64URLMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64URLMapValue) clear() { *v.value = make(map[int64]*url.URL) }
+
+func (v *int64URLMapValue) appendNext() {}
+
+func (v *int64URLMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int64URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintURLMapValue
+type uintURLMapValue struct {
+	value *map[uint]*url.URL
+	sep   string
+}
+
+var _ RepeatableFlag = (*uintURLMapValue)(nil)
+var _ Value = (*uintURLMapValue)(nil)
+var _ Getter = (*uintURLMapValue)(nil)
+
+func newUintURLMapValue(m *map[uint]*url.URL) *uintURLMapValue {
+	return &uintURLMapValue{
+		value: m,
+	}
+}
+
+func (v *uintURLMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintURLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintURLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintURLMapValue) Type() string { return "map[uint]*url.URL" }
+
+func (v *uintURLMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintURLMapValue) clear() { *v.value = make(map[uint]*url.URL) }
+
+func (v *uintURLMapValue) appendNext() {}
+
+func (v *uintURLMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uintURLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8URLMapValue
+type uint8URLMapValue struct {
+	value *map[uint8]*url.URL
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint8URLMapValue)(nil)
+var _ Value = (*uint8URLMapValue)(nil)
+var _ Getter = (*uint8URLMapValue)(nil)
+
+func newUint8URLMapValue(m *map[uint8]*url.URL) *uint8URLMapValue {
+	return &uint8URLMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8URLMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8URLMapValue) Type() string { return "map[uint8]*url.URL" }
+
+func (v *uint8URLMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8URLMapValue) clear() { *v.value = make(map[uint8]*url.URL) }
+
+func (v *uint8URLMapValue) appendNext() {}
+
+func (v *uint8URLMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint8URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16URLMapValue
+type uint16URLMapValue struct {
+	value *map[uint16]*url.URL
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint16URLMapValue)(nil)
+var _ Value = (*uint16URLMapValue)(nil)
+var _ Getter = (*uint16URLMapValue)(nil)
+
+func newUint16URLMapValue(m *map[uint16]*url.URL) *uint16URLMapValue {
+	return &uint16URLMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16URLMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16URLMapValue) Type() string { return "map[uint16]*url.URL" }
+
+func (v *uint16URLMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16URLMapValue) clear() { *v.value = make(map[uint16]*url.URL) }
+
+func (v *uint16URLMapValue) appendNext() {}
+
+func (v *uint16URLMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint16URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32URLMapValue
+type uint32URLMapValue struct {
+	value *map[uint32]*url.URL
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint32URLMapValue)(nil)
+var _ Value = (*uint32URLMapValue)(nil)
+var _ Getter = (*uint32URLMapValue)(nil)
+
+func newUint32URLMapValue(m *map[uint32]*url.URL) *uint32URLMapValue {
+	return &uint32URLMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32URLMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32URLMapValue) Type() string { return "map[uint32]*url.URL" }
+
+func (v *uint32URLMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32URLMapValue) clear() { *v.value = make(map[uint32]*url.URL) }
+
+func (v *uint32URLMapValue) appendNext() {}
+
+func (v *uint32URLMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint32URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64URLMapValue
+type uint64URLMapValue struct {
+	value *map[uint64]*url.URL
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint64URLMapValue)(nil)
+var _ Value = (*uint64URLMapValue)(nil)
+var _ Getter = (*uint64URLMapValue)(nil)
+
+func newUint64URLMapValue(m *map[uint64]*url.URL) *uint64URLMapValue {
+	return &uint64URLMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64URLMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64URLMapValue) Type() string { return "map[uint64]*url.URL" }
+
+func (v *uint64URLMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64URLMapValue) clear() { *v.value = make(map[uint64]*url.URL) }
+
+func (v *uint64URLMapValue) appendNext() {}
+
+func (v *uint64URLMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint64URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- time.Time Value
+type timeValue struct {
+	value  *time.Time
+	layout string
+}
+
+var _ Value = (*timeValue)(nil)
+var _ Getter = (*timeValue)(nil)
+
+func newTimeValue(p *time.Time) *timeValue {
+	return &timeValue{value: p}
+}
+
+func (v *timeValue) Set(s string) error {
+	parsed, err := parseTime(s, v.layout)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *timeValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *timeValue) String() string {
+	if v != nil && v.value != nil {
+		return formatTime(*v.value, v.layout)
+	}
+	return ""
+}
+
+func (v *timeValue) Type() string { return "time" }
+
+func (v *timeValue) setLayout(layout string) { v.layout = layout }
+
+// -- time.TimeSlice Value
+
+type timeSliceValue struct {
+	value   *[]time.Time
+	changed bool
+	sep     string
+	layout  string
+}
+
+var _ RepeatableFlag = (*timeSliceValue)(nil)
+var _ Value = (*timeSliceValue)(nil)
+var _ Getter = (*timeSliceValue)(nil)
+
+func newTimeSliceValue(slice *[]time.Time) *timeSliceValue {
+	return &timeSliceValue{
+		value: slice,
+	}
+}
+
+func (v *timeSliceValue) Set(raw string) error {
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
+
+	out := make([]time.Time, len(ss))
+	for i, s := range ss {
+		parsed, err := parseTime(s, v.layout)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *timeSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]time.Time)(nil)
+}
+
+func (v *timeSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, (&timeValue{value: &elem, layout: v.layout}).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *timeSliceValue) Type() string { return "timeSlice" }
+
+func (v *timeSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *timeSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *timeSliceValue) clear() {
+	*v.value = []time.Time{}
+	v.changed = true
+}
+
+func (v *timeSliceValue) appendNext() { v.changed = true }
+
+func (v *timeSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
+func (v *timeSliceValue) setLayout(layout string) { v.layout = layout }
+
+// -- stringTimeMapValue
+type stringTimeMapValue struct {
+	value  *map[string]time.Time
+	sep    string
+	layout string
+}
+
+var _ RepeatableFlag = (*stringTimeMapValue)(nil)
+var _ Value = (*stringTimeMapValue)(nil)
+var _ Getter = (*stringTimeMapValue)(nil)
+
+func newStringTimeMapValue(m *map[string]time.Time) *stringTimeMapValue {
+	return &stringTimeMapValue{
+		value: m,
+	}
+}
+
+func (v *stringTimeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	key := s
+
+	s = rawVal
+
+	parsedVal, err := parseTime(s, v.layout)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringTimeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringTimeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		out := make(map[string]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
+	}
+	return ""
+}
+
+func (v *stringTimeMapValue) Type() string { return "map[string]time.Time" }
+
+func (v *stringTimeMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringTimeMapValue) clear() { *v.value = make(map[string]time.Time) }
+
+func (v *stringTimeMapValue) appendNext() {}
+
+func (v *stringTimeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *stringTimeMapValue) setLayout(layout string) { v.layout = layout }
+
+func (v *stringTimeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intTimeMapValue
+type intTimeMapValue struct {
+	value  *map[int]time.Time
+	sep    string
+	layout string
+}
+
+var _ RepeatableFlag = (*intTimeMapValue)(nil)
+var _ Value = (*intTimeMapValue)(nil)
+var _ Getter = (*intTimeMapValue)(nil)
+
+func newIntTimeMapValue(m *map[int]time.Time) *intTimeMapValue {
+	return &intTimeMapValue{
+		value: m,
+	}
+}
+
+func (v *intTimeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseTime(s, v.layout)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intTimeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intTimeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		out := make(map[int]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
+	}
+	return ""
+}
+
+func (v *intTimeMapValue) Type() string { return "map[int]time.Time" }
+
+func (v *intTimeMapValue) mapSeparator() string { return v.sep }
+
+func (v *intTimeMapValue) clear() { *v.value = make(map[int]time.Time) }
+
+func (v *intTimeMapValue) appendNext() {}
+
+func (v *intTimeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *intTimeMapValue) setLayout(layout string) { v.layout = layout }
+
+func (v *intTimeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8TimeMapValue
+type int8TimeMapValue struct {
+	value  *map[int8]time.Time
+	sep    string
+	layout string
+}
+
+var _ RepeatableFlag = (*int8TimeMapValue)(nil)
+var _ Value = (*int8TimeMapValue)(nil)
+var _ Getter = (*int8TimeMapValue)(nil)
+
+func newInt8TimeMapValue(m *map[int8]time.Time) *int8TimeMapValue {
+	return &int8TimeMapValue{
+		value: m,
+	}
+}
+
+func (v *int8TimeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseTime(s, v.layout)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8TimeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8TimeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		out := make(map[int8]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
+	}
+	return ""
+}
+
+func (v *int8TimeMapValue) Type() string { return "map[int8]time.Time" }
+
+func (v *int8TimeMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8TimeMapValue) clear() { *v.value = make(map[int8]time.Time) }
+
+func (v *int8TimeMapValue) appendNext() {}
+
+func (v *int8TimeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int8TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+func (v *int8TimeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16TimeMapValue
+type int16TimeMapValue struct {
+	value  *map[int16]time.Time
+	sep    string
+	layout string
+}
+
+var _ RepeatableFlag = (*int16TimeMapValue)(nil)
+var _ Value = (*int16TimeMapValue)(nil)
+var _ Getter = (*int16TimeMapValue)(nil)
+
+func newInt16TimeMapValue(m *map[int16]time.Time) *int16TimeMapValue {
+	return &int16TimeMapValue{
+		value: m,
+	}
+}
+
+func (v *int16TimeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseTime(s, v.layout)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16TimeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16TimeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		out := make(map[int16]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
+	}
+	return ""
+}
+
+func (v *int16TimeMapValue) Type() string { return "map[int16]time.Time" }
+
+func (v *int16TimeMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16TimeMapValue) clear() { *v.value = make(map[int16]time.Time) }
+
+func (v *int16TimeMapValue) appendNext() {}
+
+func (v *int16TimeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int16TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+func (v *int16TimeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32TimeMapValue
+type int32TimeMapValue struct {
+	value  *map[int32]time.Time
+	sep    string
+	layout string
+}
+
+var _ RepeatableFlag = (*int32TimeMapValue)(nil)
+var _ Value = (*int32TimeMapValue)(nil)
+var _ Getter = (*int32TimeMapValue)(nil)
+
+func newInt32TimeMapValue(m *map[int32]time.Time) *int32TimeMapValue {
+	return &int32TimeMapValue{
+		value: m,
+	}
+}
+
+func (v *int32TimeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseTime(s, v.layout)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32TimeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32TimeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		out := make(map[int32]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
+	}
+	return ""
+}
+
+func (v *int32TimeMapValue) Type() string { return "map[int32]time.Time" }
+
+func (v *int32TimeMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32TimeMapValue) clear() { *v.value = make(map[int32]time.Time) }
+
+func (v *int32TimeMapValue) appendNext() {}
+
+func (v *int32TimeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int32TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+func (v *int32TimeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64TimeMapValue
+type int64TimeMapValue struct {
+	value  *map[int64]time.Time
+	sep    string
+	layout string
+}
+
+var _ RepeatableFlag = (*int64TimeMapValue)(nil)
+var _ Value = (*int64TimeMapValue)(nil)
+var _ Getter = (*int64TimeMapValue)(nil)
+
+func newInt64TimeMapValue(m *map[int64]time.Time) *int64TimeMapValue {
+	return &int64TimeMapValue{
+		value: m,
+	}
+}
+
+func (v *int64TimeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := parseTime(s, v.layout)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64TimeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64TimeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		out := make(map[int64]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
+	}
+	return ""
+}
+
+func (v *int64TimeMapValue) Type() string { return "map[int64]time.Time" }
+
+func (v *int64TimeMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64TimeMapValue) clear() { *v.value = make(map[int64]time.Time) }
+
+func (v *int64TimeMapValue) appendNext() {}
+
+func (v *int64TimeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int64TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+func (v *int64TimeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintTimeMapValue
+type uintTimeMapValue struct {
+	value  *map[uint]time.Time
+	sep    string
+	layout string
+}
+
+var _ RepeatableFlag = (*uintTimeMapValue)(nil)
+var _ Value = (*uintTimeMapValue)(nil)
+var _ Getter = (*uintTimeMapValue)(nil)
+
+func newUintTimeMapValue(m *map[uint]time.Time) *uintTimeMapValue {
+	return &uintTimeMapValue{
+		value: m,
+	}
+}
+
+func (v *uintTimeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseTime(s, v.layout)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintTimeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintTimeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		out := make(map[uint]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
+	}
+	return ""
+}
+
+func (v *uintTimeMapValue) Type() string { return "map[uint]time.Time" }
+
+func (v *uintTimeMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintTimeMapValue) clear() { *v.value = make(map[uint]time.Time) }
+
+func (v *uintTimeMapValue) appendNext() {}
+
+func (v *uintTimeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uintTimeMapValue) setLayout(layout string) { v.layout = layout }
+
+func (v *uintTimeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8TimeMapValue
+type uint8TimeMapValue struct {
+	value  *map[uint8]time.Time
+	sep    string
+	layout string
+}
+
+var _ RepeatableFlag = (*uint8TimeMapValue)(nil)
+var _ Value = (*uint8TimeMapValue)(nil)
+var _ Getter = (*uint8TimeMapValue)(nil)
+
+func newUint8TimeMapValue(m *map[uint8]time.Time) *uint8TimeMapValue {
+	return &uint8TimeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8TimeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseTime(s, v.layout)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8TimeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8TimeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		out := make(map[uint8]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
+	}
+	return ""
+}
+
+func (v *uint8TimeMapValue) Type() string { return "map[uint8]time.Time" }
+
+func (v *uint8TimeMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8TimeMapValue) clear() { *v.value = make(map[uint8]time.Time) }
+
+func (v *uint8TimeMapValue) appendNext() {}
+
+func (v *uint8TimeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint8TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+func (v *uint8TimeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16TimeMapValue
+type uint16TimeMapValue struct {
+	value  *map[uint16]time.Time
+	sep    string
+	layout string
+}
+
+var _ RepeatableFlag = (*uint16TimeMapValue)(nil)
+var _ Value = (*uint16TimeMapValue)(nil)
+var _ Getter = (*uint16TimeMapValue)(nil)
+
+func newUint16TimeMapValue(m *map[uint16]time.Time) *uint16TimeMapValue {
+	return &uint16TimeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16TimeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseTime(s, v.layout)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16TimeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16TimeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		out := make(map[uint16]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
+	}
+	return ""
+}
+
+func (v *uint16TimeMapValue) Type() string { return "map[uint16]time.Time" }
+
+func (v *uint16TimeMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16TimeMapValue) clear() { *v.value = make(map[uint16]time.Time) }
+
+func (v *uint16TimeMapValue) appendNext() {}
+
+func (v *uint16TimeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint16TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+func (v *uint16TimeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32TimeMapValue
+type uint32TimeMapValue struct {
+	value  *map[uint32]time.Time
+	sep    string
+	layout string
+}
+
+var _ RepeatableFlag = (*uint32TimeMapValue)(nil)
+var _ Value = (*uint32TimeMapValue)(nil)
+var _ Getter = (*uint32TimeMapValue)(nil)
+
+func newUint32TimeMapValue(m *map[uint32]time.Time) *uint32TimeMapValue {
+	return &uint32TimeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32TimeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseTime(s, v.layout)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32TimeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32TimeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		out := make(map[uint32]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
+	}
+	return ""
+}
+
+func (v *uint32TimeMapValue) Type() string { return "map[uint32]time.Time" }
+
+func (v *uint32TimeMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32TimeMapValue) clear() { *v.value = make(map[uint32]time.Time) }
+
+func (v *uint32TimeMapValue) appendNext() {}
+
+func (v *uint32TimeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint32TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+func (v *uint32TimeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64TimeMapValue
+type uint64TimeMapValue struct {
+	value  *map[uint64]time.Time
+	sep    string
+	layout string
+}
+
+var _ RepeatableFlag = (*uint64TimeMapValue)(nil)
+var _ Value = (*uint64TimeMapValue)(nil)
+var _ Getter = (*uint64TimeMapValue)(nil)
+
+func newUint64TimeMapValue(m *map[uint64]time.Time) *uint64TimeMapValue {
+	return &uint64TimeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64TimeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := parseTime(s, v.layout)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64TimeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64TimeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		out := make(map[uint64]string, len(*v.value))
+		for key, elem := range *v.value {
+			out[key] = (&timeValue{value: &elem, layout: v.layout}).String()
+		}
+		return fmt.Sprintf("%v", out)
+	}
+	return ""
+}
+
+func (v *uint64TimeMapValue) Type() string { return "map[uint64]time.Time" }
+
+func (v *uint64TimeMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64TimeMapValue) clear() { *v.value = make(map[uint64]time.Time) }
+
+func (v *uint64TimeMapValue) appendNext() {}
+
+func (v *uint64TimeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint64TimeMapValue) setLayout(layout string) { v.layout = layout }
+
+func (v *uint64TimeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- netip.Addr Value
+type netipAddrValue struct {
+	value *netip.Addr
+}
+
+var _ Value = (*netipAddrValue)(nil)
+var _ Getter = (*netipAddrValue)(nil)
+
+func newNetipAddrValue(p *netip.Addr) *netipAddrValue {
+	return &netipAddrValue{value: p}
+}
+
+func (v *netipAddrValue) Set(s string) error {
+	parsed, err := netip.ParseAddr(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *netipAddrValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *netipAddrValue) String() string {
+	if v != nil && v.value != nil {
+		return formatValid(*v.value)
+	}
+	return ""
+}
+
+func (v *netipAddrValue) Type() string { return "netipAddr" }
+
+// -- netip.AddrSlice Value
+
+type netipAddrSliceValue struct {
+	value   *[]netip.Addr
+	changed bool
+	sep     string
+}
+
+var _ RepeatableFlag = (*netipAddrSliceValue)(nil)
+var _ Value = (*netipAddrSliceValue)(nil)
+var _ Getter = (*netipAddrSliceValue)(nil)
+
+func newNetipAddrSliceValue(slice *[]netip.Addr) *netipAddrSliceValue {
+	return &netipAddrSliceValue{
+		value: slice,
+	}
+}
+
+func (v *netipAddrSliceValue) Set(raw string) error {
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
+
+	out := make([]netip.Addr, len(ss))
+	for i, s := range ss {
+		parsed, err := netip.ParseAddr(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *netipAddrSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]netip.Addr)(nil)
+}
+
+func (v *netipAddrSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newNetipAddrValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *netipAddrSliceValue) Type() string { return "netipAddrSlice" }
+
+func (v *netipAddrSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *netipAddrSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *netipAddrSliceValue) clear() {
+	*v.value = []netip.Addr{}
+	v.changed = true
+}
+
+func (v *netipAddrSliceValue) appendNext() { v.changed = true }
+
+func (v *netipAddrSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
+// -- stringNetipAddrMapValue
+type stringNetipAddrMapValue struct {
+	value *map[string]netip.Addr
+	sep   string
+}
+
+var _ RepeatableFlag = (*stringNetipAddrMapValue)(nil)
+var _ Value = (*stringNetipAddrMapValue)(nil)
+var _ Getter = (*stringNetipAddrMapValue)(nil)
+
+func newStringNetipAddrMapValue(m *map[string]netip.Addr) *stringNetipAddrMapValue {
+	return &stringNetipAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *stringNetipAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	key := s
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringNetipAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringNetipAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringNetipAddrMapValue) Type() string { return "map[string]netip.Addr" }
+
+func (v *stringNetipAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringNetipAddrMapValue) clear() { *v.value = make(map[string]netip.Addr) }
+
+func (v *stringNetipAddrMapValue) appendNext() {}
+
+func (v *stringNetipAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *stringNetipAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intNetipAddrMapValue
+type intNetipAddrMapValue struct {
+	value *map[int]netip.Addr
+	sep   string
+}
+
+var _ RepeatableFlag = (*intNetipAddrMapValue)(nil)
+var _ Value = (*intNetipAddrMapValue)(nil)
+var _ Getter = (*intNetipAddrMapValue)(nil)
+
+func newIntNetipAddrMapValue(m *map[int]netip.Addr) *intNetipAddrMapValue {
+	return &intNetipAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *intNetipAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intNetipAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intNetipAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intNetipAddrMapValue) Type() string { return "map[int]netip.Addr" }
+
+func (v *intNetipAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *intNetipAddrMapValue) clear() { *v.value = make(map[int]netip.Addr) }
+
+func (v *intNetipAddrMapValue) appendNext() {}
+
+func (v *intNetipAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *intNetipAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8NetipAddrMapValue
+type int8NetipAddrMapValue struct {
+	value *map[int8]netip.Addr
+	sep   string
+}
+
+var _ RepeatableFlag = (*int8NetipAddrMapValue)(nil)
+var _ Value = (*int8NetipAddrMapValue)(nil)
+var _ Getter = (*int8NetipAddrMapValue)(nil)
+
+func newInt8NetipAddrMapValue(m *map[int8]netip.Addr) *int8NetipAddrMapValue {
+	return &int8NetipAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int8NetipAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8NetipAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8NetipAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8NetipAddrMapValue) Type() string { return "map[int8]netip.Addr" }
+
+func (v *int8NetipAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8NetipAddrMapValue) clear() { *v.value = make(map[int8]netip.Addr) }
+
+func (v *int8NetipAddrMapValue) appendNext() {}
+
+func (v *int8NetipAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int8NetipAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16NetipAddrMapValue
+type int16NetipAddrMapValue struct {
+	value *map[int16]netip.Addr
+	sep   string
+}
+
+var _ RepeatableFlag = (*int16NetipAddrMapValue)(nil)
+var _ Value = (*int16NetipAddrMapValue)(nil)
+var _ Getter = (*int16NetipAddrMapValue)(nil)
+
+func newInt16NetipAddrMapValue(m *map[int16]netip.Addr) *int16NetipAddrMapValue {
+	return &int16NetipAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int16NetipAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16NetipAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16NetipAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16NetipAddrMapValue) Type() string { return "map[int16]netip.Addr" }
+
+func (v *int16NetipAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16NetipAddrMapValue) clear() { *v.value = make(map[int16]netip.Addr) }
+
+func (v *int16NetipAddrMapValue) appendNext() {}
+
+func (v *int16NetipAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int16NetipAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32NetipAddrMapValue
+type int32NetipAddrMapValue struct {
+	value *map[int32]netip.Addr
+	sep   string
+}
+
+var _ RepeatableFlag = (*int32NetipAddrMapValue)(nil)
+var _ Value = (*int32NetipAddrMapValue)(nil)
+var _ Getter = (*int32NetipAddrMapValue)(nil)
+
+func newInt32NetipAddrMapValue(m *map[int32]netip.Addr) *int32NetipAddrMapValue {
+	return &int32NetipAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int32NetipAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32NetipAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32NetipAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32NetipAddrMapValue) Type() string { return "map[int32]netip.Addr" }
+
+func (v *int32NetipAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32NetipAddrMapValue) clear() { *v.value = make(map[int32]netip.Addr) }
+
+func (v *int32NetipAddrMapValue) appendNext() {}
+
+func (v *int32NetipAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int32NetipAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64NetipAddrMapValue
+type int64NetipAddrMapValue struct {
+	value *map[int64]netip.Addr
+	sep   string
+}
+
+var _ RepeatableFlag = (*int64NetipAddrMapValue)(nil)
+var _ Value = (*int64NetipAddrMapValue)(nil)
+var _ Getter = (*int64NetipAddrMapValue)(nil)
+
+func newInt64NetipAddrMapValue(m *map[int64]netip.Addr) *int64NetipAddrMapValue {
+	return &int64NetipAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int64NetipAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64NetipAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64NetipAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64NetipAddrMapValue) Type() string { return "map[int64]netip.Addr" }
+
+func (v *int64NetipAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64NetipAddrMapValue) clear() { *v.value = make(map[int64]netip.Addr) }
+
+func (v *int64NetipAddrMapValue) appendNext() {}
+
+func (v *int64NetipAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int64NetipAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintNetipAddrMapValue
+type uintNetipAddrMapValue struct {
+	value *map[uint]netip.Addr
+	sep   string
+}
+
+var _ RepeatableFlag = (*uintNetipAddrMapValue)(nil)
+var _ Value = (*uintNetipAddrMapValue)(nil)
+var _ Getter = (*uintNetipAddrMapValue)(nil)
+
+func newUintNetipAddrMapValue(m *map[uint]netip.Addr) *uintNetipAddrMapValue {
+	return &uintNetipAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uintNetipAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintNetipAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintNetipAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintNetipAddrMapValue) Type() string { return "map[uint]netip.Addr" }
+
+func (v *uintNetipAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintNetipAddrMapValue) clear() { *v.value = make(map[uint]netip.Addr) }
+
+func (v *uintNetipAddrMapValue) appendNext() {}
+
+func (v *uintNetipAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uintNetipAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8NetipAddrMapValue
+type uint8NetipAddrMapValue struct {
+	value *map[uint8]netip.Addr
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint8NetipAddrMapValue)(nil)
+var _ Value = (*uint8NetipAddrMapValue)(nil)
+var _ Getter = (*uint8NetipAddrMapValue)(nil)
+
+func newUint8NetipAddrMapValue(m *map[uint8]netip.Addr) *uint8NetipAddrMapValue {
+	return &uint8NetipAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8NetipAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8NetipAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8NetipAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8NetipAddrMapValue) Type() string { return "map[uint8]netip.Addr" }
+
+func (v *uint8NetipAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8NetipAddrMapValue) clear() { *v.value = make(map[uint8]netip.Addr) }
+
+func (v *uint8NetipAddrMapValue) appendNext() {}
+
+func (v *uint8NetipAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint8NetipAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16NetipAddrMapValue
+type uint16NetipAddrMapValue struct {
+	value *map[uint16]netip.Addr
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint16NetipAddrMapValue)(nil)
+var _ Value = (*uint16NetipAddrMapValue)(nil)
+var _ Getter = (*uint16NetipAddrMapValue)(nil)
+
+func newUint16NetipAddrMapValue(m *map[uint16]netip.Addr) *uint16NetipAddrMapValue {
+	return &uint16NetipAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16NetipAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16NetipAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16NetipAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16NetipAddrMapValue) Type() string { return "map[uint16]netip.Addr" }
+
+func (v *uint16NetipAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16NetipAddrMapValue) clear() { *v.value = make(map[uint16]netip.Addr) }
+
+func (v *uint16NetipAddrMapValue) appendNext() {}
+
+func (v *uint16NetipAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint16NetipAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32NetipAddrMapValue
+type uint32NetipAddrMapValue struct {
+	value *map[uint32]netip.Addr
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint32NetipAddrMapValue)(nil)
+var _ Value = (*uint32NetipAddrMapValue)(nil)
+var _ Getter = (*uint32NetipAddrMapValue)(nil)
+
+func newUint32NetipAddrMapValue(m *map[uint32]netip.Addr) *uint32NetipAddrMapValue {
+	return &uint32NetipAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32NetipAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32NetipAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32NetipAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32NetipAddrMapValue) Type() string { return "map[uint32]netip.Addr" }
+
+func (v *uint32NetipAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32NetipAddrMapValue) clear() { *v.value = make(map[uint32]netip.Addr) }
+
+func (v *uint32NetipAddrMapValue) appendNext() {}
+
+func (v *uint32NetipAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint32NetipAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64NetipAddrMapValue
+type uint64NetipAddrMapValue struct {
+	value *map[uint64]netip.Addr
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint64NetipAddrMapValue)(nil)
+var _ Value = (*uint64NetipAddrMapValue)(nil)
+var _ Getter = (*uint64NetipAddrMapValue)(nil)
+
+func newUint64NetipAddrMapValue(m *map[uint64]netip.Addr) *uint64NetipAddrMapValue {
+	return &uint64NetipAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64NetipAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64NetipAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64NetipAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64NetipAddrMapValue) Type() string { return "map[uint64]netip.Addr" }
+
+func (v *uint64NetipAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64NetipAddrMapValue) clear() { *v.value = make(map[uint64]netip.Addr) }
+
+func (v *uint64NetipAddrMapValue) appendNext() {}
+
+func (v *uint64NetipAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint64NetipAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- netip.Prefix Value
+type netipPrefixValue struct {
+	value *netip.Prefix
+}
+
+var _ Value = (*netipPrefixValue)(nil)
+var _ Getter = (*netipPrefixValue)(nil)
+
+func newNetipPrefixValue(p *netip.Prefix) *netipPrefixValue {
+	return &netipPrefixValue{value: p}
+}
+
+func (v *netipPrefixValue) Set(s string) error {
+	parsed, err := netip.ParsePrefix(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *netipPrefixValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *netipPrefixValue) String() string {
+	if v != nil && v.value != nil {
+		return formatValid(*v.value)
+	}
+	return ""
+}
+
+func (v *netipPrefixValue) Type() string { return "netipPrefix" }
+
+// -- netip.PrefixSlice Value
+
+type netipPrefixSliceValue struct {
+	value   *[]netip.Prefix
+	changed bool
+	sep     string
+}
+
+var _ RepeatableFlag = (*netipPrefixSliceValue)(nil)
+var _ Value = (*netipPrefixSliceValue)(nil)
+var _ Getter = (*netipPrefixSliceValue)(nil)
+
+func newNetipPrefixSliceValue(slice *[]netip.Prefix) *netipPrefixSliceValue {
+	return &netipPrefixSliceValue{
+		value: slice,
+	}
+}
+
+func (v *netipPrefixSliceValue) Set(raw string) error {
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
+
+	out := make([]netip.Prefix, len(ss))
+	for i, s := range ss {
+		parsed, err := netip.ParsePrefix(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *netipPrefixSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]netip.Prefix)(nil)
+}
+
+func (v *netipPrefixSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newNetipPrefixValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *netipPrefixSliceValue) Type() string { return "netipPrefixSlice" }
+
+func (v *netipPrefixSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *netipPrefixSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *netipPrefixSliceValue) clear() {
+	*v.value = []netip.Prefix{}
+	v.changed = true
+}
+
+func (v *netipPrefixSliceValue) appendNext() { v.changed = true }
+
+func (v *netipPrefixSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
+// -- stringNetipPrefixMapValue
+type stringNetipPrefixMapValue struct {
+	value *map[string]netip.Prefix
+	sep   string
+}
+
+var _ RepeatableFlag = (*stringNetipPrefixMapValue)(nil)
+var _ Value = (*stringNetipPrefixMapValue)(nil)
+var _ Getter = (*stringNetipPrefixMapValue)(nil)
+
+func newStringNetipPrefixMapValue(m *map[string]netip.Prefix) *stringNetipPrefixMapValue {
+	return &stringNetipPrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *stringNetipPrefixMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	key := s
+
+	s = rawVal
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringNetipPrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringNetipPrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringNetipPrefixMapValue) Type() string { return "map[string]netip.Prefix" }
+
+func (v *stringNetipPrefixMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringNetipPrefixMapValue) clear() { *v.value = make(map[string]netip.Prefix) }
+
+func (v *stringNetipPrefixMapValue) appendNext() {}
+
+func (v *stringNetipPrefixMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *stringNetipPrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intNetipPrefixMapValue
+type intNetipPrefixMapValue struct {
+	value *map[int]netip.Prefix
+	sep   string
+}
+
+var _ RepeatableFlag = (*intNetipPrefixMapValue)(nil)
+var _ Value = (*intNetipPrefixMapValue)(nil)
+var _ Getter = (*intNetipPrefixMapValue)(nil)
+
+func newIntNetipPrefixMapValue(m *map[int]netip.Prefix) *intNetipPrefixMapValue {
+	return &intNetipPrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *intNetipPrefixMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intNetipPrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intNetipPrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intNetipPrefixMapValue) Type() string { return "map[int]netip.Prefix" }
+
+func (v *intNetipPrefixMapValue) mapSeparator() string { return v.sep }
+
+func (v *intNetipPrefixMapValue) clear() { *v.value = make(map[int]netip.Prefix) }
+
+func (v *intNetipPrefixMapValue) appendNext() {}
+
+func (v *intNetipPrefixMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *intNetipPrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8NetipPrefixMapValue
+type int8NetipPrefixMapValue struct {
+	value *map[int8]netip.Prefix
+	sep   string
+}
+
+var _ RepeatableFlag = (*int8NetipPrefixMapValue)(nil)
+var _ Value = (*int8NetipPrefixMapValue)(nil)
+var _ Getter = (*int8NetipPrefixMapValue)(nil)
+
+func newInt8NetipPrefixMapValue(m *map[int8]netip.Prefix) *int8NetipPrefixMapValue {
+	return &int8NetipPrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *int8NetipPrefixMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8NetipPrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8NetipPrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8NetipPrefixMapValue) Type() string { return "map[int8]netip.Prefix" }
+
+func (v *int8NetipPrefixMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8NetipPrefixMapValue) clear() { *v.value = make(map[int8]netip.Prefix) }
+
+func (v *int8NetipPrefixMapValue) appendNext() {}
+
+func (v *int8NetipPrefixMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int8NetipPrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16NetipPrefixMapValue
+type int16NetipPrefixMapValue struct {
+	value *map[int16]netip.Prefix
+	sep   string
+}
+
+var _ RepeatableFlag = (*int16NetipPrefixMapValue)(nil)
+var _ Value = (*int16NetipPrefixMapValue)(nil)
+var _ Getter = (*int16NetipPrefixMapValue)(nil)
+
+func newInt16NetipPrefixMapValue(m *map[int16]netip.Prefix) *int16NetipPrefixMapValue {
+	return &int16NetipPrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *int16NetipPrefixMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16NetipPrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16NetipPrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16NetipPrefixMapValue) Type() string { return "map[int16]netip.Prefix" }
+
+func (v *int16NetipPrefixMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16NetipPrefixMapValue) clear() { *v.value = make(map[int16]netip.Prefix) }
+
+func (v *int16NetipPrefixMapValue) appendNext() {}
+
+func (v *int16NetipPrefixMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int16NetipPrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32NetipPrefixMapValue
+type int32NetipPrefixMapValue struct {
+	value *map[int32]netip.Prefix
+	sep   string
+}
+
+var _ RepeatableFlag = (*int32NetipPrefixMapValue)(nil)
+var _ Value = (*int32NetipPrefixMapValue)(nil)
+var _ Getter = (*int32NetipPrefixMapValue)(nil)
+
+func newInt32NetipPrefixMapValue(m *map[int32]netip.Prefix) *int32NetipPrefixMapValue {
+	return &int32NetipPrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *int32NetipPrefixMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32NetipPrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32NetipPrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32NetipPrefixMapValue) Type() string { return "map[int32]netip.Prefix" }
+
+func (v *int32NetipPrefixMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32NetipPrefixMapValue) clear() { *v.value = make(map[int32]netip.Prefix) }
+
+func (v *int32NetipPrefixMapValue) appendNext() {}
+
+func (v *int32NetipPrefixMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int32NetipPrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64NetipPrefixMapValue
+type int64NetipPrefixMapValue struct {
+	value *map[int64]netip.Prefix
+	sep   string
+}
+
+var _ RepeatableFlag = (*int64NetipPrefixMapValue)(nil)
+var _ Value = (*int64NetipPrefixMapValue)(nil)
+var _ Getter = (*int64NetipPrefixMapValue)(nil)
+
+func newInt64NetipPrefixMapValue(m *map[int64]netip.Prefix) *int64NetipPrefixMapValue {
+	return &int64NetipPrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *int64NetipPrefixMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64NetipPrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64NetipPrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64NetipPrefixMapValue) Type() string { return "map[int64]netip.Prefix" }
+
+func (v *int64NetipPrefixMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64NetipPrefixMapValue) clear() { *v.value = make(map[int64]netip.Prefix) }
+
+func (v *int64NetipPrefixMapValue) appendNext() {}
+
+func (v *int64NetipPrefixMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int64NetipPrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintNetipPrefixMapValue
+type uintNetipPrefixMapValue struct {
+	value *map[uint]netip.Prefix
+	sep   string
+}
+
+var _ RepeatableFlag = (*uintNetipPrefixMapValue)(nil)
+var _ Value = (*uintNetipPrefixMapValue)(nil)
+var _ Getter = (*uintNetipPrefixMapValue)(nil)
+
+func newUintNetipPrefixMapValue(m *map[uint]netip.Prefix) *uintNetipPrefixMapValue {
+	return &uintNetipPrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *uintNetipPrefixMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintNetipPrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintNetipPrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintNetipPrefixMapValue) Type() string { return "map[uint]netip.Prefix" }
+
+func (v *uintNetipPrefixMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintNetipPrefixMapValue) clear() { *v.value = make(map[uint]netip.Prefix) }
+
+func (v *uintNetipPrefixMapValue) appendNext() {}
+
+func (v *uintNetipPrefixMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uintNetipPrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8NetipPrefixMapValue
+type uint8NetipPrefixMapValue struct {
+	value *map[uint8]netip.Prefix
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint8NetipPrefixMapValue)(nil)
+var _ Value = (*uint8NetipPrefixMapValue)(nil)
+var _ Getter = (*uint8NetipPrefixMapValue)(nil)
+
+func newUint8NetipPrefixMapValue(m *map[uint8]netip.Prefix) *uint8NetipPrefixMapValue {
+	return &uint8NetipPrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8NetipPrefixMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8NetipPrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8NetipPrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8NetipPrefixMapValue) Type() string { return "map[uint8]netip.Prefix" }
+
+func (v *uint8NetipPrefixMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8NetipPrefixMapValue) clear() { *v.value = make(map[uint8]netip.Prefix) }
+
+func (v *uint8NetipPrefixMapValue) appendNext() {}
+
+func (v *uint8NetipPrefixMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint8NetipPrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16NetipPrefixMapValue
+type uint16NetipPrefixMapValue struct {
+	value *map[uint16]netip.Prefix
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint16NetipPrefixMapValue)(nil)
+var _ Value = (*uint16NetipPrefixMapValue)(nil)
+var _ Getter = (*uint16NetipPrefixMapValue)(nil)
+
+func newUint16NetipPrefixMapValue(m *map[uint16]netip.Prefix) *uint16NetipPrefixMapValue {
+	return &uint16NetipPrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16NetipPrefixMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16NetipPrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16NetipPrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16NetipPrefixMapValue) Type() string { return "map[uint16]netip.Prefix" }
+
+func (v *uint16NetipPrefixMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16NetipPrefixMapValue) clear() { *v.value = make(map[uint16]netip.Prefix) }
+
+func (v *uint16NetipPrefixMapValue) appendNext() {}
+
+func (v *uint16NetipPrefixMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint16NetipPrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32NetipPrefixMapValue
+type uint32NetipPrefixMapValue struct {
+	value *map[uint32]netip.Prefix
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint32NetipPrefixMapValue)(nil)
+var _ Value = (*uint32NetipPrefixMapValue)(nil)
+var _ Getter = (*uint32NetipPrefixMapValue)(nil)
+
+func newUint32NetipPrefixMapValue(m *map[uint32]netip.Prefix) *uint32NetipPrefixMapValue {
+	return &uint32NetipPrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32NetipPrefixMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32NetipPrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32NetipPrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32NetipPrefixMapValue) Type() string { return "map[uint32]netip.Prefix" }
+
+func (v *uint32NetipPrefixMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32NetipPrefixMapValue) clear() { *v.value = make(map[uint32]netip.Prefix) }
+
+func (v *uint32NetipPrefixMapValue) appendNext() {}
+
+func (v *uint32NetipPrefixMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint32NetipPrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64NetipPrefixMapValue
+type uint64NetipPrefixMapValue struct {
+	value *map[uint64]netip.Prefix
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint64NetipPrefixMapValue)(nil)
+var _ Value = (*uint64NetipPrefixMapValue)(nil)
+var _ Getter = (*uint64NetipPrefixMapValue)(nil)
+
+func newUint64NetipPrefixMapValue(m *map[uint64]netip.Prefix) *uint64NetipPrefixMapValue {
+	return &uint64NetipPrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64NetipPrefixMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64NetipPrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64NetipPrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64NetipPrefixMapValue) Type() string { return "map[uint64]netip.Prefix" }
+
+func (v *uint64NetipPrefixMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64NetipPrefixMapValue) clear() { *v.value = make(map[uint64]netip.Prefix) }
+
+func (v *uint64NetipPrefixMapValue) appendNext() {}
+
+func (v *uint64NetipPrefixMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint64NetipPrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- netip.AddrPort Value
+type netipAddrPortValue struct {
+	value *netip.AddrPort
+}
+
+var _ Value = (*netipAddrPortValue)(nil)
+var _ Getter = (*netipAddrPortValue)(nil)
+
+func newNetipAddrPortValue(p *netip.AddrPort) *netipAddrPortValue {
+	return &netipAddrPortValue{value: p}
+}
+
+func (v *netipAddrPortValue) Set(s string) error {
+	parsed, err := netip.ParseAddrPort(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *netipAddrPortValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *netipAddrPortValue) String() string {
+	if v != nil && v.value != nil {
+		return formatValid(*v.value)
+	}
+	return ""
+}
+
+func (v *netipAddrPortValue) Type() string { return "netipAddrPort" }
+
+// -- netip.AddrPortSlice Value
+
+type netipAddrPortSliceValue struct {
+	value   *[]netip.AddrPort
+	changed bool
+	sep     string
+}
+
+var _ RepeatableFlag = (*netipAddrPortSliceValue)(nil)
+var _ Value = (*netipAddrPortSliceValue)(nil)
+var _ Getter = (*netipAddrPortSliceValue)(nil)
+
+func newNetipAddrPortSliceValue(slice *[]netip.AddrPort) *netipAddrPortSliceValue {
+	return &netipAddrPortSliceValue{
+		value: slice,
+	}
+}
+
+func (v *netipAddrPortSliceValue) Set(raw string) error {
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
+
+	out := make([]netip.AddrPort, len(ss))
+	for i, s := range ss {
+		parsed, err := netip.ParseAddrPort(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *netipAddrPortSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]netip.AddrPort)(nil)
+}
+
+func (v *netipAddrPortSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newNetipAddrPortValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *netipAddrPortSliceValue) Type() string { return "netipAddrPortSlice" }
+
+func (v *netipAddrPortSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *netipAddrPortSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *netipAddrPortSliceValue) clear() {
+	*v.value = []netip.AddrPort{}
+	v.changed = true
+}
+
+func (v *netipAddrPortSliceValue) appendNext() { v.changed = true }
+
+func (v *netipAddrPortSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
+// -- stringNetipAddrPortMapValue
+type stringNetipAddrPortMapValue struct {
+	value *map[string]netip.AddrPort
+	sep   string
+}
+
+var _ RepeatableFlag = (*stringNetipAddrPortMapValue)(nil)
+var _ Value = (*stringNetipAddrPortMapValue)(nil)
+var _ Getter = (*stringNetipAddrPortMapValue)(nil)
+
+func newStringNetipAddrPortMapValue(m *map[string]netip.AddrPort) *stringNetipAddrPortMapValue {
+	return &stringNetipAddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *stringNetipAddrPortMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	key := s
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringNetipAddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringNetipAddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringNetipAddrPortMapValue) Type() string { return "map[string]netip.AddrPort" }
+
+func (v *stringNetipAddrPortMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringNetipAddrPortMapValue) clear() { *v.value = make(map[string]netip.AddrPort) }
+
+func (v *stringNetipAddrPortMapValue) appendNext() {}
+
+func (v *stringNetipAddrPortMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *stringNetipAddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intNetipAddrPortMapValue
+type intNetipAddrPortMapValue struct {
+	value *map[int]netip.AddrPort
+	sep   string
+}
+
+var _ RepeatableFlag = (*intNetipAddrPortMapValue)(nil)
+var _ Value = (*intNetipAddrPortMapValue)(nil)
+var _ Getter = (*intNetipAddrPortMapValue)(nil)
+
+func newIntNetipAddrPortMapValue(m *map[int]netip.AddrPort) *intNetipAddrPortMapValue {
+	return &intNetipAddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *intNetipAddrPortMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intNetipAddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intNetipAddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intNetipAddrPortMapValue) Type() string { return "map[int]netip.AddrPort" }
+
+func (v *intNetipAddrPortMapValue) mapSeparator() string { return v.sep }
+
+func (v *intNetipAddrPortMapValue) clear() { *v.value = make(map[int]netip.AddrPort) }
+
+func (v *intNetipAddrPortMapValue) appendNext() {}
+
+func (v *intNetipAddrPortMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *intNetipAddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8NetipAddrPortMapValue
+type int8NetipAddrPortMapValue struct {
+	value *map[int8]netip.AddrPort
+	sep   string
+}
+
+var _ RepeatableFlag = (*int8NetipAddrPortMapValue)(nil)
+var _ Value = (*int8NetipAddrPortMapValue)(nil)
+var _ Getter = (*int8NetipAddrPortMapValue)(nil)
+
+func newInt8NetipAddrPortMapValue(m *map[int8]netip.AddrPort) *int8NetipAddrPortMapValue {
+	return &int8NetipAddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *int8NetipAddrPortMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8NetipAddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8NetipAddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8NetipAddrPortMapValue) Type() string { return "map[int8]netip.AddrPort" }
+
+func (v *int8NetipAddrPortMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8NetipAddrPortMapValue) clear() { *v.value = make(map[int8]netip.AddrPort) }
+
+func (v *int8NetipAddrPortMapValue) appendNext() {}
+
+func (v *int8NetipAddrPortMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int8NetipAddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16NetipAddrPortMapValue
+type int16NetipAddrPortMapValue struct {
+	value *map[int16]netip.AddrPort
+	sep   string
+}
+
+var _ RepeatableFlag = (*int16NetipAddrPortMapValue)(nil)
+var _ Value = (*int16NetipAddrPortMapValue)(nil)
+var _ Getter = (*int16NetipAddrPortMapValue)(nil)
+
+func newInt16NetipAddrPortMapValue(m *map[int16]netip.AddrPort) *int16NetipAddrPortMapValue {
+	return &int16NetipAddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *int16NetipAddrPortMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16NetipAddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16NetipAddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16NetipAddrPortMapValue) Type() string { return "map[int16]netip.AddrPort" }
+
+func (v *int16NetipAddrPortMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16NetipAddrPortMapValue) clear() { *v.value = make(map[int16]netip.AddrPort) }
+
+func (v *int16NetipAddrPortMapValue) appendNext() {}
+
+func (v *int16NetipAddrPortMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int16NetipAddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32NetipAddrPortMapValue
+type int32NetipAddrPortMapValue struct {
+	value *map[int32]netip.AddrPort
+	sep   string
+}
+
+var _ RepeatableFlag = (*int32NetipAddrPortMapValue)(nil)
+var _ Value = (*int32NetipAddrPortMapValue)(nil)
+var _ Getter = (*int32NetipAddrPortMapValue)(nil)
+
+func newInt32NetipAddrPortMapValue(m *map[int32]netip.AddrPort) *int32NetipAddrPortMapValue {
+	return &int32NetipAddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *int32NetipAddrPortMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32NetipAddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32NetipAddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32NetipAddrPortMapValue) Type() string { return "map[int32]netip.AddrPort" }
+
+func (v *int32NetipAddrPortMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32NetipAddrPortMapValue) clear() { *v.value = make(map[int32]netip.AddrPort) }
+
+func (v *int32NetipAddrPortMapValue) appendNext() {}
+
+func (v *int32NetipAddrPortMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int32NetipAddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64NetipAddrPortMapValue
+type int64NetipAddrPortMapValue struct {
+	value *map[int64]netip.AddrPort
+	sep   string
+}
+
+var _ RepeatableFlag = (*int64NetipAddrPortMapValue)(nil)
+var _ Value = (*int64NetipAddrPortMapValue)(nil)
+var _ Getter = (*int64NetipAddrPortMapValue)(nil)
+
+func newInt64NetipAddrPortMapValue(m *map[int64]netip.AddrPort) *int64NetipAddrPortMapValue {
+	return &int64NetipAddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *int64NetipAddrPortMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64NetipAddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64NetipAddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64NetipAddrPortMapValue) Type() string { return "map[int64]netip.AddrPort" }
+
+func (v *int64NetipAddrPortMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64NetipAddrPortMapValue) clear() { *v.value = make(map[int64]netip.AddrPort) }
+
+func (v *int64NetipAddrPortMapValue) appendNext() {}
+
+func (v *int64NetipAddrPortMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int64NetipAddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintNetipAddrPortMapValue
+type uintNetipAddrPortMapValue struct {
+	value *map[uint]netip.AddrPort
+	sep   string
+}
+
+var _ RepeatableFlag = (*uintNetipAddrPortMapValue)(nil)
+var _ Value = (*uintNetipAddrPortMapValue)(nil)
+var _ Getter = (*uintNetipAddrPortMapValue)(nil)
+
+func newUintNetipAddrPortMapValue(m *map[uint]netip.AddrPort) *uintNetipAddrPortMapValue {
+	return &uintNetipAddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *uintNetipAddrPortMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintNetipAddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintNetipAddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintNetipAddrPortMapValue) Type() string { return "map[uint]netip.AddrPort" }
+
+func (v *uintNetipAddrPortMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintNetipAddrPortMapValue) clear() { *v.value = make(map[uint]netip.AddrPort) }
+
+func (v *uintNetipAddrPortMapValue) appendNext() {}
+
+func (v *uintNetipAddrPortMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uintNetipAddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8NetipAddrPortMapValue
+type uint8NetipAddrPortMapValue struct {
+	value *map[uint8]netip.AddrPort
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint8NetipAddrPortMapValue)(nil)
+var _ Value = (*uint8NetipAddrPortMapValue)(nil)
+var _ Getter = (*uint8NetipAddrPortMapValue)(nil)
+
+func newUint8NetipAddrPortMapValue(m *map[uint8]netip.AddrPort) *uint8NetipAddrPortMapValue {
+	return &uint8NetipAddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8NetipAddrPortMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8NetipAddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8NetipAddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8NetipAddrPortMapValue) Type() string { return "map[uint8]netip.AddrPort" }
+
+func (v *uint8NetipAddrPortMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8NetipAddrPortMapValue) clear() { *v.value = make(map[uint8]netip.AddrPort) }
+
+func (v *uint8NetipAddrPortMapValue) appendNext() {}
+
+func (v *uint8NetipAddrPortMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint8NetipAddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16NetipAddrPortMapValue
+type uint16NetipAddrPortMapValue struct {
+	value *map[uint16]netip.AddrPort
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint16NetipAddrPortMapValue)(nil)
+var _ Value = (*uint16NetipAddrPortMapValue)(nil)
+var _ Getter = (*uint16NetipAddrPortMapValue)(nil)
+
+func newUint16NetipAddrPortMapValue(m *map[uint16]netip.AddrPort) *uint16NetipAddrPortMapValue {
+	return &uint16NetipAddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16NetipAddrPortMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16NetipAddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16NetipAddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16NetipAddrPortMapValue) Type() string { return "map[uint16]netip.AddrPort" }
+
+func (v *uint16NetipAddrPortMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16NetipAddrPortMapValue) clear() { *v.value = make(map[uint16]netip.AddrPort) }
+
+func (v *uint16NetipAddrPortMapValue) appendNext() {}
+
+func (v *uint16NetipAddrPortMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint16NetipAddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32NetipAddrPortMapValue
+type uint32NetipAddrPortMapValue struct {
+	value *map[uint32]netip.AddrPort
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint32NetipAddrPortMapValue)(nil)
+var _ Value = (*uint32NetipAddrPortMapValue)(nil)
+var _ Getter = (*uint32NetipAddrPortMapValue)(nil)
+
+func newUint32NetipAddrPortMapValue(m *map[uint32]netip.AddrPort) *uint32NetipAddrPortMapValue {
+	return &uint32NetipAddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32NetipAddrPortMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32NetipAddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32NetipAddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32NetipAddrPortMapValue) Type() string { return "map[uint32]netip.AddrPort" }
+
+func (v *uint32NetipAddrPortMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32NetipAddrPortMapValue) clear() { *v.value = make(map[uint32]netip.AddrPort) }
+
+func (v *uint32NetipAddrPortMapValue) appendNext() {}
+
+func (v *uint32NetipAddrPortMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint32NetipAddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64NetipAddrPortMapValue
+type uint64NetipAddrPortMapValue struct {
+	value *map[uint64]netip.AddrPort
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint64NetipAddrPortMapValue)(nil)
+var _ Value = (*uint64NetipAddrPortMapValue)(nil)
+var _ Getter = (*uint64NetipAddrPortMapValue)(nil)
+
+func newUint64NetipAddrPortMapValue(m *map[uint64]netip.AddrPort) *uint64NetipAddrPortMapValue {
+	return &uint64NetipAddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64NetipAddrPortMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64NetipAddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64NetipAddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64NetipAddrPortMapValue) Type() string { return "map[uint64]netip.AddrPort" }
+
+func (v *uint64NetipAddrPortMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64NetipAddrPortMapValue) clear() { *v.value = make(map[uint64]netip.AddrPort) }
+
+func (v *uint64NetipAddrPortMapValue) appendNext() {}
+
+func (v *uint64NetipAddrPortMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint64NetipAddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- net.UDPAddr Value
+type udpAddrValue struct {
+	value *net.UDPAddr
+}
+
+var _ Value = (*udpAddrValue)(nil)
+var _ Getter = (*udpAddrValue)(nil)
+
+func newUDPAddrValue(p *net.UDPAddr) *udpAddrValue {
+	return &udpAddrValue{value: p}
+}
+
+func (v *udpAddrValue) Set(s string) error {
+	parsed, err := parseUDPAddr(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *udpAddrValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *udpAddrValue) String() string {
+	if v != nil && v.value != nil {
+		return v.value.String()
+	}
+	return ""
+}
+
+func (v *udpAddrValue) Type() string { return "udpAddr" }
+
+// -- net.UDPAddrSlice Value
+
+type udpAddrSliceValue struct {
+	value   *[]net.UDPAddr
+	changed bool
+	sep     string
+}
+
+var _ RepeatableFlag = (*udpAddrSliceValue)(nil)
+var _ Value = (*udpAddrSliceValue)(nil)
+var _ Getter = (*udpAddrSliceValue)(nil)
+
+func newUDPAddrSliceValue(slice *[]net.UDPAddr) *udpAddrSliceValue {
+	return &udpAddrSliceValue{
+		value: slice,
+	}
+}
+
+func (v *udpAddrSliceValue) Set(raw string) error {
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
+
+	out := make([]net.UDPAddr, len(ss))
+	for i, s := range ss {
+		parsed, err := parseUDPAddr(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *udpAddrSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]net.UDPAddr)(nil)
+}
+
+func (v *udpAddrSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newUDPAddrValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *udpAddrSliceValue) Type() string { return "udpAddrSlice" }
+
+func (v *udpAddrSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *udpAddrSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *udpAddrSliceValue) clear() {
+	*v.value = []net.UDPAddr{}
+	v.changed = true
+}
+
+func (v *udpAddrSliceValue) appendNext() { v.changed = true }
+
+func (v *udpAddrSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
+// -- stringUDPAddrMapValue
+type stringUDPAddrMapValue struct {
+	value *map[string]net.UDPAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*stringUDPAddrMapValue)(nil)
+var _ Value = (*stringUDPAddrMapValue)(nil)
+var _ Getter = (*stringUDPAddrMapValue)(nil)
+
+func newStringUDPAddrMapValue(m *map[string]net.UDPAddr) *stringUDPAddrMapValue {
+	return &stringUDPAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *stringUDPAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	key := s
+
+	s = rawVal
+
+	parsedVal, err := parseUDPAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringUDPAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringUDPAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringUDPAddrMapValue) Type() string { return "map[string]net.UDPAddr" }
+
+func (v *stringUDPAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringUDPAddrMapValue) clear() { *v.value = make(map[string]net.UDPAddr) }
+
+func (v *stringUDPAddrMapValue) appendNext() {}
+
+func (v *stringUDPAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *stringUDPAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intUDPAddrMapValue
+type intUDPAddrMapValue struct {
+	value *map[int]net.UDPAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*intUDPAddrMapValue)(nil)
+var _ Value = (*intUDPAddrMapValue)(nil)
+var _ Getter = (*intUDPAddrMapValue)(nil)
+
+func newIntUDPAddrMapValue(m *map[int]net.UDPAddr) *intUDPAddrMapValue {
+	return &intUDPAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *intUDPAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseUDPAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intUDPAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intUDPAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intUDPAddrMapValue) Type() string { return "map[int]net.UDPAddr" }
+
+func (v *intUDPAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *intUDPAddrMapValue) clear() { *v.value = make(map[int]net.UDPAddr) }
+
+func (v *intUDPAddrMapValue) appendNext() {}
+
+func (v *intUDPAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *intUDPAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8UDPAddrMapValue
+type int8UDPAddrMapValue struct {
+	value *map[int8]net.UDPAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*int8UDPAddrMapValue)(nil)
+var _ Value = (*int8UDPAddrMapValue)(nil)
+var _ Getter = (*int8UDPAddrMapValue)(nil)
+
+func newInt8UDPAddrMapValue(m *map[int8]net.UDPAddr) *int8UDPAddrMapValue {
+	return &int8UDPAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int8UDPAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseUDPAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8UDPAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8UDPAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8UDPAddrMapValue) Type() string { return "map[int8]net.UDPAddr" }
+
+func (v *int8UDPAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8UDPAddrMapValue) clear() { *v.value = make(map[int8]net.UDPAddr) }
+
+func (v *int8UDPAddrMapValue) appendNext() {}
+
+func (v *int8UDPAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int8UDPAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16UDPAddrMapValue
+type int16UDPAddrMapValue struct {
+	value *map[int16]net.UDPAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*int16UDPAddrMapValue)(nil)
+var _ Value = (*int16UDPAddrMapValue)(nil)
+var _ Getter = (*int16UDPAddrMapValue)(nil)
+
+func newInt16UDPAddrMapValue(m *map[int16]net.UDPAddr) *int16UDPAddrMapValue {
+	return &int16UDPAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int16UDPAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseUDPAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16UDPAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16UDPAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16UDPAddrMapValue) Type() string { return "map[int16]net.UDPAddr" }
+
+func (v *int16UDPAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16UDPAddrMapValue) clear() { *v.value = make(map[int16]net.UDPAddr) }
+
+func (v *int16UDPAddrMapValue) appendNext() {}
+
+func (v *int16UDPAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int16UDPAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32UDPAddrMapValue
+type int32UDPAddrMapValue struct {
+	value *map[int32]net.UDPAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*int32UDPAddrMapValue)(nil)
+var _ Value = (*int32UDPAddrMapValue)(nil)
+var _ Getter = (*int32UDPAddrMapValue)(nil)
+
+func newInt32UDPAddrMapValue(m *map[int32]net.UDPAddr) *int32UDPAddrMapValue {
+	return &int32UDPAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int32UDPAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseUDPAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32UDPAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32UDPAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32UDPAddrMapValue) Type() string { return "map[int32]net.UDPAddr" }
+
+func (v *int32UDPAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32UDPAddrMapValue) clear() { *v.value = make(map[int32]net.UDPAddr) }
+
+func (v *int32UDPAddrMapValue) appendNext() {}
+
+func (v *int32UDPAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int32UDPAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64UDPAddrMapValue
+type int64UDPAddrMapValue struct {
+	value *map[int64]net.UDPAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*int64UDPAddrMapValue)(nil)
+var _ Value = (*int64UDPAddrMapValue)(nil)
+var _ Getter = (*int64UDPAddrMapValue)(nil)
+
+func newInt64UDPAddrMapValue(m *map[int64]net.UDPAddr) *int64UDPAddrMapValue {
+	return &int64UDPAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int64UDPAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := parseUDPAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64UDPAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64UDPAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64UDPAddrMapValue) Type() string { return "map[int64]net.UDPAddr" }
+
+func (v *int64UDPAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64UDPAddrMapValue) clear() { *v.value = make(map[int64]net.UDPAddr) }
+
+func (v *int64UDPAddrMapValue) appendNext() {}
+
+func (v *int64UDPAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int64UDPAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintUDPAddrMapValue
+type uintUDPAddrMapValue struct {
+	value *map[uint]net.UDPAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*uintUDPAddrMapValue)(nil)
+var _ Value = (*uintUDPAddrMapValue)(nil)
+var _ Getter = (*uintUDPAddrMapValue)(nil)
+
+func newUintUDPAddrMapValue(m *map[uint]net.UDPAddr) *uintUDPAddrMapValue {
+	return &uintUDPAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uintUDPAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseUDPAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintUDPAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintUDPAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintUDPAddrMapValue) Type() string { return "map[uint]net.UDPAddr" }
+
+func (v *uintUDPAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintUDPAddrMapValue) clear() { *v.value = make(map[uint]net.UDPAddr) }
+
+func (v *uintUDPAddrMapValue) appendNext() {}
+
+func (v *uintUDPAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uintUDPAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8UDPAddrMapValue
+type uint8UDPAddrMapValue struct {
+	value *map[uint8]net.UDPAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint8UDPAddrMapValue)(nil)
+var _ Value = (*uint8UDPAddrMapValue)(nil)
+var _ Getter = (*uint8UDPAddrMapValue)(nil)
+
+func newUint8UDPAddrMapValue(m *map[uint8]net.UDPAddr) *uint8UDPAddrMapValue {
+	return &uint8UDPAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8UDPAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseUDPAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8UDPAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8UDPAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8UDPAddrMapValue) Type() string { return "map[uint8]net.UDPAddr" }
+
+func (v *uint8UDPAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8UDPAddrMapValue) clear() { *v.value = make(map[uint8]net.UDPAddr) }
+
+func (v *uint8UDPAddrMapValue) appendNext() {}
+
+func (v *uint8UDPAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint8UDPAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16UDPAddrMapValue
+type uint16UDPAddrMapValue struct {
+	value *map[uint16]net.UDPAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint16UDPAddrMapValue)(nil)
+var _ Value = (*uint16UDPAddrMapValue)(nil)
+var _ Getter = (*uint16UDPAddrMapValue)(nil)
+
+func newUint16UDPAddrMapValue(m *map[uint16]net.UDPAddr) *uint16UDPAddrMapValue {
+	return &uint16UDPAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16UDPAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseUDPAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16UDPAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16UDPAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16UDPAddrMapValue) Type() string { return "map[uint16]net.UDPAddr" }
+
+func (v *uint16UDPAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16UDPAddrMapValue) clear() { *v.value = make(map[uint16]net.UDPAddr) }
+
+func (v *uint16UDPAddrMapValue) appendNext() {}
+
+func (v *uint16UDPAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint16UDPAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32UDPAddrMapValue
+type uint32UDPAddrMapValue struct {
+	value *map[uint32]net.UDPAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint32UDPAddrMapValue)(nil)
+var _ Value = (*uint32UDPAddrMapValue)(nil)
+var _ Getter = (*uint32UDPAddrMapValue)(nil)
+
+func newUint32UDPAddrMapValue(m *map[uint32]net.UDPAddr) *uint32UDPAddrMapValue {
+	return &uint32UDPAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32UDPAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseUDPAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32UDPAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32UDPAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32UDPAddrMapValue) Type() string { return "map[uint32]net.UDPAddr" }
+
+func (v *uint32UDPAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32UDPAddrMapValue) clear() { *v.value = make(map[uint32]net.UDPAddr) }
+
+func (v *uint32UDPAddrMapValue) appendNext() {}
+
+func (v *uint32UDPAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint32UDPAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64UDPAddrMapValue
+type uint64UDPAddrMapValue struct {
+	value *map[uint64]net.UDPAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint64UDPAddrMapValue)(nil)
+var _ Value = (*uint64UDPAddrMapValue)(nil)
+var _ Getter = (*uint64UDPAddrMapValue)(nil)
+
+func newUint64UDPAddrMapValue(m *map[uint64]net.UDPAddr) *uint64UDPAddrMapValue {
+	return &uint64UDPAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64UDPAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := parseUDPAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64UDPAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64UDPAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64UDPAddrMapValue) Type() string { return "map[uint64]net.UDPAddr" }
+
+func (v *uint64UDPAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64UDPAddrMapValue) clear() { *v.value = make(map[uint64]net.UDPAddr) }
+
+func (v *uint64UDPAddrMapValue) appendNext() {}
+
+func (v *uint64UDPAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint64UDPAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- net.HardwareAddr Value
+type hardwareAddrValue struct {
+	value *net.HardwareAddr
+}
+
+var _ Value = (*hardwareAddrValue)(nil)
+var _ Getter = (*hardwareAddrValue)(nil)
+
+func newHardwareAddrValue(p *net.HardwareAddr) *hardwareAddrValue {
+	return &hardwareAddrValue{value: p}
+}
+
+func (v *hardwareAddrValue) Set(s string) error {
+	parsed, err := net.ParseMAC(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *hardwareAddrValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *hardwareAddrValue) String() string {
+	if v != nil && v.value != nil {
+		return v.value.String()
+	}
+	return ""
+}
+
+func (v *hardwareAddrValue) Type() string { return "hardwareAddr" }
+
+// -- net.HardwareAddrSlice Value
+
+type hardwareAddrSliceValue struct {
+	value   *[]net.HardwareAddr
+	changed bool
+	sep     string
+}
+
+var _ RepeatableFlag = (*hardwareAddrSliceValue)(nil)
+var _ Value = (*hardwareAddrSliceValue)(nil)
+var _ Getter = (*hardwareAddrSliceValue)(nil)
+
+func newHardwareAddrSliceValue(slice *[]net.HardwareAddr) *hardwareAddrSliceValue {
+	return &hardwareAddrSliceValue{
+		value: slice,
+	}
+}
+
+func (v *hardwareAddrSliceValue) Set(raw string) error {
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
+
+	out := make([]net.HardwareAddr, len(ss))
+	for i, s := range ss {
+		parsed, err := net.ParseMAC(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *hardwareAddrSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]net.HardwareAddr)(nil)
+}
+
+func (v *hardwareAddrSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newHardwareAddrValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *hardwareAddrSliceValue) Type() string { return "hardwareAddrSlice" }
+
+func (v *hardwareAddrSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *hardwareAddrSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *hardwareAddrSliceValue) clear() {
+	*v.value = []net.HardwareAddr{}
+	v.changed = true
+}
+
+func (v *hardwareAddrSliceValue) appendNext() { v.changed = true }
+
+func (v *hardwareAddrSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
+// -- stringHardwareAddrMapValue
+type stringHardwareAddrMapValue struct {
+	value *map[string]net.HardwareAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*stringHardwareAddrMapValue)(nil)
+var _ Value = (*stringHardwareAddrMapValue)(nil)
+var _ Getter = (*stringHardwareAddrMapValue)(nil)
+
+func newStringHardwareAddrMapValue(m *map[string]net.HardwareAddr) *stringHardwareAddrMapValue {
+	return &stringHardwareAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *stringHardwareAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	key := s
+
+	s = rawVal
+
+	parsedVal, err := net.ParseMAC(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringHardwareAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringHardwareAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringHardwareAddrMapValue) Type() string { return "map[string]net.HardwareAddr" }
+
+func (v *stringHardwareAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringHardwareAddrMapValue) clear() { *v.value = make(map[string]net.HardwareAddr) }
+
+func (v *stringHardwareAddrMapValue) appendNext() {}
+
+func (v *stringHardwareAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *stringHardwareAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intHardwareAddrMapValue
+type intHardwareAddrMapValue struct {
+	value *map[int]net.HardwareAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*intHardwareAddrMapValue)(nil)
+var _ Value = (*intHardwareAddrMapValue)(nil)
+var _ Getter = (*intHardwareAddrMapValue)(nil)
+
+func newIntHardwareAddrMapValue(m *map[int]net.HardwareAddr) *intHardwareAddrMapValue {
+	return &intHardwareAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *intHardwareAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := net.ParseMAC(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intHardwareAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intHardwareAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intHardwareAddrMapValue) Type() string { return "map[int]net.HardwareAddr" }
+
+func (v *intHardwareAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *intHardwareAddrMapValue) clear() { *v.value = make(map[int]net.HardwareAddr) }
+
+func (v *intHardwareAddrMapValue) appendNext() {}
+
+func (v *intHardwareAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *intHardwareAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8HardwareAddrMapValue
+type int8HardwareAddrMapValue struct {
+	value *map[int8]net.HardwareAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*int8HardwareAddrMapValue)(nil)
+var _ Value = (*int8HardwareAddrMapValue)(nil)
+var _ Getter = (*int8HardwareAddrMapValue)(nil)
+
+func newInt8HardwareAddrMapValue(m *map[int8]net.HardwareAddr) *int8HardwareAddrMapValue {
+	return &int8HardwareAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int8HardwareAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := net.ParseMAC(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8HardwareAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8HardwareAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8HardwareAddrMapValue) Type() string { return "map[int8]net.HardwareAddr" }
+
+func (v *int8HardwareAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8HardwareAddrMapValue) clear() { *v.value = make(map[int8]net.HardwareAddr) }
+
+func (v *int8HardwareAddrMapValue) appendNext() {}
+
+func (v *int8HardwareAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int8HardwareAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16HardwareAddrMapValue
+type int16HardwareAddrMapValue struct {
+	value *map[int16]net.HardwareAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*int16HardwareAddrMapValue)(nil)
+var _ Value = (*int16HardwareAddrMapValue)(nil)
+var _ Getter = (*int16HardwareAddrMapValue)(nil)
+
+func newInt16HardwareAddrMapValue(m *map[int16]net.HardwareAddr) *int16HardwareAddrMapValue {
+	return &int16HardwareAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int16HardwareAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := net.ParseMAC(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16HardwareAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16HardwareAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16HardwareAddrMapValue) Type() string { return "map[int16]net.HardwareAddr" }
+
+func (v *int16HardwareAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16HardwareAddrMapValue) clear() { *v.value = make(map[int16]net.HardwareAddr) }
+
+func (v *int16HardwareAddrMapValue) appendNext() {}
+
+func (v *int16HardwareAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int16HardwareAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32HardwareAddrMapValue
+type int32HardwareAddrMapValue struct {
+	value *map[int32]net.HardwareAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*int32HardwareAddrMapValue)(nil)
+var _ Value = (*int32HardwareAddrMapValue)(nil)
+var _ Getter = (*int32HardwareAddrMapValue)(nil)
+
+func newInt32HardwareAddrMapValue(m *map[int32]net.HardwareAddr) *int32HardwareAddrMapValue {
+	return &int32HardwareAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int32HardwareAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := net.ParseMAC(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32HardwareAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32HardwareAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32HardwareAddrMapValue) Type() string { return "map[int32]net.HardwareAddr" }
+
+func (v *int32HardwareAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32HardwareAddrMapValue) clear() { *v.value = make(map[int32]net.HardwareAddr) }
+
+func (v *int32HardwareAddrMapValue) appendNext() {}
+
+func (v *int32HardwareAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int32HardwareAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64HardwareAddrMapValue
+type int64HardwareAddrMapValue struct {
+	value *map[int64]net.HardwareAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*int64HardwareAddrMapValue)(nil)
+var _ Value = (*int64HardwareAddrMapValue)(nil)
+var _ Getter = (*int64HardwareAddrMapValue)(nil)
+
+func newInt64HardwareAddrMapValue(m *map[int64]net.HardwareAddr) *int64HardwareAddrMapValue {
+	return &int64HardwareAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int64HardwareAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := net.ParseMAC(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64HardwareAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64HardwareAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64HardwareAddrMapValue) Type() string { return "map[int64]net.HardwareAddr" }
+
+func (v *int64HardwareAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64HardwareAddrMapValue) clear() { *v.value = make(map[int64]net.HardwareAddr) }
+
+func (v *int64HardwareAddrMapValue) appendNext() {}
+
+func (v *int64HardwareAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int64HardwareAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintHardwareAddrMapValue
+type uintHardwareAddrMapValue struct {
+	value *map[uint]net.HardwareAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*uintHardwareAddrMapValue)(nil)
+var _ Value = (*uintHardwareAddrMapValue)(nil)
+var _ Getter = (*uintHardwareAddrMapValue)(nil)
+
+func newUintHardwareAddrMapValue(m *map[uint]net.HardwareAddr) *uintHardwareAddrMapValue {
+	return &uintHardwareAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uintHardwareAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := net.ParseMAC(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintHardwareAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintHardwareAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintHardwareAddrMapValue) Type() string { return "map[uint]net.HardwareAddr" }
+
+func (v *uintHardwareAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintHardwareAddrMapValue) clear() { *v.value = make(map[uint]net.HardwareAddr) }
+
+func (v *uintHardwareAddrMapValue) appendNext() {}
+
+func (v *uintHardwareAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uintHardwareAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8HardwareAddrMapValue
+type uint8HardwareAddrMapValue struct {
+	value *map[uint8]net.HardwareAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint8HardwareAddrMapValue)(nil)
+var _ Value = (*uint8HardwareAddrMapValue)(nil)
+var _ Getter = (*uint8HardwareAddrMapValue)(nil)
+
+func newUint8HardwareAddrMapValue(m *map[uint8]net.HardwareAddr) *uint8HardwareAddrMapValue {
+	return &uint8HardwareAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8HardwareAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := net.ParseMAC(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8HardwareAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8HardwareAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8HardwareAddrMapValue) Type() string { return "map[uint8]net.HardwareAddr" }
+
+func (v *uint8HardwareAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8HardwareAddrMapValue) clear() { *v.value = make(map[uint8]net.HardwareAddr) }
+
+func (v *uint8HardwareAddrMapValue) appendNext() {}
+
+func (v *uint8HardwareAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint8HardwareAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16HardwareAddrMapValue
+type uint16HardwareAddrMapValue struct {
+	value *map[uint16]net.HardwareAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint16HardwareAddrMapValue)(nil)
+var _ Value = (*uint16HardwareAddrMapValue)(nil)
+var _ Getter = (*uint16HardwareAddrMapValue)(nil)
+
+func newUint16HardwareAddrMapValue(m *map[uint16]net.HardwareAddr) *uint16HardwareAddrMapValue {
+	return &uint16HardwareAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16HardwareAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := net.ParseMAC(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16HardwareAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16HardwareAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16HardwareAddrMapValue) Type() string { return "map[uint16]net.HardwareAddr" }
+
+func (v *uint16HardwareAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16HardwareAddrMapValue) clear() { *v.value = make(map[uint16]net.HardwareAddr) }
+
+func (v *uint16HardwareAddrMapValue) appendNext() {}
+
+func (v *uint16HardwareAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint16HardwareAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32HardwareAddrMapValue
+type uint32HardwareAddrMapValue struct {
+	value *map[uint32]net.HardwareAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint32HardwareAddrMapValue)(nil)
+var _ Value = (*uint32HardwareAddrMapValue)(nil)
+var _ Getter = (*uint32HardwareAddrMapValue)(nil)
+
+func newUint32HardwareAddrMapValue(m *map[uint32]net.HardwareAddr) *uint32HardwareAddrMapValue {
+	return &uint32HardwareAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32HardwareAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := net.ParseMAC(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32HardwareAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32HardwareAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32HardwareAddrMapValue) Type() string { return "map[uint32]net.HardwareAddr" }
+
+func (v *uint32HardwareAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32HardwareAddrMapValue) clear() { *v.value = make(map[uint32]net.HardwareAddr) }
+
+func (v *uint32HardwareAddrMapValue) appendNext() {}
+
+func (v *uint32HardwareAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint32HardwareAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64HardwareAddrMapValue
+type uint64HardwareAddrMapValue struct {
+	value *map[uint64]net.HardwareAddr
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint64HardwareAddrMapValue)(nil)
+var _ Value = (*uint64HardwareAddrMapValue)(nil)
+var _ Getter = (*uint64HardwareAddrMapValue)(nil)
+
+func newUint64HardwareAddrMapValue(m *map[uint64]net.HardwareAddr) *uint64HardwareAddrMapValue {
+	return &uint64HardwareAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64HardwareAddrMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := net.ParseMAC(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64HardwareAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64HardwareAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64HardwareAddrMapValue) Type() string { return "map[uint64]net.HardwareAddr" }
+
+func (v *uint64HardwareAddrMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64HardwareAddrMapValue) clear() { *v.value = make(map[uint64]net.HardwareAddr) }
+
+func (v *uint64HardwareAddrMapValue) appendNext() {}
+
+func (v *uint64HardwareAddrMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint64HardwareAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- *big.Int Value
+type bigIntValue struct {
+	value **big.Int
+}
+
+var _ Value = (*bigIntValue)(nil)
+var _ Getter = (*bigIntValue)(nil)
+
+func newBigIntValue(p **big.Int) *bigIntValue {
+	return &bigIntValue{value: p}
+}
+
+func (v *bigIntValue) Set(s string) error {
+	parsed, err := parseBigInt(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *bigIntValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *bigIntValue) String() string {
+	if v != nil && v.value != nil {
+		return (*v.value).String()
+	}
+	return ""
+}
+
+func (v *bigIntValue) Type() string { return "bigInt" }
+
+// -- *big.IntSlice Value
+
+type bigIntSliceValue struct {
+	value   *[]*big.Int
+	changed bool
+	sep     string
+}
+
+var _ RepeatableFlag = (*bigIntSliceValue)(nil)
+var _ Value = (*bigIntSliceValue)(nil)
+var _ Getter = (*bigIntSliceValue)(nil)
+
+func newBigIntSliceValue(slice *[]*big.Int) *bigIntSliceValue {
+	return &bigIntSliceValue{
+		value: slice,
+	}
+}
+
+func (v *bigIntSliceValue) Set(raw string) error {
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
+
+	out := make([]*big.Int, len(ss))
+	for i, s := range ss {
+		parsed, err := parseBigInt(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *bigIntSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]*big.Int)(nil)
+}
+
+func (v *bigIntSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newBigIntValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *bigIntSliceValue) Type() string { return "bigIntSlice" }
+
+func (v *bigIntSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *bigIntSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *bigIntSliceValue) clear() {
+	*v.value = []*big.Int{}
+	v.changed = true
+}
+
+func (v *bigIntSliceValue) appendNext() { v.changed = true }
+
+func (v *bigIntSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
+// -- stringBigIntMapValue
+type stringBigIntMapValue struct {
+	value *map[string]*big.Int
+	sep   string
+}
+
+var _ RepeatableFlag = (*stringBigIntMapValue)(nil)
+var _ Value = (*stringBigIntMapValue)(nil)
+var _ Getter = (*stringBigIntMapValue)(nil)
+
+func newStringBigIntMapValue(m *map[string]*big.Int) *stringBigIntMapValue {
+	return &stringBigIntMapValue{
+		value: m,
+	}
+}
+
+func (v *stringBigIntMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	key := s
+
+	s = rawVal
+
+	parsedVal, err := parseBigInt(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringBigIntMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringBigIntMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringBigIntMapValue) Type() string { return "map[string]*big.Int" }
+
+func (v *stringBigIntMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringBigIntMapValue) clear() { *v.value = make(map[string]*big.Int) }
+
+func (v *stringBigIntMapValue) appendNext() {}
+
+func (v *stringBigIntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *stringBigIntMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intBigIntMapValue
+type intBigIntMapValue struct {
+	value *map[int]*big.Int
+	sep   string
+}
+
+var _ RepeatableFlag = (*intBigIntMapValue)(nil)
+var _ Value = (*intBigIntMapValue)(nil)
+var _ Getter = (*intBigIntMapValue)(nil)
+
+func newIntBigIntMapValue(m *map[int]*big.Int) *intBigIntMapValue {
+	return &intBigIntMapValue{
+		value: m,
+	}
+}
+
+func (v *intBigIntMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigInt(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intBigIntMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intBigIntMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intBigIntMapValue) Type() string { return "map[int]*big.Int" }
+
+func (v *intBigIntMapValue) mapSeparator() string { return v.sep }
+
+func (v *intBigIntMapValue) clear() { *v.value = make(map[int]*big.Int) }
+
+func (v *intBigIntMapValue) appendNext() {}
+
+func (v *intBigIntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *intBigIntMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8BigIntMapValue
+type int8BigIntMapValue struct {
+	value *map[int8]*big.Int
+	sep   string
+}
+
+var _ RepeatableFlag = (*int8BigIntMapValue)(nil)
+var _ Value = (*int8BigIntMapValue)(nil)
+var _ Getter = (*int8BigIntMapValue)(nil)
+
+func newInt8BigIntMapValue(m *map[int8]*big.Int) *int8BigIntMapValue {
+	return &int8BigIntMapValue{
+		value: m,
+	}
+}
+
+func (v *int8BigIntMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigInt(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8BigIntMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8BigIntMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8BigIntMapValue) Type() string { return "map[int8]*big.Int" }
+
+func (v *int8BigIntMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8BigIntMapValue) clear() { *v.value = make(map[int8]*big.Int) }
+
+func (v *int8BigIntMapValue) appendNext() {}
+
+func (v *int8BigIntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int8BigIntMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16BigIntMapValue
+type int16BigIntMapValue struct {
+	value *map[int16]*big.Int
+	sep   string
+}
+
+var _ RepeatableFlag = (*int16BigIntMapValue)(nil)
+var _ Value = (*int16BigIntMapValue)(nil)
+var _ Getter = (*int16BigIntMapValue)(nil)
+
+func newInt16BigIntMapValue(m *map[int16]*big.Int) *int16BigIntMapValue {
+	return &int16BigIntMapValue{
+		value: m,
+	}
+}
+
+func (v *int16BigIntMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigInt(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16BigIntMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16BigIntMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16BigIntMapValue) Type() string { return "map[int16]*big.Int" }
+
+func (v *int16BigIntMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16BigIntMapValue) clear() { *v.value = make(map[int16]*big.Int) }
+
+func (v *int16BigIntMapValue) appendNext() {}
+
+func (v *int16BigIntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int16BigIntMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32BigIntMapValue
+type int32BigIntMapValue struct {
+	value *map[int32]*big.Int
+	sep   string
+}
+
+var _ RepeatableFlag = (*int32BigIntMapValue)(nil)
+var _ Value = (*int32BigIntMapValue)(nil)
+var _ Getter = (*int32BigIntMapValue)(nil)
+
+func newInt32BigIntMapValue(m *map[int32]*big.Int) *int32BigIntMapValue {
+	return &int32BigIntMapValue{
+		value: m,
+	}
+}
+
+func (v *int32BigIntMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigInt(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32BigIntMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32BigIntMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32BigIntMapValue) Type() string { return "map[int32]*big.Int" }
+
+func (v *int32BigIntMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32BigIntMapValue) clear() { *v.value = make(map[int32]*big.Int) }
+
+func (v *int32BigIntMapValue) appendNext() {}
+
+func (v *int32BigIntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int32BigIntMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64BigIntMapValue
+type int64BigIntMapValue struct {
+	value *map[int64]*big.Int
+	sep   string
+}
+
+var _ RepeatableFlag = (*int64BigIntMapValue)(nil)
+var _ Value = (*int64BigIntMapValue)(nil)
+var _ Getter = (*int64BigIntMapValue)(nil)
+
+func newInt64BigIntMapValue(m *map[int64]*big.Int) *int64BigIntMapValue {
+	return &int64BigIntMapValue{
+		value: m,
+	}
+}
+
+func (v *int64BigIntMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := parseBigInt(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64BigIntMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64BigIntMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64BigIntMapValue) Type() string { return "map[int64]*big.Int" }
+
+func (v *int64BigIntMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64BigIntMapValue) clear() { *v.value = make(map[int64]*big.Int) }
+
+func (v *int64BigIntMapValue) appendNext() {}
+
+func (v *int64BigIntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int64BigIntMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintBigIntMapValue
+type uintBigIntMapValue struct {
+	value *map[uint]*big.Int
+	sep   string
+}
+
+var _ RepeatableFlag = (*uintBigIntMapValue)(nil)
+var _ Value = (*uintBigIntMapValue)(nil)
+var _ Getter = (*uintBigIntMapValue)(nil)
+
+func newUintBigIntMapValue(m *map[uint]*big.Int) *uintBigIntMapValue {
+	return &uintBigIntMapValue{
+		value: m,
+	}
+}
+
+func (v *uintBigIntMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigInt(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintBigIntMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintBigIntMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintBigIntMapValue) Type() string { return "map[uint]*big.Int" }
+
+func (v *uintBigIntMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintBigIntMapValue) clear() { *v.value = make(map[uint]*big.Int) }
+
+func (v *uintBigIntMapValue) appendNext() {}
+
+func (v *uintBigIntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uintBigIntMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8BigIntMapValue
+type uint8BigIntMapValue struct {
+	value *map[uint8]*big.Int
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint8BigIntMapValue)(nil)
+var _ Value = (*uint8BigIntMapValue)(nil)
+var _ Getter = (*uint8BigIntMapValue)(nil)
+
+func newUint8BigIntMapValue(m *map[uint8]*big.Int) *uint8BigIntMapValue {
+	return &uint8BigIntMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8BigIntMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigInt(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8BigIntMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8BigIntMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8BigIntMapValue) Type() string { return "map[uint8]*big.Int" }
+
+func (v *uint8BigIntMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8BigIntMapValue) clear() { *v.value = make(map[uint8]*big.Int) }
+
+func (v *uint8BigIntMapValue) appendNext() {}
+
+func (v *uint8BigIntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint8BigIntMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16BigIntMapValue
+type uint16BigIntMapValue struct {
+	value *map[uint16]*big.Int
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint16BigIntMapValue)(nil)
+var _ Value = (*uint16BigIntMapValue)(nil)
+var _ Getter = (*uint16BigIntMapValue)(nil)
+
+func newUint16BigIntMapValue(m *map[uint16]*big.Int) *uint16BigIntMapValue {
+	return &uint16BigIntMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16BigIntMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigInt(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16BigIntMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16BigIntMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16BigIntMapValue) Type() string { return "map[uint16]*big.Int" }
+
+func (v *uint16BigIntMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16BigIntMapValue) clear() { *v.value = make(map[uint16]*big.Int) }
+
+func (v *uint16BigIntMapValue) appendNext() {}
+
+func (v *uint16BigIntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint16BigIntMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32BigIntMapValue
+type uint32BigIntMapValue struct {
+	value *map[uint32]*big.Int
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint32BigIntMapValue)(nil)
+var _ Value = (*uint32BigIntMapValue)(nil)
+var _ Getter = (*uint32BigIntMapValue)(nil)
+
+func newUint32BigIntMapValue(m *map[uint32]*big.Int) *uint32BigIntMapValue {
+	return &uint32BigIntMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32BigIntMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigInt(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32BigIntMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32BigIntMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32BigIntMapValue) Type() string { return "map[uint32]*big.Int" }
+
+func (v *uint32BigIntMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32BigIntMapValue) clear() { *v.value = make(map[uint32]*big.Int) }
+
+func (v *uint32BigIntMapValue) appendNext() {}
+
+func (v *uint32BigIntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint32BigIntMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64BigIntMapValue
+type uint64BigIntMapValue struct {
+	value *map[uint64]*big.Int
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint64BigIntMapValue)(nil)
+var _ Value = (*uint64BigIntMapValue)(nil)
+var _ Getter = (*uint64BigIntMapValue)(nil)
+
+func newUint64BigIntMapValue(m *map[uint64]*big.Int) *uint64BigIntMapValue {
+	return &uint64BigIntMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64BigIntMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := parseBigInt(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64BigIntMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64BigIntMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64BigIntMapValue) Type() string { return "map[uint64]*big.Int" }
+
+func (v *uint64BigIntMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64BigIntMapValue) clear() { *v.value = make(map[uint64]*big.Int) }
+
+func (v *uint64BigIntMapValue) appendNext() {}
+
+func (v *uint64BigIntMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint64BigIntMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- *big.Float Value
+type bigFloatValue struct {
+	value **big.Float
+}
+
+var _ Value = (*bigFloatValue)(nil)
+var _ Getter = (*bigFloatValue)(nil)
+
+func newBigFloatValue(p **big.Float) *bigFloatValue {
+	return &bigFloatValue{value: p}
+}
+
+func (v *bigFloatValue) Set(s string) error {
+	parsed, err := parseBigFloat(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *bigFloatValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *bigFloatValue) String() string {
+	if v != nil && v.value != nil {
+		return (*v.value).Text('g', -1)
+	}
+	return ""
+}
+
+func (v *bigFloatValue) Type() string { return "bigFloat" }
+
+// -- *big.FloatSlice Value
+
+type bigFloatSliceValue struct {
+	value   *[]*big.Float
+	changed bool
+	sep     string
+}
+
+var _ RepeatableFlag = (*bigFloatSliceValue)(nil)
+var _ Value = (*bigFloatSliceValue)(nil)
+var _ Getter = (*bigFloatSliceValue)(nil)
+
+func newBigFloatSliceValue(slice *[]*big.Float) *bigFloatSliceValue {
+	return &bigFloatSliceValue{
+		value: slice,
+	}
+}
+
+func (v *bigFloatSliceValue) Set(raw string) error {
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
+
+	out := make([]*big.Float, len(ss))
+	for i, s := range ss {
+		parsed, err := parseBigFloat(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *bigFloatSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]*big.Float)(nil)
+}
+
+func (v *bigFloatSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newBigFloatValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *bigFloatSliceValue) Type() string { return "bigFloatSlice" }
+
+func (v *bigFloatSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *bigFloatSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *bigFloatSliceValue) clear() {
+	*v.value = []*big.Float{}
+	v.changed = true
+}
+
+func (v *bigFloatSliceValue) appendNext() { v.changed = true }
+
+func (v *bigFloatSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
+// -- stringBigFloatMapValue
+type stringBigFloatMapValue struct {
+	value *map[string]*big.Float
+	sep   string
+}
+
+var _ RepeatableFlag = (*stringBigFloatMapValue)(nil)
+var _ Value = (*stringBigFloatMapValue)(nil)
+var _ Getter = (*stringBigFloatMapValue)(nil)
+
+func newStringBigFloatMapValue(m *map[string]*big.Float) *stringBigFloatMapValue {
+	return &stringBigFloatMapValue{
+		value: m,
+	}
+}
+
+func (v *stringBigFloatMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	key := s
+
+	s = rawVal
+
+	parsedVal, err := parseBigFloat(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringBigFloatMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringBigFloatMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringBigFloatMapValue) Type() string { return "map[string]*big.Float" }
+
+func (v *stringBigFloatMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringBigFloatMapValue) clear() { *v.value = make(map[string]*big.Float) }
+
+func (v *stringBigFloatMapValue) appendNext() {}
+
+func (v *stringBigFloatMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *stringBigFloatMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intBigFloatMapValue
+type intBigFloatMapValue struct {
+	value *map[int]*big.Float
+	sep   string
+}
+
+var _ RepeatableFlag = (*intBigFloatMapValue)(nil)
+var _ Value = (*intBigFloatMapValue)(nil)
+var _ Getter = (*intBigFloatMapValue)(nil)
+
+func newIntBigFloatMapValue(m *map[int]*big.Float) *intBigFloatMapValue {
+	return &intBigFloatMapValue{
+		value: m,
+	}
+}
+
+func (v *intBigFloatMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigFloat(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intBigFloatMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intBigFloatMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intBigFloatMapValue) Type() string { return "map[int]*big.Float" }
+
+func (v *intBigFloatMapValue) mapSeparator() string { return v.sep }
+
+func (v *intBigFloatMapValue) clear() { *v.value = make(map[int]*big.Float) }
+
+func (v *intBigFloatMapValue) appendNext() {}
+
+func (v *intBigFloatMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *intBigFloatMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8BigFloatMapValue
+type int8BigFloatMapValue struct {
+	value *map[int8]*big.Float
+	sep   string
+}
+
+var _ RepeatableFlag = (*int8BigFloatMapValue)(nil)
+var _ Value = (*int8BigFloatMapValue)(nil)
+var _ Getter = (*int8BigFloatMapValue)(nil)
+
+func newInt8BigFloatMapValue(m *map[int8]*big.Float) *int8BigFloatMapValue {
+	return &int8BigFloatMapValue{
+		value: m,
+	}
+}
+
+func (v *int8BigFloatMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigFloat(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8BigFloatMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8BigFloatMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8BigFloatMapValue) Type() string { return "map[int8]*big.Float" }
+
+func (v *int8BigFloatMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8BigFloatMapValue) clear() { *v.value = make(map[int8]*big.Float) }
+
+func (v *int8BigFloatMapValue) appendNext() {}
+
+func (v *int8BigFloatMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int8BigFloatMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16BigFloatMapValue
+type int16BigFloatMapValue struct {
+	value *map[int16]*big.Float
+	sep   string
+}
+
+var _ RepeatableFlag = (*int16BigFloatMapValue)(nil)
+var _ Value = (*int16BigFloatMapValue)(nil)
+var _ Getter = (*int16BigFloatMapValue)(nil)
+
+func newInt16BigFloatMapValue(m *map[int16]*big.Float) *int16BigFloatMapValue {
+	return &int16BigFloatMapValue{
+		value: m,
+	}
+}
+
+func (v *int16BigFloatMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigFloat(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16BigFloatMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16BigFloatMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16BigFloatMapValue) Type() string { return "map[int16]*big.Float" }
+
+func (v *int16BigFloatMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16BigFloatMapValue) clear() { *v.value = make(map[int16]*big.Float) }
+
+func (v *int16BigFloatMapValue) appendNext() {}
+
+func (v *int16BigFloatMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int16BigFloatMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32BigFloatMapValue
+type int32BigFloatMapValue struct {
+	value *map[int32]*big.Float
+	sep   string
+}
+
+var _ RepeatableFlag = (*int32BigFloatMapValue)(nil)
+var _ Value = (*int32BigFloatMapValue)(nil)
+var _ Getter = (*int32BigFloatMapValue)(nil)
+
+func newInt32BigFloatMapValue(m *map[int32]*big.Float) *int32BigFloatMapValue {
+	return &int32BigFloatMapValue{
+		value: m,
+	}
+}
+
+func (v *int32BigFloatMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigFloat(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32BigFloatMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32BigFloatMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32BigFloatMapValue) Type() string { return "map[int32]*big.Float" }
+
+func (v *int32BigFloatMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32BigFloatMapValue) clear() { *v.value = make(map[int32]*big.Float) }
+
+func (v *int32BigFloatMapValue) appendNext() {}
+
+func (v *int32BigFloatMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int32BigFloatMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64BigFloatMapValue
+type int64BigFloatMapValue struct {
+	value *map[int64]*big.Float
+	sep   string
+}
+
+var _ RepeatableFlag = (*int64BigFloatMapValue)(nil)
+var _ Value = (*int64BigFloatMapValue)(nil)
+var _ Getter = (*int64BigFloatMapValue)(nil)
+
+func newInt64BigFloatMapValue(m *map[int64]*big.Float) *int64BigFloatMapValue {
+	return &int64BigFloatMapValue{
+		value: m,
+	}
+}
+
+func (v *int64BigFloatMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := parseBigFloat(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64BigFloatMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64BigFloatMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64BigFloatMapValue) Type() string { return "map[int64]*big.Float" }
+
+func (v *int64BigFloatMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64BigFloatMapValue) clear() { *v.value = make(map[int64]*big.Float) }
+
+func (v *int64BigFloatMapValue) appendNext() {}
+
+func (v *int64BigFloatMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int64BigFloatMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintBigFloatMapValue
+type uintBigFloatMapValue struct {
+	value *map[uint]*big.Float
+	sep   string
+}
+
+var _ RepeatableFlag = (*uintBigFloatMapValue)(nil)
+var _ Value = (*uintBigFloatMapValue)(nil)
+var _ Getter = (*uintBigFloatMapValue)(nil)
+
+func newUintBigFloatMapValue(m *map[uint]*big.Float) *uintBigFloatMapValue {
+	return &uintBigFloatMapValue{
+		value: m,
+	}
+}
+
+func (v *uintBigFloatMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigFloat(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintBigFloatMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintBigFloatMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintBigFloatMapValue) Type() string { return "map[uint]*big.Float" }
+
+func (v *uintBigFloatMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintBigFloatMapValue) clear() { *v.value = make(map[uint]*big.Float) }
+
+func (v *uintBigFloatMapValue) appendNext() {}
+
+func (v *uintBigFloatMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uintBigFloatMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8BigFloatMapValue
+type uint8BigFloatMapValue struct {
+	value *map[uint8]*big.Float
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint8BigFloatMapValue)(nil)
+var _ Value = (*uint8BigFloatMapValue)(nil)
+var _ Getter = (*uint8BigFloatMapValue)(nil)
+
+func newUint8BigFloatMapValue(m *map[uint8]*big.Float) *uint8BigFloatMapValue {
+	return &uint8BigFloatMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8BigFloatMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigFloat(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8BigFloatMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8BigFloatMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8BigFloatMapValue) Type() string { return "map[uint8]*big.Float" }
+
+func (v *uint8BigFloatMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8BigFloatMapValue) clear() { *v.value = make(map[uint8]*big.Float) }
+
+func (v *uint8BigFloatMapValue) appendNext() {}
+
+func (v *uint8BigFloatMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint8BigFloatMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16BigFloatMapValue
+type uint16BigFloatMapValue struct {
+	value *map[uint16]*big.Float
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint16BigFloatMapValue)(nil)
+var _ Value = (*uint16BigFloatMapValue)(nil)
+var _ Getter = (*uint16BigFloatMapValue)(nil)
+
+func newUint16BigFloatMapValue(m *map[uint16]*big.Float) *uint16BigFloatMapValue {
+	return &uint16BigFloatMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16BigFloatMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigFloat(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16BigFloatMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16BigFloatMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16BigFloatMapValue) Type() string { return "map[uint16]*big.Float" }
+
+func (v *uint16BigFloatMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16BigFloatMapValue) clear() { *v.value = make(map[uint16]*big.Float) }
+
+func (v *uint16BigFloatMapValue) appendNext() {}
+
+func (v *uint16BigFloatMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint16BigFloatMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32BigFloatMapValue
+type uint32BigFloatMapValue struct {
+	value *map[uint32]*big.Float
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint32BigFloatMapValue)(nil)
+var _ Value = (*uint32BigFloatMapValue)(nil)
+var _ Getter = (*uint32BigFloatMapValue)(nil)
+
+func newUint32BigFloatMapValue(m *map[uint32]*big.Float) *uint32BigFloatMapValue {
+	return &uint32BigFloatMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32BigFloatMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseBigFloat(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32BigFloatMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32BigFloatMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32BigFloatMapValue) Type() string { return "map[uint32]*big.Float" }
+
+func (v *uint32BigFloatMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32BigFloatMapValue) clear() { *v.value = make(map[uint32]*big.Float) }
+
+func (v *uint32BigFloatMapValue) appendNext() {}
+
+func (v *uint32BigFloatMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint32BigFloatMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64BigFloatMapValue
+type uint64BigFloatMapValue struct {
+	value *map[uint64]*big.Float
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint64BigFloatMapValue)(nil)
+var _ Value = (*uint64BigFloatMapValue)(nil)
+var _ Getter = (*uint64BigFloatMapValue)(nil)
+
+func newUint64BigFloatMapValue(m *map[uint64]*big.Float) *uint64BigFloatMapValue {
+	return &uint64BigFloatMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64BigFloatMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := parseBigFloat(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64BigFloatMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64BigFloatMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64BigFloatMapValue) Type() string { return "map[uint64]*big.Float" }
+
+func (v *uint64BigFloatMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64BigFloatMapValue) clear() { *v.value = make(map[uint64]*big.Float) }
+
+func (v *uint64BigFloatMapValue) appendNext() {}
+
+func (v *uint64BigFloatMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint64BigFloatMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- os.FileMode Value
+type fileModeValue struct {
+	value *os.FileMode
+}
+
+var _ Value = (*fileModeValue)(nil)
+var _ Getter = (*fileModeValue)(nil)
+
+func newFileModeValue(p *os.FileMode) *fileModeValue {
+	return &fileModeValue{value: p}
+}
+
+func (v *fileModeValue) Set(s string) error {
+	parsed, err := parseFileMode(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *fileModeValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *fileModeValue) String() string {
+	if v != nil && v.value != nil {
+		return fmt.Sprintf("%#o", uint32(*v.value))
+	}
+	return ""
+}
+
+func (v *fileModeValue) Type() string { return "fileMode" }
+
+// -- os.FileModeSlice Value
+
+type fileModeSliceValue struct {
+	value   *[]os.FileMode
+	changed bool
+	sep     string
+}
+
+var _ RepeatableFlag = (*fileModeSliceValue)(nil)
+var _ Value = (*fileModeSliceValue)(nil)
+var _ Getter = (*fileModeSliceValue)(nil)
+
+func newFileModeSliceValue(slice *[]os.FileMode) *fileModeSliceValue {
+	return &fileModeSliceValue{
+		value: slice,
+	}
+}
+
+func (v *fileModeSliceValue) Set(raw string) error {
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
+
+	out := make([]os.FileMode, len(ss))
+	for i, s := range ss {
+		parsed, err := parseFileMode(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *fileModeSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]os.FileMode)(nil)
+}
+
+func (v *fileModeSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newFileModeValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *fileModeSliceValue) Type() string { return "fileModeSlice" }
+
+func (v *fileModeSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *fileModeSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *fileModeSliceValue) clear() {
+	*v.value = []os.FileMode{}
+	v.changed = true
+}
+
+func (v *fileModeSliceValue) appendNext() { v.changed = true }
+
+func (v *fileModeSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
+// -- stringFileModeMapValue
+type stringFileModeMapValue struct {
+	value *map[string]os.FileMode
+	sep   string
+}
+
+var _ RepeatableFlag = (*stringFileModeMapValue)(nil)
+var _ Value = (*stringFileModeMapValue)(nil)
+var _ Getter = (*stringFileModeMapValue)(nil)
+
+func newStringFileModeMapValue(m *map[string]os.FileMode) *stringFileModeMapValue {
+	return &stringFileModeMapValue{
+		value: m,
+	}
+}
+
+func (v *stringFileModeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	key := s
+
+	s = rawVal
+
+	parsedVal, err := parseFileMode(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringFileModeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringFileModeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringFileModeMapValue) Type() string { return "map[string]os.FileMode" }
+
+func (v *stringFileModeMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringFileModeMapValue) clear() { *v.value = make(map[string]os.FileMode) }
+
+func (v *stringFileModeMapValue) appendNext() {}
+
+func (v *stringFileModeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *stringFileModeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intFileModeMapValue
+type intFileModeMapValue struct {
+	value *map[int]os.FileMode
+	sep   string
+}
+
+var _ RepeatableFlag = (*intFileModeMapValue)(nil)
+var _ Value = (*intFileModeMapValue)(nil)
+var _ Getter = (*intFileModeMapValue)(nil)
+
+func newIntFileModeMapValue(m *map[int]os.FileMode) *intFileModeMapValue {
+	return &intFileModeMapValue{
+		value: m,
+	}
+}
+
+func (v *intFileModeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseFileMode(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intFileModeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intFileModeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intFileModeMapValue) Type() string { return "map[int]os.FileMode" }
+
+func (v *intFileModeMapValue) mapSeparator() string { return v.sep }
+
+func (v *intFileModeMapValue) clear() { *v.value = make(map[int]os.FileMode) }
+
+func (v *intFileModeMapValue) appendNext() {}
+
+func (v *intFileModeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *intFileModeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8FileModeMapValue
+type int8FileModeMapValue struct {
+	value *map[int8]os.FileMode
+	sep   string
+}
+
+var _ RepeatableFlag = (*int8FileModeMapValue)(nil)
+var _ Value = (*int8FileModeMapValue)(nil)
+var _ Getter = (*int8FileModeMapValue)(nil)
+
+func newInt8FileModeMapValue(m *map[int8]os.FileMode) *int8FileModeMapValue {
+	return &int8FileModeMapValue{
+		value: m,
+	}
+}
+
+func (v *int8FileModeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseFileMode(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8FileModeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8FileModeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8FileModeMapValue) Type() string { return "map[int8]os.FileMode" }
+
+func (v *int8FileModeMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8FileModeMapValue) clear() { *v.value = make(map[int8]os.FileMode) }
+
+func (v *int8FileModeMapValue) appendNext() {}
+
+func (v *int8FileModeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int8FileModeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16FileModeMapValue
+type int16FileModeMapValue struct {
+	value *map[int16]os.FileMode
+	sep   string
+}
+
+var _ RepeatableFlag = (*int16FileModeMapValue)(nil)
+var _ Value = (*int16FileModeMapValue)(nil)
+var _ Getter = (*int16FileModeMapValue)(nil)
+
+func newInt16FileModeMapValue(m *map[int16]os.FileMode) *int16FileModeMapValue {
+	return &int16FileModeMapValue{
+		value: m,
+	}
+}
+
+func (v *int16FileModeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseFileMode(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16FileModeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16FileModeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16FileModeMapValue) Type() string { return "map[int16]os.FileMode" }
+
+func (v *int16FileModeMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16FileModeMapValue) clear() { *v.value = make(map[int16]os.FileMode) }
+
+func (v *int16FileModeMapValue) appendNext() {}
+
+func (v *int16FileModeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int16FileModeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32FileModeMapValue
+type int32FileModeMapValue struct {
+	value *map[int32]os.FileMode
+	sep   string
+}
+
+var _ RepeatableFlag = (*int32FileModeMapValue)(nil)
+var _ Value = (*int32FileModeMapValue)(nil)
+var _ Getter = (*int32FileModeMapValue)(nil)
+
+func newInt32FileModeMapValue(m *map[int32]os.FileMode) *int32FileModeMapValue {
+	return &int32FileModeMapValue{
+		value: m,
+	}
+}
+
+func (v *int32FileModeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseFileMode(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32FileModeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32FileModeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32FileModeMapValue) Type() string { return "map[int32]os.FileMode" }
+
+func (v *int32FileModeMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32FileModeMapValue) clear() { *v.value = make(map[int32]os.FileMode) }
+
+func (v *int32FileModeMapValue) appendNext() {}
+
+func (v *int32FileModeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int32FileModeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64FileModeMapValue
+type int64FileModeMapValue struct {
+	value *map[int64]os.FileMode
+	sep   string
+}
+
+var _ RepeatableFlag = (*int64FileModeMapValue)(nil)
+var _ Value = (*int64FileModeMapValue)(nil)
+var _ Getter = (*int64FileModeMapValue)(nil)
+
+func newInt64FileModeMapValue(m *map[int64]os.FileMode) *int64FileModeMapValue {
+	return &int64FileModeMapValue{
+		value: m,
+	}
+}
+
+func (v *int64FileModeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := parseFileMode(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64FileModeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64FileModeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64FileModeMapValue) Type() string { return "map[int64]os.FileMode" }
+
+func (v *int64FileModeMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64FileModeMapValue) clear() { *v.value = make(map[int64]os.FileMode) }
+
+func (v *int64FileModeMapValue) appendNext() {}
+
+func (v *int64FileModeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int64FileModeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintFileModeMapValue
+type uintFileModeMapValue struct {
+	value *map[uint]os.FileMode
+	sep   string
+}
+
+var _ RepeatableFlag = (*uintFileModeMapValue)(nil)
+var _ Value = (*uintFileModeMapValue)(nil)
+var _ Getter = (*uintFileModeMapValue)(nil)
+
+func newUintFileModeMapValue(m *map[uint]os.FileMode) *uintFileModeMapValue {
+	return &uintFileModeMapValue{
+		value: m,
+	}
+}
+
+func (v *uintFileModeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseFileMode(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintFileModeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintFileModeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintFileModeMapValue) Type() string { return "map[uint]os.FileMode" }
+
+func (v *uintFileModeMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintFileModeMapValue) clear() { *v.value = make(map[uint]os.FileMode) }
+
+func (v *uintFileModeMapValue) appendNext() {}
+
+func (v *uintFileModeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uintFileModeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8FileModeMapValue
+type uint8FileModeMapValue struct {
+	value *map[uint8]os.FileMode
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint8FileModeMapValue)(nil)
+var _ Value = (*uint8FileModeMapValue)(nil)
+var _ Getter = (*uint8FileModeMapValue)(nil)
+
+func newUint8FileModeMapValue(m *map[uint8]os.FileMode) *uint8FileModeMapValue {
+	return &uint8FileModeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8FileModeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseFileMode(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8FileModeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8FileModeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8FileModeMapValue) Type() string { return "map[uint8]os.FileMode" }
+
+func (v *uint8FileModeMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8FileModeMapValue) clear() { *v.value = make(map[uint8]os.FileMode) }
+
+func (v *uint8FileModeMapValue) appendNext() {}
+
+func (v *uint8FileModeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint8FileModeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16FileModeMapValue
+type uint16FileModeMapValue struct {
+	value *map[uint16]os.FileMode
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint16FileModeMapValue)(nil)
+var _ Value = (*uint16FileModeMapValue)(nil)
+var _ Getter = (*uint16FileModeMapValue)(nil)
+
+func newUint16FileModeMapValue(m *map[uint16]os.FileMode) *uint16FileModeMapValue {
+	return &uint16FileModeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16FileModeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseFileMode(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16FileModeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16FileModeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16FileModeMapValue) Type() string { return "map[uint16]os.FileMode" }
+
+func (v *uint16FileModeMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16FileModeMapValue) clear() { *v.value = make(map[uint16]os.FileMode) }
+
+func (v *uint16FileModeMapValue) appendNext() {}
+
+func (v *uint16FileModeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint16FileModeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32FileModeMapValue
+type uint32FileModeMapValue struct {
+	value *map[uint32]os.FileMode
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint32FileModeMapValue)(nil)
+var _ Value = (*uint32FileModeMapValue)(nil)
+var _ Getter = (*uint32FileModeMapValue)(nil)
+
+func newUint32FileModeMapValue(m *map[uint32]os.FileMode) *uint32FileModeMapValue {
+	return &uint32FileModeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32FileModeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseFileMode(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32FileModeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32FileModeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32FileModeMapValue) Type() string { return "map[uint32]os.FileMode" }
+
+func (v *uint32FileModeMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32FileModeMapValue) clear() { *v.value = make(map[uint32]os.FileMode) }
+
+func (v *uint32FileModeMapValue) appendNext() {}
+
+func (v *uint32FileModeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint32FileModeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64FileModeMapValue
+type uint64FileModeMapValue struct {
+	value *map[uint64]os.FileMode
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint64FileModeMapValue)(nil)
+var _ Value = (*uint64FileModeMapValue)(nil)
+var _ Getter = (*uint64FileModeMapValue)(nil)
+
+func newUint64FileModeMapValue(m *map[uint64]os.FileMode) *uint64FileModeMapValue {
+	return &uint64FileModeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64FileModeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := parseFileMode(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64FileModeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64FileModeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64FileModeMapValue) Type() string { return "map[uint64]os.FileMode" }
+
+func (v *uint64FileModeMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64FileModeMapValue) clear() { *v.value = make(map[uint64]os.FileMode) }
+
+func (v *uint64FileModeMapValue) appendNext() {}
+
+func (v *uint64FileModeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint64FileModeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- *time.Location Value
+type locationValue struct {
+	value **time.Location
+}
+
+var _ Value = (*locationValue)(nil)
+var _ Getter = (*locationValue)(nil)
+
+func newLocationValue(p **time.Location) *locationValue {
+	return &locationValue{value: p}
+}
+
+func (v *locationValue) Set(s string) error {
+	parsed, err := time.LoadLocation(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *locationValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *locationValue) String() string {
+	if v != nil && v.value != nil {
+		return (*v.value).String()
+	}
+	return ""
+}
+
+func (v *locationValue) Type() string { return "location" }
+
+// -- *time.LocationSlice Value
+
+type locationSliceValue struct {
+	value   *[]*time.Location
+	changed bool
+	sep     string
+}
+
+var _ RepeatableFlag = (*locationSliceValue)(nil)
+var _ Value = (*locationSliceValue)(nil)
+var _ Getter = (*locationSliceValue)(nil)
+
+func newLocationSliceValue(slice *[]*time.Location) *locationSliceValue {
+	return &locationSliceValue{
+		value: slice,
+	}
+}
+
+func (v *locationSliceValue) Set(raw string) error {
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
+
+	out := make([]*time.Location, len(ss))
+	for i, s := range ss {
+		parsed, err := time.LoadLocation(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *locationSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]*time.Location)(nil)
+}
+
+func (v *locationSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newLocationValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *locationSliceValue) Type() string { return "locationSlice" }
+
+func (v *locationSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *locationSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *locationSliceValue) clear() {
+	*v.value = []*time.Location{}
+	v.changed = true
+}
+
+func (v *locationSliceValue) appendNext() { v.changed = true }
+
+func (v *locationSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
+// -- stringLocationMapValue
+type stringLocationMapValue struct {
+	value *map[string]*time.Location
+	sep   string
+}
+
+var _ RepeatableFlag = (*stringLocationMapValue)(nil)
+var _ Value = (*stringLocationMapValue)(nil)
+var _ Getter = (*stringLocationMapValue)(nil)
+
+func newStringLocationMapValue(m *map[string]*time.Location) *stringLocationMapValue {
+	return &stringLocationMapValue{
+		value: m,
+	}
+}
+
+func (v *stringLocationMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	key := s
+
+	s = rawVal
+
+	parsedVal, err := time.LoadLocation(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringLocationMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringLocationMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringLocationMapValue) Type() string { return "map[string]*time.Location" }
+
+func (v *stringLocationMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringLocationMapValue) clear() { *v.value = make(map[string]*time.Location) }
+
+func (v *stringLocationMapValue) appendNext() {}
+
+func (v *stringLocationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *stringLocationMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intLocationMapValue
+type intLocationMapValue struct {
+	value *map[int]*time.Location
+	sep   string
+}
+
+var _ RepeatableFlag = (*intLocationMapValue)(nil)
+var _ Value = (*intLocationMapValue)(nil)
+var _ Getter = (*intLocationMapValue)(nil)
+
+func newIntLocationMapValue(m *map[int]*time.Location) *intLocationMapValue {
+	return &intLocationMapValue{
+		value: m,
+	}
+}
+
+func (v *intLocationMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := time.LoadLocation(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intLocationMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intLocationMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intLocationMapValue) Type() string { return "map[int]*time.Location" }
+
+func (v *intLocationMapValue) mapSeparator() string { return v.sep }
+
+func (v *intLocationMapValue) clear() { *v.value = make(map[int]*time.Location) }
+
+func (v *intLocationMapValue) appendNext() {}
+
+func (v *intLocationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *intLocationMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8LocationMapValue
+type int8LocationMapValue struct {
+	value *map[int8]*time.Location
+	sep   string
+}
+
+var _ RepeatableFlag = (*int8LocationMapValue)(nil)
+var _ Value = (*int8LocationMapValue)(nil)
+var _ Getter = (*int8LocationMapValue)(nil)
+
+func newInt8LocationMapValue(m *map[int8]*time.Location) *int8LocationMapValue {
+	return &int8LocationMapValue{
+		value: m,
+	}
+}
+
+func (v *int8LocationMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := time.LoadLocation(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8LocationMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8LocationMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8LocationMapValue) Type() string { return "map[int8]*time.Location" }
+
+func (v *int8LocationMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8LocationMapValue) clear() { *v.value = make(map[int8]*time.Location) }
+
+func (v *int8LocationMapValue) appendNext() {}
+
+func (v *int8LocationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int8LocationMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16LocationMapValue
+type int16LocationMapValue struct {
+	value *map[int16]*time.Location
+	sep   string
+}
+
+var _ RepeatableFlag = (*int16LocationMapValue)(nil)
+var _ Value = (*int16LocationMapValue)(nil)
+var _ Getter = (*int16LocationMapValue)(nil)
+
+func newInt16LocationMapValue(m *map[int16]*time.Location) *int16LocationMapValue {
+	return &int16LocationMapValue{
+		value: m,
+	}
+}
+
+func (v *int16LocationMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := time.LoadLocation(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16LocationMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16LocationMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16LocationMapValue) Type() string { return "map[int16]*time.Location" }
+
+func (v *int16LocationMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16LocationMapValue) clear() { *v.value = make(map[int16]*time.Location) }
+
+func (v *int16LocationMapValue) appendNext() {}
+
+func (v *int16LocationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int16LocationMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32LocationMapValue
+type int32LocationMapValue struct {
+	value *map[int32]*time.Location
+	sep   string
+}
+
+var _ RepeatableFlag = (*int32LocationMapValue)(nil)
+var _ Value = (*int32LocationMapValue)(nil)
+var _ Getter = (*int32LocationMapValue)(nil)
+
+func newInt32LocationMapValue(m *map[int32]*time.Location) *int32LocationMapValue {
+	return &int32LocationMapValue{
+		value: m,
+	}
+}
+
+func (v *int32LocationMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := time.LoadLocation(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32LocationMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32LocationMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32LocationMapValue) Type() string { return "map[int32]*time.Location" }
+
+func (v *int32LocationMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32LocationMapValue) clear() { *v.value = make(map[int32]*time.Location) }
+
+func (v *int32LocationMapValue) appendNext() {}
+
+func (v *int32LocationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int32LocationMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64LocationMapValue
+type int64LocationMapValue struct {
+	value *map[int64]*time.Location
+	sep   string
+}
+
+var _ RepeatableFlag = (*int64LocationMapValue)(nil)
+var _ Value = (*int64LocationMapValue)(nil)
+var _ Getter = (*int64LocationMapValue)(nil)
+
+func newInt64LocationMapValue(m *map[int64]*time.Location) *int64LocationMapValue {
+	return &int64LocationMapValue{
+		value: m,
+	}
+}
+
+func (v *int64LocationMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := time.LoadLocation(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64LocationMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64LocationMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64LocationMapValue) Type() string { return "map[int64]*time.Location" }
+
+func (v *int64LocationMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64LocationMapValue) clear() { *v.value = make(map[int64]*time.Location) }
+
+func (v *int64LocationMapValue) appendNext() {}
+
+func (v *int64LocationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int64LocationMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintLocationMapValue
+type uintLocationMapValue struct {
+	value *map[uint]*time.Location
+	sep   string
+}
+
+var _ RepeatableFlag = (*uintLocationMapValue)(nil)
+var _ Value = (*uintLocationMapValue)(nil)
+var _ Getter = (*uintLocationMapValue)(nil)
+
+func newUintLocationMapValue(m *map[uint]*time.Location) *uintLocationMapValue {
+	return &uintLocationMapValue{
+		value: m,
+	}
+}
+
+func (v *uintLocationMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := time.LoadLocation(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintLocationMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintLocationMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintLocationMapValue) Type() string { return "map[uint]*time.Location" }
+
+func (v *uintLocationMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintLocationMapValue) clear() { *v.value = make(map[uint]*time.Location) }
+
+func (v *uintLocationMapValue) appendNext() {}
+
+func (v *uintLocationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uintLocationMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8LocationMapValue
+type uint8LocationMapValue struct {
+	value *map[uint8]*time.Location
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint8LocationMapValue)(nil)
+var _ Value = (*uint8LocationMapValue)(nil)
+var _ Getter = (*uint8LocationMapValue)(nil)
+
+func newUint8LocationMapValue(m *map[uint8]*time.Location) *uint8LocationMapValue {
+	return &uint8LocationMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8LocationMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := time.LoadLocation(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8LocationMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8LocationMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8LocationMapValue) Type() string { return "map[uint8]*time.Location" }
+
+func (v *uint8LocationMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8LocationMapValue) clear() { *v.value = make(map[uint8]*time.Location) }
+
+func (v *uint8LocationMapValue) appendNext() {}
+
+func (v *uint8LocationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint8LocationMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16LocationMapValue
+type uint16LocationMapValue struct {
+	value *map[uint16]*time.Location
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint16LocationMapValue)(nil)
+var _ Value = (*uint16LocationMapValue)(nil)
+var _ Getter = (*uint16LocationMapValue)(nil)
+
+func newUint16LocationMapValue(m *map[uint16]*time.Location) *uint16LocationMapValue {
+	return &uint16LocationMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16LocationMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := time.LoadLocation(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16LocationMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16LocationMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16LocationMapValue) Type() string { return "map[uint16]*time.Location" }
+
+func (v *uint16LocationMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16LocationMapValue) clear() { *v.value = make(map[uint16]*time.Location) }
+
+func (v *uint16LocationMapValue) appendNext() {}
+
+func (v *uint16LocationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint16LocationMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32LocationMapValue
+type uint32LocationMapValue struct {
+	value *map[uint32]*time.Location
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint32LocationMapValue)(nil)
+var _ Value = (*uint32LocationMapValue)(nil)
+var _ Getter = (*uint32LocationMapValue)(nil)
+
+func newUint32LocationMapValue(m *map[uint32]*time.Location) *uint32LocationMapValue {
+	return &uint32LocationMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32LocationMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := time.LoadLocation(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32LocationMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32LocationMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32LocationMapValue) Type() string { return "map[uint32]*time.Location" }
+
+func (v *uint32LocationMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32LocationMapValue) clear() { *v.value = make(map[uint32]*time.Location) }
+
+func (v *uint32LocationMapValue) appendNext() {}
+
+func (v *uint32LocationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint32LocationMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64LocationMapValue
+type uint64LocationMapValue struct {
+	value *map[uint64]*time.Location
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint64LocationMapValue)(nil)
+var _ Value = (*uint64LocationMapValue)(nil)
+var _ Getter = (*uint64LocationMapValue)(nil)
+
+func newUint64LocationMapValue(m *map[uint64]*time.Location) *uint64LocationMapValue {
+	return &uint64LocationMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64LocationMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := time.LoadLocation(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64LocationMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64LocationMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64LocationMapValue) Type() string { return "map[uint64]*time.Location" }
+
+func (v *uint64LocationMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64LocationMapValue) clear() { *v.value = make(map[uint64]*time.Location) }
+
+func (v *uint64LocationMapValue) appendNext() {}
+
+func (v *uint64LocationMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint64LocationMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- ByteSize Value
+type byteSizeValue struct {
+	value *ByteSize
+}
+
+var _ Value = (*byteSizeValue)(nil)
+var _ Getter = (*byteSizeValue)(nil)
+
+func newByteSizeValue(p *ByteSize) *byteSizeValue {
+	return &byteSizeValue{value: p}
+}
+
+func (v *byteSizeValue) Set(s string) error {
+	parsed, err := parseByteSize(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *byteSizeValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *byteSizeValue) String() string {
+	if v != nil && v.value != nil {
+		return v.value.String()
+	}
+	return ""
+}
+
+func (v *byteSizeValue) Type() string { return "byteSize" }
+
+// -- ByteSizeSlice Value
+
+type byteSizeSliceValue struct {
+	value   *[]ByteSize
+	changed bool
+	sep     string
+}
+
+var _ RepeatableFlag = (*byteSizeSliceValue)(nil)
+var _ Value = (*byteSizeSliceValue)(nil)
+var _ Getter = (*byteSizeSliceValue)(nil)
+
+func newByteSizeSliceValue(slice *[]ByteSize) *byteSizeSliceValue {
+	return &byteSizeSliceValue{
+		value: slice,
+	}
+}
+
+func (v *byteSizeSliceValue) Set(raw string) error {
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
+
+	out := make([]ByteSize, len(ss))
+	for i, s := range ss {
+		parsed, err := parseByteSize(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *byteSizeSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]ByteSize)(nil)
+}
+
+func (v *byteSizeSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newByteSizeValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *byteSizeSliceValue) Type() string { return "byteSizeSlice" }
+
+func (v *byteSizeSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *byteSizeSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *byteSizeSliceValue) clear() {
+	*v.value = []ByteSize{}
+	v.changed = true
+}
+
+func (v *byteSizeSliceValue) appendNext() { v.changed = true }
+
+func (v *byteSizeSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
+// -- stringByteSizeMapValue
+type stringByteSizeMapValue struct {
+	value *map[string]ByteSize
+	sep   string
+}
+
+var _ RepeatableFlag = (*stringByteSizeMapValue)(nil)
+var _ Value = (*stringByteSizeMapValue)(nil)
+var _ Getter = (*stringByteSizeMapValue)(nil)
+
+func newStringByteSizeMapValue(m *map[string]ByteSize) *stringByteSizeMapValue {
+	return &stringByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *stringByteSizeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	key := s
+
+	s = rawVal
+
+	parsedVal, err := parseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringByteSizeMapValue) Type() string { return "map[string]ByteSize" }
+
+func (v *stringByteSizeMapValue) mapSeparator() string { return v.sep }
+
+func (v *stringByteSizeMapValue) clear() { *v.value = make(map[string]ByteSize) }
+
+func (v *stringByteSizeMapValue) appendNext() {}
+
+func (v *stringByteSizeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *stringByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intByteSizeMapValue
+type intByteSizeMapValue struct {
+	value *map[int]ByteSize
+	sep   string
+}
+
+var _ RepeatableFlag = (*intByteSizeMapValue)(nil)
+var _ Value = (*intByteSizeMapValue)(nil)
+var _ Getter = (*intByteSizeMapValue)(nil)
+
+func newIntByteSizeMapValue(m *map[int]ByteSize) *intByteSizeMapValue {
+	return &intByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *intByteSizeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intByteSizeMapValue) Type() string { return "map[int]ByteSize" }
+
+func (v *intByteSizeMapValue) mapSeparator() string { return v.sep }
+
+func (v *intByteSizeMapValue) clear() { *v.value = make(map[int]ByteSize) }
+
+func (v *intByteSizeMapValue) appendNext() {}
+
+func (v *intByteSizeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *intByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8ByteSizeMapValue
+type int8ByteSizeMapValue struct {
+	value *map[int8]ByteSize
+	sep   string
+}
+
+var _ RepeatableFlag = (*int8ByteSizeMapValue)(nil)
+var _ Value = (*int8ByteSizeMapValue)(nil)
+var _ Getter = (*int8ByteSizeMapValue)(nil)
+
+func newInt8ByteSizeMapValue(m *map[int8]ByteSize) *int8ByteSizeMapValue {
+	return &int8ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *int8ByteSizeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8ByteSizeMapValue) Type() string { return "map[int8]ByteSize" }
+
+func (v *int8ByteSizeMapValue) mapSeparator() string { return v.sep }
+
+func (v *int8ByteSizeMapValue) clear() { *v.value = make(map[int8]ByteSize) }
+
+func (v *int8ByteSizeMapValue) appendNext() {}
+
+func (v *int8ByteSizeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int8ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16ByteSizeMapValue
+type int16ByteSizeMapValue struct {
+	value *map[int16]ByteSize
+	sep   string
+}
+
+var _ RepeatableFlag = (*int16ByteSizeMapValue)(nil)
+var _ Value = (*int16ByteSizeMapValue)(nil)
+var _ Getter = (*int16ByteSizeMapValue)(nil)
+
+func newInt16ByteSizeMapValue(m *map[int16]ByteSize) *int16ByteSizeMapValue {
+	return &int16ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *int16ByteSizeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16ByteSizeMapValue) Type() string { return "map[int16]ByteSize" }
+
+func (v *int16ByteSizeMapValue) mapSeparator() string { return v.sep }
+
+func (v *int16ByteSizeMapValue) clear() { *v.value = make(map[int16]ByteSize) }
+
+func (v *int16ByteSizeMapValue) appendNext() {}
+
+func (v *int16ByteSizeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int16ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32ByteSizeMapValue
+type int32ByteSizeMapValue struct {
+	value *map[int32]ByteSize
+	sep   string
+}
+
+var _ RepeatableFlag = (*int32ByteSizeMapValue)(nil)
+var _ Value = (*int32ByteSizeMapValue)(nil)
+var _ Getter = (*int32ByteSizeMapValue)(nil)
+
+func newInt32ByteSizeMapValue(m *map[int32]ByteSize) *int32ByteSizeMapValue {
+	return &int32ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *int32ByteSizeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32ByteSizeMapValue) Type() string { return "map[int32]ByteSize" }
+
+func (v *int32ByteSizeMapValue) mapSeparator() string { return v.sep }
+
+func (v *int32ByteSizeMapValue) clear() { *v.value = make(map[int32]ByteSize) }
+
+func (v *int32ByteSizeMapValue) appendNext() {}
+
+func (v *int32ByteSizeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int32ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64ByteSizeMapValue
+type int64ByteSizeMapValue struct {
+	value *map[int64]ByteSize
+	sep   string
+}
+
+var _ RepeatableFlag = (*int64ByteSizeMapValue)(nil)
+var _ Value = (*int64ByteSizeMapValue)(nil)
+var _ Getter = (*int64ByteSizeMapValue)(nil)
+
+func newInt64ByteSizeMapValue(m *map[int64]ByteSize) *int64ByteSizeMapValue {
+	return &int64ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *int64ByteSizeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := parseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64ByteSizeMapValue) Type() string { return "map[int64]ByteSize" }
+
+func (v *int64ByteSizeMapValue) mapSeparator() string { return v.sep }
+
+func (v *int64ByteSizeMapValue) clear() { *v.value = make(map[int64]ByteSize) }
+
+func (v *int64ByteSizeMapValue) appendNext() {}
+
+func (v *int64ByteSizeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *int64ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintByteSizeMapValue
+type uintByteSizeMapValue struct {
+	value *map[uint]ByteSize
+	sep   string
+}
+
+var _ RepeatableFlag = (*uintByteSizeMapValue)(nil)
+var _ Value = (*uintByteSizeMapValue)(nil)
+var _ Getter = (*uintByteSizeMapValue)(nil)
+
+func newUintByteSizeMapValue(m *map[uint]ByteSize) *uintByteSizeMapValue {
+	return &uintByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *uintByteSizeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintByteSizeMapValue) Type() string { return "map[uint]ByteSize" }
+
+func (v *uintByteSizeMapValue) mapSeparator() string { return v.sep }
+
+func (v *uintByteSizeMapValue) clear() { *v.value = make(map[uint]ByteSize) }
+
+func (v *uintByteSizeMapValue) appendNext() {}
+
+func (v *uintByteSizeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uintByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8ByteSizeMapValue
+type uint8ByteSizeMapValue struct {
+	value *map[uint8]ByteSize
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint8ByteSizeMapValue)(nil)
+var _ Value = (*uint8ByteSizeMapValue)(nil)
+var _ Getter = (*uint8ByteSizeMapValue)(nil)
+
+func newUint8ByteSizeMapValue(m *map[uint8]ByteSize) *uint8ByteSizeMapValue {
+	return &uint8ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8ByteSizeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8ByteSizeMapValue) Type() string { return "map[uint8]ByteSize" }
+
+func (v *uint8ByteSizeMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint8ByteSizeMapValue) clear() { *v.value = make(map[uint8]ByteSize) }
+
+func (v *uint8ByteSizeMapValue) appendNext() {}
+
+func (v *uint8ByteSizeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint8ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16ByteSizeMapValue
+type uint16ByteSizeMapValue struct {
+	value *map[uint16]ByteSize
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint16ByteSizeMapValue)(nil)
+var _ Value = (*uint16ByteSizeMapValue)(nil)
+var _ Getter = (*uint16ByteSizeMapValue)(nil)
+
+func newUint16ByteSizeMapValue(m *map[uint16]ByteSize) *uint16ByteSizeMapValue {
+	return &uint16ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16ByteSizeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16ByteSizeMapValue) Type() string { return "map[uint16]ByteSize" }
+
+func (v *uint16ByteSizeMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint16ByteSizeMapValue) clear() { *v.value = make(map[uint16]ByteSize) }
+
+func (v *uint16ByteSizeMapValue) appendNext() {}
+
+func (v *uint16ByteSizeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint16ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32ByteSizeMapValue
+type uint32ByteSizeMapValue struct {
+	value *map[uint32]ByteSize
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint32ByteSizeMapValue)(nil)
+var _ Value = (*uint32ByteSizeMapValue)(nil)
+var _ Getter = (*uint32ByteSizeMapValue)(nil)
+
+func newUint32ByteSizeMapValue(m *map[uint32]ByteSize) *uint32ByteSizeMapValue {
+	return &uint32ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32ByteSizeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = rawVal
+
+	parsedVal, err := parseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32ByteSizeMapValue) Type() string { return "map[uint32]ByteSize" }
+
+func (v *uint32ByteSizeMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint32ByteSizeMapValue) clear() { *v.value = make(map[uint32]ByteSize) }
+
+func (v *uint32ByteSizeMapValue) appendNext() {}
+
+func (v *uint32ByteSizeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint32ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64ByteSizeMapValue
+type uint64ByteSizeMapValue struct {
+	value *map[uint64]ByteSize
+	sep   string
+}
+
+var _ RepeatableFlag = (*uint64ByteSizeMapValue)(nil)
+var _ Value = (*uint64ByteSizeMapValue)(nil)
+var _ Getter = (*uint64ByteSizeMapValue)(nil)
+
+func newUint64ByteSizeMapValue(m *map[uint64]ByteSize) *uint64ByteSizeMapValue {
+	return &uint64ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64ByteSizeMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+
+	s = rawKey
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = rawVal
+
+	parsedVal, err := parseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64ByteSizeMapValue) Type() string { return "map[uint64]ByteSize" }
+
+func (v *uint64ByteSizeMapValue) mapSeparator() string { return v.sep }
+
+func (v *uint64ByteSizeMapValue) clear() { *v.value = make(map[uint64]ByteSize) }
+
+func (v *uint64ByteSizeMapValue) appendNext() {}
+
+func (v *uint64ByteSizeMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+func (v *uint64ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
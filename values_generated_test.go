@@ -3,7 +3,11 @@ package sflags
 // This file is autogenerated by "go generate .". Do not modify.
 
 import (
+	"math/big"
 	"net"
+	"net/netip"
+	"net/url"
+	"os"
 	"regexp"
 	"testing"
 	"time"
@@ -8968,7 +8972,7 @@ func TestDurationValue(t *testing.T) {
 		v := newDurationValue(a)
 		assert.Equal(t, parseGenerated(a), v)
 		err := v.Set("3l")
-		assert.EqualError(t, err, "time: unknown unit l in duration 3l")
+		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, "0s", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "duration", v.Type())
@@ -9106,7 +9110,7 @@ func TestDurationSliceValue(t *testing.T) {
 		assert.Equal(t, parseGenerated(a), v)
 		assert.True(t, v.IsCumulative())
 		err = v.Set("1s,3l")
-		assert.EqualError(t, err, "time: unknown unit l in duration 3l")
+		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, "[]", v.String())
 		assert.Equal(t, *a, v.Get())
 		assert.Equal(t, "durationSlice", v.Type())
@@ -9142,7 +9146,7 @@ func TestStringDurationMapValue(t *testing.T) {
 		err = v.Set("vvmsI3l")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set("DSJeK:3l")
-		assert.EqualError(t, err, "time: unknown unit l in duration 3l")
+		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -9183,7 +9187,7 @@ func TestIntDurationMapValue(t *testing.T) {
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("0:3l")
-		assert.EqualError(t, err, "time: unknown unit l in duration 3l")
+		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -9224,7 +9228,7 @@ func TestInt8DurationMapValue(t *testing.T) {
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("5:3l")
-		assert.EqualError(t, err, "time: unknown unit l in duration 3l")
+		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -9265,7 +9269,7 @@ func TestInt16DurationMapValue(t *testing.T) {
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("1:3l")
-		assert.EqualError(t, err, "time: unknown unit l in duration 3l")
+		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -9306,7 +9310,7 @@ func TestInt32DurationMapValue(t *testing.T) {
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("3:3l")
-		assert.EqualError(t, err, "time: unknown unit l in duration 3l")
+		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -9347,7 +9351,7 @@ func TestInt64DurationMapValue(t *testing.T) {
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("1:3l")
-		assert.EqualError(t, err, "time: unknown unit l in duration 3l")
+		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -9388,7 +9392,7 @@ func TestUintDurationMapValue(t *testing.T) {
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("3:3l")
-		assert.EqualError(t, err, "time: unknown unit l in duration 3l")
+		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -9429,7 +9433,7 @@ func TestUint8DurationMapValue(t *testing.T) {
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("6:3l")
-		assert.EqualError(t, err, "time: unknown unit l in duration 3l")
+		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -9470,7 +9474,7 @@ func TestUint16DurationMapValue(t *testing.T) {
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("4:3l")
-		assert.EqualError(t, err, "time: unknown unit l in duration 3l")
+		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -9511,7 +9515,7 @@ func TestUint32DurationMapValue(t *testing.T) {
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("1:3l")
-		assert.EqualError(t, err, "time: unknown unit l in duration 3l")
+		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -9552,7 +9556,7 @@ func TestUint64DurationMapValue(t *testing.T) {
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
 		err = v.Set("3:3l")
-		assert.EqualError(t, err, "time: unknown unit l in duration 3l")
+		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]time.Duration", v.Type())
 		assert.Empty(t, v.String())
@@ -12175,6 +12179,7420 @@ func TestUint64IPNetMapValue(t *testing.T) {
 	})
 }
 
+func TestURLValue_Zero(t *testing.T) {
+	nilValue := new(urlValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*urlValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestURLValue(t *testing.T) {
+	t.Run("in: https://example.com/path?q=1", func(t *testing.T) {
+		a := new(url.URL)
+		v := newURLValue(&a)
+		assert.Equal(t, parseGeneratedPtrs(&a), v)
+		err := v.Set("https://example.com/path?q=1")
+		assert.Nil(t, err)
+		assert.Equal(t, "https://example.com/path?q=1", v.String())
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "url", v.Type())
+	})
+	t.Run("in: ://bad", func(t *testing.T) {
+		a := new(url.URL)
+		v := newURLValue(&a)
+		assert.Equal(t, parseGeneratedPtrs(&a), v)
+		err := v.Set("://bad")
+		assert.EqualError(t, err, "parse \"://bad\": missing protocol scheme")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "url", v.Type())
+	})
+
+}
+
+func TestURLSliceValue_Zero(t *testing.T) {
+	nilValue := new(urlSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*urlSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringURLMapValue_Zero(t *testing.T) {
+	var nilValue stringURLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringURLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntURLMapValue_Zero(t *testing.T) {
+	var nilValue intURLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intURLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8URLMapValue_Zero(t *testing.T) {
+	var nilValue int8URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16URLMapValue_Zero(t *testing.T) {
+	var nilValue int16URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32URLMapValue_Zero(t *testing.T) {
+	var nilValue int32URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64URLMapValue_Zero(t *testing.T) {
+	var nilValue int64URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintURLMapValue_Zero(t *testing.T) {
+	var nilValue uintURLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintURLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8URLMapValue_Zero(t *testing.T) {
+	var nilValue uint8URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16URLMapValue_Zero(t *testing.T) {
+	var nilValue uint16URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32URLMapValue_Zero(t *testing.T) {
+	var nilValue uint32URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64URLMapValue_Zero(t *testing.T) {
+	var nilValue uint64URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestURLSliceValue(t *testing.T) {
+	t.Run("in: [http://a.com,http://b.com http://c.com]", func(t *testing.T) {
+		var err error
+		a := new([]*url.URL)
+		v := newURLSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("http://a.com,http://b.com")
+		assert.Nil(t, err)
+		err = v.Set("http://c.com")
+		assert.Nil(t, err)
+		assert.Equal(t, "[http://a.com,http://b.com,http://c.com]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "urlSlice", v.Type())
+	})
+	t.Run("in: [http://a.com,://bad]", func(t *testing.T) {
+		var err error
+		a := new([]*url.URL)
+		v := newURLSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("http://a.com,://bad")
+		assert.EqualError(t, err, "parse \"://bad\": missing protocol scheme")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "urlSlice", v.Type())
+	})
+
+}
+
+func TestStringURLMapValue(t *testing.T) {
+	t.Run("in: [https://example.com/path?q=1 http://localhost:8080]", func(t *testing.T) {
+		var err error
+		a := make(map[string]*url.URL)
+		v := newStringURLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("ryhmS:https://example.com/path?q=1")
+		assert.Nil(t, err)
+		err = v.Set("laOSS:http://localhost:8080")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]*url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [://bad]", func(t *testing.T) {
+		var err error
+		a := make(map[string]*url.URL)
+		v := newStringURLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("UVsZs:://bad")
+		assert.EqualError(t, err, "parse \"://bad\": missing protocol scheme")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]*url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntURLMapValue(t *testing.T) {
+	t.Run("in: [https://example.com/path?q=1 http://localhost:8080]", func(t *testing.T) {
+		var err error
+		a := make(map[int]*url.URL)
+		v := newIntURLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":https://example.com/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("4:https://example.com/path?q=1")
+		assert.Nil(t, err)
+		err = v.Set(":http://localhost:8080")
+		assert.NotNil(t, err)
+		err = v.Set("1:http://localhost:8080")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]*url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [://bad]", func(t *testing.T) {
+		var err error
+		a := make(map[int]*url.URL)
+		v := newIntURLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":://bad")
+		assert.NotNil(t, err)
+		err = v.Set("0:://bad")
+		assert.EqualError(t, err, "parse \"://bad\": missing protocol scheme")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]*url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8URLMapValue(t *testing.T) {
+	t.Run("in: [https://example.com/path?q=1 http://localhost:8080]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]*url.URL)
+		v := newInt8URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":https://example.com/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("0:https://example.com/path?q=1")
+		assert.Nil(t, err)
+		err = v.Set(":http://localhost:8080")
+		assert.NotNil(t, err)
+		err = v.Set("3:http://localhost:8080")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]*url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [://bad]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]*url.URL)
+		v := newInt8URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":://bad")
+		assert.NotNil(t, err)
+		err = v.Set("2:://bad")
+		assert.EqualError(t, err, "parse \"://bad\": missing protocol scheme")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]*url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16URLMapValue(t *testing.T) {
+	t.Run("in: [https://example.com/path?q=1 http://localhost:8080]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]*url.URL)
+		v := newInt16URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":https://example.com/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("4:https://example.com/path?q=1")
+		assert.Nil(t, err)
+		err = v.Set(":http://localhost:8080")
+		assert.NotNil(t, err)
+		err = v.Set("0:http://localhost:8080")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]*url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [://bad]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]*url.URL)
+		v := newInt16URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":://bad")
+		assert.NotNil(t, err)
+		err = v.Set("1:://bad")
+		assert.EqualError(t, err, "parse \"://bad\": missing protocol scheme")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]*url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32URLMapValue(t *testing.T) {
+	t.Run("in: [https://example.com/path?q=1 http://localhost:8080]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]*url.URL)
+		v := newInt32URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":https://example.com/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("0:https://example.com/path?q=1")
+		assert.Nil(t, err)
+		err = v.Set(":http://localhost:8080")
+		assert.NotNil(t, err)
+		err = v.Set("3:http://localhost:8080")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]*url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [://bad]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]*url.URL)
+		v := newInt32URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":://bad")
+		assert.NotNil(t, err)
+		err = v.Set("4:://bad")
+		assert.EqualError(t, err, "parse \"://bad\": missing protocol scheme")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]*url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64URLMapValue(t *testing.T) {
+	t.Run("in: [https://example.com/path?q=1 http://localhost:8080]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]*url.URL)
+		v := newInt64URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":https://example.com/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("4:https://example.com/path?q=1")
+		assert.Nil(t, err)
+		err = v.Set(":http://localhost:8080")
+		assert.NotNil(t, err)
+		err = v.Set("4:http://localhost:8080")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]*url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [://bad]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]*url.URL)
+		v := newInt64URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":://bad")
+		assert.NotNil(t, err)
+		err = v.Set("7:://bad")
+		assert.EqualError(t, err, "parse \"://bad\": missing protocol scheme")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]*url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintURLMapValue(t *testing.T) {
+	t.Run("in: [https://example.com/path?q=1 http://localhost:8080]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]*url.URL)
+		v := newUintURLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":https://example.com/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("1:https://example.com/path?q=1")
+		assert.Nil(t, err)
+		err = v.Set(":http://localhost:8080")
+		assert.NotNil(t, err)
+		err = v.Set("5:http://localhost:8080")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]*url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [://bad]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]*url.URL)
+		v := newUintURLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":://bad")
+		assert.NotNil(t, err)
+		err = v.Set("4:://bad")
+		assert.EqualError(t, err, "parse \"://bad\": missing protocol scheme")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]*url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8URLMapValue(t *testing.T) {
+	t.Run("in: [https://example.com/path?q=1 http://localhost:8080]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]*url.URL)
+		v := newUint8URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":https://example.com/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("0:https://example.com/path?q=1")
+		assert.Nil(t, err)
+		err = v.Set(":http://localhost:8080")
+		assert.NotNil(t, err)
+		err = v.Set("0:http://localhost:8080")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]*url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [://bad]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]*url.URL)
+		v := newUint8URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":://bad")
+		assert.NotNil(t, err)
+		err = v.Set("4:://bad")
+		assert.EqualError(t, err, "parse \"://bad\": missing protocol scheme")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]*url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16URLMapValue(t *testing.T) {
+	t.Run("in: [https://example.com/path?q=1 http://localhost:8080]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]*url.URL)
+		v := newUint16URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":https://example.com/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("1:https://example.com/path?q=1")
+		assert.Nil(t, err)
+		err = v.Set(":http://localhost:8080")
+		assert.NotNil(t, err)
+		err = v.Set("3:http://localhost:8080")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]*url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [://bad]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]*url.URL)
+		v := newUint16URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":://bad")
+		assert.NotNil(t, err)
+		err = v.Set("6:://bad")
+		assert.EqualError(t, err, "parse \"://bad\": missing protocol scheme")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]*url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32URLMapValue(t *testing.T) {
+	t.Run("in: [https://example.com/path?q=1 http://localhost:8080]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]*url.URL)
+		v := newUint32URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":https://example.com/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("2:https://example.com/path?q=1")
+		assert.Nil(t, err)
+		err = v.Set(":http://localhost:8080")
+		assert.NotNil(t, err)
+		err = v.Set("4:http://localhost:8080")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]*url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [://bad]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]*url.URL)
+		v := newUint32URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":://bad")
+		assert.NotNil(t, err)
+		err = v.Set("2:://bad")
+		assert.EqualError(t, err, "parse \"://bad\": missing protocol scheme")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]*url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64URLMapValue(t *testing.T) {
+	t.Run("in: [https://example.com/path?q=1 http://localhost:8080]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]*url.URL)
+		v := newUint64URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":https://example.com/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("5:https://example.com/path?q=1")
+		assert.Nil(t, err)
+		err = v.Set(":http://localhost:8080")
+		assert.NotNil(t, err)
+		err = v.Set("4:http://localhost:8080")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]*url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [://bad]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]*url.URL)
+		v := newUint64URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":://bad")
+		assert.NotNil(t, err)
+		err = v.Set("3:://bad")
+		assert.EqualError(t, err, "parse \"://bad\": missing protocol scheme")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]*url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestTimeValue_Zero(t *testing.T) {
+	nilValue := new(timeValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*timeValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestTimeValue(t *testing.T) {
+	t.Run("in: 2020-01-02T03:04:05Z", func(t *testing.T) {
+		a := new(time.Time)
+		v := newTimeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("2020-01-02T03:04:05Z")
+		assert.Nil(t, err)
+		assert.Equal(t, "2020-01-02T03:04:05Z", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "time", v.Type())
+	})
+	t.Run("in: 2020-01-02", func(t *testing.T) {
+		a := new(time.Time)
+		v := newTimeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("2020-01-02")
+		assert.Nil(t, err)
+		assert.Equal(t, "2020-01-02T00:00:00Z", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "time", v.Type())
+	})
+	t.Run("in: yesterday", func(t *testing.T) {
+		a := new(time.Time)
+		v := newTimeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("yesterday")
+		assert.EqualError(t, err, "failed to parse time: \"yesterday\", allowed layouts: 2006-01-02T15:04:05.999999999Z07:00, 2006-01-02")
+		assert.Equal(t, "0001-01-01T00:00:00Z", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "time", v.Type())
+	})
+
+}
+
+func TestTimeSliceValue_Zero(t *testing.T) {
+	nilValue := new(timeSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*timeSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringTimeMapValue_Zero(t *testing.T) {
+	var nilValue stringTimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringTimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntTimeMapValue_Zero(t *testing.T) {
+	var nilValue intTimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intTimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8TimeMapValue_Zero(t *testing.T) {
+	var nilValue int8TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16TimeMapValue_Zero(t *testing.T) {
+	var nilValue int16TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32TimeMapValue_Zero(t *testing.T) {
+	var nilValue int32TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64TimeMapValue_Zero(t *testing.T) {
+	var nilValue int64TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintTimeMapValue_Zero(t *testing.T) {
+	var nilValue uintTimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintTimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8TimeMapValue_Zero(t *testing.T) {
+	var nilValue uint8TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16TimeMapValue_Zero(t *testing.T) {
+	var nilValue uint16TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32TimeMapValue_Zero(t *testing.T) {
+	var nilValue uint32TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64TimeMapValue_Zero(t *testing.T) {
+	var nilValue uint64TimeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64TimeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestTimeSliceValue(t *testing.T) {
+	t.Run("in: [2020-01-02,2020-01-03 2020-01-04T00:00:00Z]", func(t *testing.T) {
+		var err error
+		a := new([]time.Time)
+		v := newTimeSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2020-01-02,2020-01-03")
+		assert.Nil(t, err)
+		err = v.Set("2020-01-04T00:00:00Z")
+		assert.Nil(t, err)
+		assert.Equal(t, "[2020-01-02T00:00:00Z,2020-01-03T00:00:00Z,2020-01-04T00:00:00Z]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "timeSlice", v.Type())
+	})
+	t.Run("in: [2020-01-02,yesterday]", func(t *testing.T) {
+		var err error
+		a := new([]time.Time)
+		v := newTimeSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2020-01-02,yesterday")
+		assert.EqualError(t, err, "failed to parse time: \"yesterday\", allowed layouts: 2006-01-02T15:04:05.999999999Z07:00, 2006-01-02")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "timeSlice", v.Type())
+	})
+
+}
+
+func TestStringTimeMapValue(t *testing.T) {
+	t.Run("in: [2020-01-02T03:04:05Z 2020-01-02]", func(t *testing.T) {
+		var err error
+		a := make(map[string]time.Time)
+		v := newStringTimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("fpvpk:2020-01-02T03:04:05Z")
+		assert.Nil(t, err)
+		err = v.Set("HdgWh2020-01-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("xbhMS:2020-01-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]time.Time", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [yesterday]", func(t *testing.T) {
+		var err error
+		a := make(map[string]time.Time)
+		v := newStringTimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("AcmaAyesterday")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("vASRD:yesterday")
+		assert.EqualError(t, err, "failed to parse time: \"yesterday\", allowed layouts: 2006-01-02T15:04:05.999999999Z07:00, 2006-01-02")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]time.Time", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntTimeMapValue(t *testing.T) {
+	t.Run("in: [2020-01-02T03:04:05Z 2020-01-02]", func(t *testing.T) {
+		var err error
+		a := make(map[int]time.Time)
+		v := newIntTimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":2020-01-02T03:04:05Z")
+		assert.NotNil(t, err)
+		err = v.Set("3:2020-01-02T03:04:05Z")
+		assert.Nil(t, err)
+		err = v.Set("12020-01-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2020-01-02")
+		assert.NotNil(t, err)
+		err = v.Set("7:2020-01-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]time.Time", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [yesterday]", func(t *testing.T) {
+		var err error
+		a := make(map[int]time.Time)
+		v := newIntTimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5yesterday")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":yesterday")
+		assert.NotNil(t, err)
+		err = v.Set("4:yesterday")
+		assert.EqualError(t, err, "failed to parse time: \"yesterday\", allowed layouts: 2006-01-02T15:04:05.999999999Z07:00, 2006-01-02")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]time.Time", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8TimeMapValue(t *testing.T) {
+	t.Run("in: [2020-01-02T03:04:05Z 2020-01-02]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]time.Time)
+		v := newInt8TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":2020-01-02T03:04:05Z")
+		assert.NotNil(t, err)
+		err = v.Set("1:2020-01-02T03:04:05Z")
+		assert.Nil(t, err)
+		err = v.Set("22020-01-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2020-01-02")
+		assert.NotNil(t, err)
+		err = v.Set("4:2020-01-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]time.Time", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [yesterday]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]time.Time)
+		v := newInt8TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5yesterday")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":yesterday")
+		assert.NotNil(t, err)
+		err = v.Set("2:yesterday")
+		assert.EqualError(t, err, "failed to parse time: \"yesterday\", allowed layouts: 2006-01-02T15:04:05.999999999Z07:00, 2006-01-02")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]time.Time", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16TimeMapValue(t *testing.T) {
+	t.Run("in: [2020-01-02T03:04:05Z 2020-01-02]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]time.Time)
+		v := newInt16TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":2020-01-02T03:04:05Z")
+		assert.NotNil(t, err)
+		err = v.Set("4:2020-01-02T03:04:05Z")
+		assert.Nil(t, err)
+		err = v.Set("12020-01-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2020-01-02")
+		assert.NotNil(t, err)
+		err = v.Set("1:2020-01-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]time.Time", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [yesterday]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]time.Time)
+		v := newInt16TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3yesterday")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":yesterday")
+		assert.NotNil(t, err)
+		err = v.Set("0:yesterday")
+		assert.EqualError(t, err, "failed to parse time: \"yesterday\", allowed layouts: 2006-01-02T15:04:05.999999999Z07:00, 2006-01-02")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]time.Time", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32TimeMapValue(t *testing.T) {
+	t.Run("in: [2020-01-02T03:04:05Z 2020-01-02]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]time.Time)
+		v := newInt32TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":2020-01-02T03:04:05Z")
+		assert.NotNil(t, err)
+		err = v.Set("4:2020-01-02T03:04:05Z")
+		assert.Nil(t, err)
+		err = v.Set("22020-01-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2020-01-02")
+		assert.NotNil(t, err)
+		err = v.Set("6:2020-01-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]time.Time", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [yesterday]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]time.Time)
+		v := newInt32TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6yesterday")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":yesterday")
+		assert.NotNil(t, err)
+		err = v.Set("5:yesterday")
+		assert.EqualError(t, err, "failed to parse time: \"yesterday\", allowed layouts: 2006-01-02T15:04:05.999999999Z07:00, 2006-01-02")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]time.Time", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64TimeMapValue(t *testing.T) {
+	t.Run("in: [2020-01-02T03:04:05Z 2020-01-02]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]time.Time)
+		v := newInt64TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":2020-01-02T03:04:05Z")
+		assert.NotNil(t, err)
+		err = v.Set("0:2020-01-02T03:04:05Z")
+		assert.Nil(t, err)
+		err = v.Set("62020-01-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2020-01-02")
+		assert.NotNil(t, err)
+		err = v.Set("7:2020-01-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]time.Time", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [yesterday]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]time.Time)
+		v := newInt64TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3yesterday")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":yesterday")
+		assert.NotNil(t, err)
+		err = v.Set("6:yesterday")
+		assert.EqualError(t, err, "failed to parse time: \"yesterday\", allowed layouts: 2006-01-02T15:04:05.999999999Z07:00, 2006-01-02")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]time.Time", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintTimeMapValue(t *testing.T) {
+	t.Run("in: [2020-01-02T03:04:05Z 2020-01-02]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]time.Time)
+		v := newUintTimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":2020-01-02T03:04:05Z")
+		assert.NotNil(t, err)
+		err = v.Set("2:2020-01-02T03:04:05Z")
+		assert.Nil(t, err)
+		err = v.Set("72020-01-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2020-01-02")
+		assert.NotNil(t, err)
+		err = v.Set("4:2020-01-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]time.Time", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [yesterday]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]time.Time)
+		v := newUintTimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6yesterday")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":yesterday")
+		assert.NotNil(t, err)
+		err = v.Set("4:yesterday")
+		assert.EqualError(t, err, "failed to parse time: \"yesterday\", allowed layouts: 2006-01-02T15:04:05.999999999Z07:00, 2006-01-02")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]time.Time", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8TimeMapValue(t *testing.T) {
+	t.Run("in: [2020-01-02T03:04:05Z 2020-01-02]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]time.Time)
+		v := newUint8TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":2020-01-02T03:04:05Z")
+		assert.NotNil(t, err)
+		err = v.Set("1:2020-01-02T03:04:05Z")
+		assert.Nil(t, err)
+		err = v.Set("02020-01-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2020-01-02")
+		assert.NotNil(t, err)
+		err = v.Set("4:2020-01-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]time.Time", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [yesterday]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]time.Time)
+		v := newUint8TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6yesterday")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":yesterday")
+		assert.NotNil(t, err)
+		err = v.Set("7:yesterday")
+		assert.EqualError(t, err, "failed to parse time: \"yesterday\", allowed layouts: 2006-01-02T15:04:05.999999999Z07:00, 2006-01-02")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]time.Time", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16TimeMapValue(t *testing.T) {
+	t.Run("in: [2020-01-02T03:04:05Z 2020-01-02]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]time.Time)
+		v := newUint16TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":2020-01-02T03:04:05Z")
+		assert.NotNil(t, err)
+		err = v.Set("5:2020-01-02T03:04:05Z")
+		assert.Nil(t, err)
+		err = v.Set("42020-01-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2020-01-02")
+		assert.NotNil(t, err)
+		err = v.Set("1:2020-01-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]time.Time", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [yesterday]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]time.Time)
+		v := newUint16TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6yesterday")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":yesterday")
+		assert.NotNil(t, err)
+		err = v.Set("6:yesterday")
+		assert.EqualError(t, err, "failed to parse time: \"yesterday\", allowed layouts: 2006-01-02T15:04:05.999999999Z07:00, 2006-01-02")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]time.Time", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32TimeMapValue(t *testing.T) {
+	t.Run("in: [2020-01-02T03:04:05Z 2020-01-02]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]time.Time)
+		v := newUint32TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":2020-01-02T03:04:05Z")
+		assert.NotNil(t, err)
+		err = v.Set("6:2020-01-02T03:04:05Z")
+		assert.Nil(t, err)
+		err = v.Set("22020-01-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2020-01-02")
+		assert.NotNil(t, err)
+		err = v.Set("0:2020-01-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]time.Time", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [yesterday]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]time.Time)
+		v := newUint32TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7yesterday")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":yesterday")
+		assert.NotNil(t, err)
+		err = v.Set("4:yesterday")
+		assert.EqualError(t, err, "failed to parse time: \"yesterday\", allowed layouts: 2006-01-02T15:04:05.999999999Z07:00, 2006-01-02")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]time.Time", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64TimeMapValue(t *testing.T) {
+	t.Run("in: [2020-01-02T03:04:05Z 2020-01-02]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]time.Time)
+		v := newUint64TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":2020-01-02T03:04:05Z")
+		assert.NotNil(t, err)
+		err = v.Set("6:2020-01-02T03:04:05Z")
+		assert.Nil(t, err)
+		err = v.Set("42020-01-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2020-01-02")
+		assert.NotNil(t, err)
+		err = v.Set("7:2020-01-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]time.Time", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [yesterday]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]time.Time)
+		v := newUint64TimeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1yesterday")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":yesterday")
+		assert.NotNil(t, err)
+		err = v.Set("0:yesterday")
+		assert.EqualError(t, err, "failed to parse time: \"yesterday\", allowed layouts: 2006-01-02T15:04:05.999999999Z07:00, 2006-01-02")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]time.Time", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestNetipAddrValue_Zero(t *testing.T) {
+	nilValue := new(netipAddrValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*netipAddrValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestNetipAddrValue(t *testing.T) {
+	t.Run("in: 127.0.0.1", func(t *testing.T) {
+		a := new(netip.Addr)
+		v := newNetipAddrValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("127.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, "127.0.0.1", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "netipAddr", v.Type())
+	})
+	t.Run("in: ::1", func(t *testing.T) {
+		a := new(netip.Addr)
+		v := newNetipAddrValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("::1")
+		assert.Nil(t, err)
+		assert.Equal(t, "::1", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "netipAddr", v.Type())
+	})
+	t.Run("in: bad", func(t *testing.T) {
+		a := new(netip.Addr)
+		v := newNetipAddrValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("bad")
+		assert.EqualError(t, err, "ParseAddr(\"bad\"): unable to parse IP")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "netipAddr", v.Type())
+	})
+
+}
+
+func TestNetipAddrSliceValue_Zero(t *testing.T) {
+	nilValue := new(netipAddrSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*netipAddrSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringNetipAddrMapValue_Zero(t *testing.T) {
+	var nilValue stringNetipAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringNetipAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntNetipAddrMapValue_Zero(t *testing.T) {
+	var nilValue intNetipAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intNetipAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8NetipAddrMapValue_Zero(t *testing.T) {
+	var nilValue int8NetipAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8NetipAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16NetipAddrMapValue_Zero(t *testing.T) {
+	var nilValue int16NetipAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16NetipAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32NetipAddrMapValue_Zero(t *testing.T) {
+	var nilValue int32NetipAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32NetipAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64NetipAddrMapValue_Zero(t *testing.T) {
+	var nilValue int64NetipAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64NetipAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintNetipAddrMapValue_Zero(t *testing.T) {
+	var nilValue uintNetipAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintNetipAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8NetipAddrMapValue_Zero(t *testing.T) {
+	var nilValue uint8NetipAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8NetipAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16NetipAddrMapValue_Zero(t *testing.T) {
+	var nilValue uint16NetipAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16NetipAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32NetipAddrMapValue_Zero(t *testing.T) {
+	var nilValue uint32NetipAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32NetipAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64NetipAddrMapValue_Zero(t *testing.T) {
+	var nilValue uint64NetipAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64NetipAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestNetipAddrSliceValue(t *testing.T) {
+	t.Run("in: [127.0.0.1,::1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := new([]netip.Addr)
+		v := newNetipAddrSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("127.0.0.1,::1")
+		assert.Nil(t, err)
+		err = v.Set("10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, "[127.0.0.1,::1,10.0.0.1]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "netipAddrSlice", v.Type())
+	})
+	t.Run("in: [127.0.0.1,bad]", func(t *testing.T) {
+		var err error
+		a := new([]netip.Addr)
+		v := newNetipAddrSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("127.0.0.1,bad")
+		assert.EqualError(t, err, "ParseAddr(\"bad\"): unable to parse IP")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "netipAddrSlice", v.Type())
+	})
+
+}
+
+func TestStringNetipAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[string]netip.Addr)
+		v := newStringNetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("MvADv127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("ezKym:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("xCWrw10.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("lUxbd:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[string]netip.Addr)
+		v := newStringNetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("MAyhEbad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("cgCTa:bad")
+		assert.EqualError(t, err, "ParseAddr(\"bad\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntNetipAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int]netip.Addr)
+		v := newIntNetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("7:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("010.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("7:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[int]netip.Addr)
+		v := newIntNetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("5:bad")
+		assert.EqualError(t, err, "ParseAddr(\"bad\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8NetipAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]netip.Addr)
+		v := newInt8NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("0:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("010.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("0:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]netip.Addr)
+		v := newInt8NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("2:bad")
+		assert.EqualError(t, err, "ParseAddr(\"bad\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16NetipAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]netip.Addr)
+		v := newInt16NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("1:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("510.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("7:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]netip.Addr)
+		v := newInt16NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("6:bad")
+		assert.EqualError(t, err, "ParseAddr(\"bad\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32NetipAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]netip.Addr)
+		v := newInt32NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("4:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("610.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("1:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]netip.Addr)
+		v := newInt32NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("1:bad")
+		assert.EqualError(t, err, "ParseAddr(\"bad\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64NetipAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]netip.Addr)
+		v := newInt64NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("5:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("210.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("6:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]netip.Addr)
+		v := newInt64NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("4:bad")
+		assert.EqualError(t, err, "ParseAddr(\"bad\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintNetipAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]netip.Addr)
+		v := newUintNetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("4:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("610.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("7:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]netip.Addr)
+		v := newUintNetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("5:bad")
+		assert.EqualError(t, err, "ParseAddr(\"bad\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8NetipAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]netip.Addr)
+		v := newUint8NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("3:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("610.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("4:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]netip.Addr)
+		v := newUint8NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("3:bad")
+		assert.EqualError(t, err, "ParseAddr(\"bad\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16NetipAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]netip.Addr)
+		v := newUint16NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("1:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("510.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("1:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]netip.Addr)
+		v := newUint16NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("4:bad")
+		assert.EqualError(t, err, "ParseAddr(\"bad\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32NetipAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]netip.Addr)
+		v := newUint32NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("4:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("510.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("3:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]netip.Addr)
+		v := newUint32NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("6:bad")
+		assert.EqualError(t, err, "ParseAddr(\"bad\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64NetipAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]netip.Addr)
+		v := newUint64NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("2:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("110.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("5:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]netip.Addr)
+		v := newUint64NetipAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("6:bad")
+		assert.EqualError(t, err, "ParseAddr(\"bad\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestNetipPrefixValue_Zero(t *testing.T) {
+	nilValue := new(netipPrefixValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*netipPrefixValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestNetipPrefixValue(t *testing.T) {
+	t.Run("in: 10.0.0.0/8", func(t *testing.T) {
+		a := new(netip.Prefix)
+		v := newNetipPrefixValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("10.0.0.0/8")
+		assert.Nil(t, err)
+		assert.Equal(t, "10.0.0.0/8", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "netipPrefix", v.Type())
+	})
+	t.Run("in: 10.1.2.3/8", func(t *testing.T) {
+		a := new(netip.Prefix)
+		v := newNetipPrefixValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("10.1.2.3/8")
+		assert.Nil(t, err)
+		assert.Equal(t, "10.1.2.3/8", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "netipPrefix", v.Type())
+	})
+	t.Run("in: 10.0.0.0", func(t *testing.T) {
+		a := new(netip.Prefix)
+		v := newNetipPrefixValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "netipPrefix", v.Type())
+	})
+
+}
+
+func TestNetipPrefixSliceValue_Zero(t *testing.T) {
+	nilValue := new(netipPrefixSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*netipPrefixSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringNetipPrefixMapValue_Zero(t *testing.T) {
+	var nilValue stringNetipPrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringNetipPrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntNetipPrefixMapValue_Zero(t *testing.T) {
+	var nilValue intNetipPrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intNetipPrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8NetipPrefixMapValue_Zero(t *testing.T) {
+	var nilValue int8NetipPrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8NetipPrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16NetipPrefixMapValue_Zero(t *testing.T) {
+	var nilValue int16NetipPrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16NetipPrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32NetipPrefixMapValue_Zero(t *testing.T) {
+	var nilValue int32NetipPrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32NetipPrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64NetipPrefixMapValue_Zero(t *testing.T) {
+	var nilValue int64NetipPrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64NetipPrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintNetipPrefixMapValue_Zero(t *testing.T) {
+	var nilValue uintNetipPrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintNetipPrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8NetipPrefixMapValue_Zero(t *testing.T) {
+	var nilValue uint8NetipPrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8NetipPrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16NetipPrefixMapValue_Zero(t *testing.T) {
+	var nilValue uint16NetipPrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16NetipPrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32NetipPrefixMapValue_Zero(t *testing.T) {
+	var nilValue uint32NetipPrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32NetipPrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64NetipPrefixMapValue_Zero(t *testing.T) {
+	var nilValue uint64NetipPrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64NetipPrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestNetipPrefixSliceValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8,192.168.0.0/16 172.16.0.0/12]", func(t *testing.T) {
+		var err error
+		a := new([]netip.Prefix)
+		v := newNetipPrefixSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("10.0.0.0/8,192.168.0.0/16")
+		assert.Nil(t, err)
+		err = v.Set("172.16.0.0/12")
+		assert.Nil(t, err)
+		assert.Equal(t, "[10.0.0.0/8,192.168.0.0/16,172.16.0.0/12]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "netipPrefixSlice", v.Type())
+	})
+	t.Run("in: [10.0.0.0/8,10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := new([]netip.Prefix)
+		v := newNetipPrefixSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("10.0.0.0/8,10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "netipPrefixSlice", v.Type())
+	})
+
+}
+
+func TestStringNetipPrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[string]netip.Prefix)
+		v := newStringNetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("umDoP10.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("OnnQV:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("NsqKk192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("ejfUl:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[string]netip.Prefix)
+		v := newStringNetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("xsWAw10.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("WjtpZ:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntNetipPrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[int]netip.Prefix)
+		v := newIntNetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("310.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("1:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("4192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("7:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[int]netip.Prefix)
+		v := newIntNetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("410.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("5:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8NetipPrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]netip.Prefix)
+		v := newInt8NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("310.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("2:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("6192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("7:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]netip.Prefix)
+		v := newInt8NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("310.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("6:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16NetipPrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]netip.Prefix)
+		v := newInt16NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("510.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("2:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("0192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("3:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]netip.Prefix)
+		v := newInt16NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("310.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("0:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32NetipPrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]netip.Prefix)
+		v := newInt32NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("310.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("4:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("6192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("0:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]netip.Prefix)
+		v := newInt32NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("610.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("2:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64NetipPrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]netip.Prefix)
+		v := newInt64NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("010.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("2:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("0192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("0:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]netip.Prefix)
+		v := newInt64NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("410.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("7:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintNetipPrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]netip.Prefix)
+		v := newUintNetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("410.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("3:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("1192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("7:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]netip.Prefix)
+		v := newUintNetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("710.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("5:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8NetipPrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]netip.Prefix)
+		v := newUint8NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("710.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("7:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("1192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("7:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]netip.Prefix)
+		v := newUint8NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("510.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("5:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16NetipPrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]netip.Prefix)
+		v := newUint16NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("110.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("4:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("3192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("0:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]netip.Prefix)
+		v := newUint16NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("110.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("2:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32NetipPrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]netip.Prefix)
+		v := newUint32NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("010.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("6:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("1192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("2:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]netip.Prefix)
+		v := newUint32NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("510.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("0:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64NetipPrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]netip.Prefix)
+		v := newUint64NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("310.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("3:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("4192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("6:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]netip.Prefix)
+		v := newUint64NetipPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("510.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("4:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestNetipAddrPortValue_Zero(t *testing.T) {
+	nilValue := new(netipAddrPortValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*netipAddrPortValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestNetipAddrPortValue(t *testing.T) {
+	t.Run("in: 127.0.0.1:80", func(t *testing.T) {
+		a := new(netip.AddrPort)
+		v := newNetipAddrPortValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("127.0.0.1:80")
+		assert.Nil(t, err)
+		assert.Equal(t, "127.0.0.1:80", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "netipAddrPort", v.Type())
+	})
+	t.Run("in: [::1]:53", func(t *testing.T) {
+		a := new(netip.AddrPort)
+		v := newNetipAddrPortValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("[::1]:53")
+		assert.Nil(t, err)
+		assert.Equal(t, "[::1]:53", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "netipAddrPort", v.Type())
+	})
+	t.Run("in: 127.0.0.1", func(t *testing.T) {
+		a := new(netip.AddrPort)
+		v := newNetipAddrPortValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("127.0.0.1")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "netipAddrPort", v.Type())
+	})
+
+}
+
+func TestNetipAddrPortSliceValue_Zero(t *testing.T) {
+	nilValue := new(netipAddrPortSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*netipAddrPortSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringNetipAddrPortMapValue_Zero(t *testing.T) {
+	var nilValue stringNetipAddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringNetipAddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntNetipAddrPortMapValue_Zero(t *testing.T) {
+	var nilValue intNetipAddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intNetipAddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8NetipAddrPortMapValue_Zero(t *testing.T) {
+	var nilValue int8NetipAddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8NetipAddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16NetipAddrPortMapValue_Zero(t *testing.T) {
+	var nilValue int16NetipAddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16NetipAddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32NetipAddrPortMapValue_Zero(t *testing.T) {
+	var nilValue int32NetipAddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32NetipAddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64NetipAddrPortMapValue_Zero(t *testing.T) {
+	var nilValue int64NetipAddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64NetipAddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintNetipAddrPortMapValue_Zero(t *testing.T) {
+	var nilValue uintNetipAddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintNetipAddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8NetipAddrPortMapValue_Zero(t *testing.T) {
+	var nilValue uint8NetipAddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8NetipAddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16NetipAddrPortMapValue_Zero(t *testing.T) {
+	var nilValue uint16NetipAddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16NetipAddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32NetipAddrPortMapValue_Zero(t *testing.T) {
+	var nilValue uint32NetipAddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32NetipAddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64NetipAddrPortMapValue_Zero(t *testing.T) {
+	var nilValue uint64NetipAddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64NetipAddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestNetipAddrPortSliceValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:80,127.0.0.2:80 [::1]:53]", func(t *testing.T) {
+		var err error
+		a := new([]netip.AddrPort)
+		v := newNetipAddrPortSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("127.0.0.1:80,127.0.0.2:80")
+		assert.Nil(t, err)
+		err = v.Set("[::1]:53")
+		assert.Nil(t, err)
+		assert.Equal(t, "[127.0.0.1:80,127.0.0.2:80,[::1]:53]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "netipAddrPortSlice", v.Type())
+	})
+	t.Run("in: [127.0.0.1:80,127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := new([]netip.AddrPort)
+		v := newNetipAddrPortSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("127.0.0.1:80,127.0.0.1")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "netipAddrPortSlice", v.Type())
+	})
+
+}
+
+func TestStringNetipAddrPortMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:80 [::1]:53]", func(t *testing.T) {
+		var err error
+		a := make(map[string]netip.AddrPort)
+		v := newStringNetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("IaFON:127.0.0.1:80")
+		assert.Nil(t, err)
+		err = v.Set("Fwrxm:[::1]:53")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]netip.AddrPort", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[string]netip.AddrPort)
+		v := newStringNetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("PGDUa127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("MiraB:127.0.0.1")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntNetipAddrPortMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:80 [::1]:53]", func(t *testing.T) {
+		var err error
+		a := make(map[int]netip.AddrPort)
+		v := newIntNetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:80")
+		assert.NotNil(t, err)
+		err = v.Set("5:127.0.0.1:80")
+		assert.Nil(t, err)
+		err = v.Set(":[::1]:53")
+		assert.NotNil(t, err)
+		err = v.Set("2:[::1]:53")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]netip.AddrPort", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int]netip.AddrPort)
+		v := newIntNetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("0:127.0.0.1")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8NetipAddrPortMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:80 [::1]:53]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]netip.AddrPort)
+		v := newInt8NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:80")
+		assert.NotNil(t, err)
+		err = v.Set("7:127.0.0.1:80")
+		assert.Nil(t, err)
+		err = v.Set(":[::1]:53")
+		assert.NotNil(t, err)
+		err = v.Set("7:[::1]:53")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]netip.AddrPort", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]netip.AddrPort)
+		v := newInt8NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("6:127.0.0.1")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16NetipAddrPortMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:80 [::1]:53]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]netip.AddrPort)
+		v := newInt16NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:80")
+		assert.NotNil(t, err)
+		err = v.Set("0:127.0.0.1:80")
+		assert.Nil(t, err)
+		err = v.Set(":[::1]:53")
+		assert.NotNil(t, err)
+		err = v.Set("1:[::1]:53")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]netip.AddrPort", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]netip.AddrPort)
+		v := newInt16NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("5:127.0.0.1")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32NetipAddrPortMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:80 [::1]:53]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]netip.AddrPort)
+		v := newInt32NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:80")
+		assert.NotNil(t, err)
+		err = v.Set("1:127.0.0.1:80")
+		assert.Nil(t, err)
+		err = v.Set(":[::1]:53")
+		assert.NotNil(t, err)
+		err = v.Set("5:[::1]:53")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]netip.AddrPort", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]netip.AddrPort)
+		v := newInt32NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("2:127.0.0.1")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64NetipAddrPortMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:80 [::1]:53]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]netip.AddrPort)
+		v := newInt64NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:80")
+		assert.NotNil(t, err)
+		err = v.Set("0:127.0.0.1:80")
+		assert.Nil(t, err)
+		err = v.Set(":[::1]:53")
+		assert.NotNil(t, err)
+		err = v.Set("2:[::1]:53")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]netip.AddrPort", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]netip.AddrPort)
+		v := newInt64NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("3:127.0.0.1")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintNetipAddrPortMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:80 [::1]:53]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]netip.AddrPort)
+		v := newUintNetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:80")
+		assert.NotNil(t, err)
+		err = v.Set("5:127.0.0.1:80")
+		assert.Nil(t, err)
+		err = v.Set(":[::1]:53")
+		assert.NotNil(t, err)
+		err = v.Set("6:[::1]:53")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]netip.AddrPort", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]netip.AddrPort)
+		v := newUintNetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("5:127.0.0.1")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8NetipAddrPortMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:80 [::1]:53]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]netip.AddrPort)
+		v := newUint8NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:80")
+		assert.NotNil(t, err)
+		err = v.Set("5:127.0.0.1:80")
+		assert.Nil(t, err)
+		err = v.Set(":[::1]:53")
+		assert.NotNil(t, err)
+		err = v.Set("5:[::1]:53")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]netip.AddrPort", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]netip.AddrPort)
+		v := newUint8NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("2:127.0.0.1")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16NetipAddrPortMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:80 [::1]:53]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]netip.AddrPort)
+		v := newUint16NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:80")
+		assert.NotNil(t, err)
+		err = v.Set("3:127.0.0.1:80")
+		assert.Nil(t, err)
+		err = v.Set(":[::1]:53")
+		assert.NotNil(t, err)
+		err = v.Set("0:[::1]:53")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]netip.AddrPort", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]netip.AddrPort)
+		v := newUint16NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("2:127.0.0.1")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32NetipAddrPortMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:80 [::1]:53]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]netip.AddrPort)
+		v := newUint32NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:80")
+		assert.NotNil(t, err)
+		err = v.Set("3:127.0.0.1:80")
+		assert.Nil(t, err)
+		err = v.Set(":[::1]:53")
+		assert.NotNil(t, err)
+		err = v.Set("0:[::1]:53")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]netip.AddrPort", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]netip.AddrPort)
+		v := newUint32NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("0:127.0.0.1")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64NetipAddrPortMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:80 [::1]:53]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]netip.AddrPort)
+		v := newUint64NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:80")
+		assert.NotNil(t, err)
+		err = v.Set("2:127.0.0.1:80")
+		assert.Nil(t, err)
+		err = v.Set(":[::1]:53")
+		assert.NotNil(t, err)
+		err = v.Set("5:[::1]:53")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]netip.AddrPort", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]netip.AddrPort)
+		v := newUint64NetipAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("1:127.0.0.1")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUDPAddrValue_Zero(t *testing.T) {
+	nilValue := new(udpAddrValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*udpAddrValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUDPAddrValue(t *testing.T) {
+	t.Run("in: 127.0.0.1:53", func(t *testing.T) {
+		a := new(net.UDPAddr)
+		v := newUDPAddrValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("127.0.0.1:53")
+		assert.Nil(t, err)
+		assert.Equal(t, "127.0.0.1:53", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "udpAddr", v.Type())
+	})
+	t.Run("in: 127.0.0.1", func(t *testing.T) {
+		a := new(net.UDPAddr)
+		v := newUDPAddrValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("127.0.0.1")
+		assert.EqualError(t, err, "failed to parse UDPAddr: \"127.0.0.1\"")
+		assert.Equal(t, ":0", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "udpAddr", v.Type())
+	})
+
+}
+
+func TestUDPAddrSliceValue_Zero(t *testing.T) {
+	nilValue := new(udpAddrSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*udpAddrSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringUDPAddrMapValue_Zero(t *testing.T) {
+	var nilValue stringUDPAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringUDPAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntUDPAddrMapValue_Zero(t *testing.T) {
+	var nilValue intUDPAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intUDPAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8UDPAddrMapValue_Zero(t *testing.T) {
+	var nilValue int8UDPAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8UDPAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16UDPAddrMapValue_Zero(t *testing.T) {
+	var nilValue int16UDPAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16UDPAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32UDPAddrMapValue_Zero(t *testing.T) {
+	var nilValue int32UDPAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32UDPAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64UDPAddrMapValue_Zero(t *testing.T) {
+	var nilValue int64UDPAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64UDPAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintUDPAddrMapValue_Zero(t *testing.T) {
+	var nilValue uintUDPAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintUDPAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8UDPAddrMapValue_Zero(t *testing.T) {
+	var nilValue uint8UDPAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8UDPAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16UDPAddrMapValue_Zero(t *testing.T) {
+	var nilValue uint16UDPAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16UDPAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32UDPAddrMapValue_Zero(t *testing.T) {
+	var nilValue uint32UDPAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32UDPAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64UDPAddrMapValue_Zero(t *testing.T) {
+	var nilValue uint64UDPAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64UDPAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUDPAddrSliceValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:53,127.0.0.2:53 127.0.0.3:5353]", func(t *testing.T) {
+		var err error
+		a := new([]net.UDPAddr)
+		v := newUDPAddrSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("127.0.0.1:53,127.0.0.2:53")
+		assert.Nil(t, err)
+		err = v.Set("127.0.0.3:5353")
+		assert.Nil(t, err)
+		assert.Equal(t, "[127.0.0.1:53,127.0.0.2:53,127.0.0.3:5353]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "udpAddrSlice", v.Type())
+	})
+	t.Run("in: [127.0.0.1:53,127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := new([]net.UDPAddr)
+		v := newUDPAddrSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("127.0.0.1:53,127.0.0.1")
+		assert.EqualError(t, err, "failed to parse UDPAddr: \"127.0.0.1\"")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "udpAddrSlice", v.Type())
+	})
+
+}
+
+func TestStringUDPAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:53 127.0.0.2:5353]", func(t *testing.T) {
+		var err error
+		a := make(map[string]net.UDPAddr)
+		v := newStringUDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("CePNn:127.0.0.1:53")
+		assert.Nil(t, err)
+		err = v.Set("imQoC:127.0.0.2:5353")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]net.UDPAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[string]net.UDPAddr)
+		v := newStringUDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("NrtHH127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("ZSHVy:127.0.0.1")
+		assert.EqualError(t, err, "failed to parse UDPAddr: \"127.0.0.1\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]net.UDPAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntUDPAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:53 127.0.0.2:5353]", func(t *testing.T) {
+		var err error
+		a := make(map[int]net.UDPAddr)
+		v := newIntUDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:53")
+		assert.NotNil(t, err)
+		err = v.Set("2:127.0.0.1:53")
+		assert.Nil(t, err)
+		err = v.Set(":127.0.0.2:5353")
+		assert.NotNil(t, err)
+		err = v.Set("0:127.0.0.2:5353")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]net.UDPAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int]net.UDPAddr)
+		v := newIntUDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("4:127.0.0.1")
+		assert.EqualError(t, err, "failed to parse UDPAddr: \"127.0.0.1\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]net.UDPAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8UDPAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:53 127.0.0.2:5353]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]net.UDPAddr)
+		v := newInt8UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:53")
+		assert.NotNil(t, err)
+		err = v.Set("4:127.0.0.1:53")
+		assert.Nil(t, err)
+		err = v.Set(":127.0.0.2:5353")
+		assert.NotNil(t, err)
+		err = v.Set("3:127.0.0.2:5353")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]net.UDPAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]net.UDPAddr)
+		v := newInt8UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("1:127.0.0.1")
+		assert.EqualError(t, err, "failed to parse UDPAddr: \"127.0.0.1\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]net.UDPAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16UDPAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:53 127.0.0.2:5353]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]net.UDPAddr)
+		v := newInt16UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:53")
+		assert.NotNil(t, err)
+		err = v.Set("3:127.0.0.1:53")
+		assert.Nil(t, err)
+		err = v.Set(":127.0.0.2:5353")
+		assert.NotNil(t, err)
+		err = v.Set("3:127.0.0.2:5353")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]net.UDPAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]net.UDPAddr)
+		v := newInt16UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("7:127.0.0.1")
+		assert.EqualError(t, err, "failed to parse UDPAddr: \"127.0.0.1\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]net.UDPAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32UDPAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:53 127.0.0.2:5353]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]net.UDPAddr)
+		v := newInt32UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:53")
+		assert.NotNil(t, err)
+		err = v.Set("5:127.0.0.1:53")
+		assert.Nil(t, err)
+		err = v.Set(":127.0.0.2:5353")
+		assert.NotNil(t, err)
+		err = v.Set("3:127.0.0.2:5353")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]net.UDPAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]net.UDPAddr)
+		v := newInt32UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("0:127.0.0.1")
+		assert.EqualError(t, err, "failed to parse UDPAddr: \"127.0.0.1\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]net.UDPAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64UDPAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:53 127.0.0.2:5353]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]net.UDPAddr)
+		v := newInt64UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:53")
+		assert.NotNil(t, err)
+		err = v.Set("0:127.0.0.1:53")
+		assert.Nil(t, err)
+		err = v.Set(":127.0.0.2:5353")
+		assert.NotNil(t, err)
+		err = v.Set("1:127.0.0.2:5353")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]net.UDPAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]net.UDPAddr)
+		v := newInt64UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("5:127.0.0.1")
+		assert.EqualError(t, err, "failed to parse UDPAddr: \"127.0.0.1\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]net.UDPAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintUDPAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:53 127.0.0.2:5353]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]net.UDPAddr)
+		v := newUintUDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:53")
+		assert.NotNil(t, err)
+		err = v.Set("2:127.0.0.1:53")
+		assert.Nil(t, err)
+		err = v.Set(":127.0.0.2:5353")
+		assert.NotNil(t, err)
+		err = v.Set("4:127.0.0.2:5353")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]net.UDPAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]net.UDPAddr)
+		v := newUintUDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("1:127.0.0.1")
+		assert.EqualError(t, err, "failed to parse UDPAddr: \"127.0.0.1\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]net.UDPAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8UDPAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:53 127.0.0.2:5353]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]net.UDPAddr)
+		v := newUint8UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:53")
+		assert.NotNil(t, err)
+		err = v.Set("2:127.0.0.1:53")
+		assert.Nil(t, err)
+		err = v.Set(":127.0.0.2:5353")
+		assert.NotNil(t, err)
+		err = v.Set("1:127.0.0.2:5353")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]net.UDPAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]net.UDPAddr)
+		v := newUint8UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("6:127.0.0.1")
+		assert.EqualError(t, err, "failed to parse UDPAddr: \"127.0.0.1\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]net.UDPAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16UDPAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:53 127.0.0.2:5353]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]net.UDPAddr)
+		v := newUint16UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:53")
+		assert.NotNil(t, err)
+		err = v.Set("4:127.0.0.1:53")
+		assert.Nil(t, err)
+		err = v.Set(":127.0.0.2:5353")
+		assert.NotNil(t, err)
+		err = v.Set("5:127.0.0.2:5353")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]net.UDPAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]net.UDPAddr)
+		v := newUint16UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("7:127.0.0.1")
+		assert.EqualError(t, err, "failed to parse UDPAddr: \"127.0.0.1\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]net.UDPAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32UDPAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:53 127.0.0.2:5353]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]net.UDPAddr)
+		v := newUint32UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:53")
+		assert.NotNil(t, err)
+		err = v.Set("5:127.0.0.1:53")
+		assert.Nil(t, err)
+		err = v.Set(":127.0.0.2:5353")
+		assert.NotNil(t, err)
+		err = v.Set("5:127.0.0.2:5353")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]net.UDPAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]net.UDPAddr)
+		v := newUint32UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("0:127.0.0.1")
+		assert.EqualError(t, err, "failed to parse UDPAddr: \"127.0.0.1\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]net.UDPAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64UDPAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:53 127.0.0.2:5353]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]net.UDPAddr)
+		v := newUint64UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":127.0.0.1:53")
+		assert.NotNil(t, err)
+		err = v.Set("6:127.0.0.1:53")
+		assert.Nil(t, err)
+		err = v.Set(":127.0.0.2:5353")
+		assert.NotNil(t, err)
+		err = v.Set("7:127.0.0.2:5353")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]net.UDPAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]net.UDPAddr)
+		v := newUint64UDPAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("5:127.0.0.1")
+		assert.EqualError(t, err, "failed to parse UDPAddr: \"127.0.0.1\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]net.UDPAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestHardwareAddrValue_Zero(t *testing.T) {
+	nilValue := new(hardwareAddrValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*hardwareAddrValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestHardwareAddrValue(t *testing.T) {
+	t.Run("in: 00:00:5e:00:53:01", func(t *testing.T) {
+		a := new(net.HardwareAddr)
+		v := newHardwareAddrValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("00:00:5e:00:53:01")
+		assert.Nil(t, err)
+		assert.Equal(t, "00:00:5e:00:53:01", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "hardwareAddr", v.Type())
+	})
+	t.Run("in: 00-00-5E-00-53-01", func(t *testing.T) {
+		a := new(net.HardwareAddr)
+		v := newHardwareAddrValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("00-00-5E-00-53-01")
+		assert.Nil(t, err)
+		assert.Equal(t, "00:00:5e:00:53:01", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "hardwareAddr", v.Type())
+	})
+	t.Run("in: bad", func(t *testing.T) {
+		a := new(net.HardwareAddr)
+		v := newHardwareAddrValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("bad")
+		assert.EqualError(t, err, "address bad: invalid MAC address")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "hardwareAddr", v.Type())
+	})
+
+}
+
+func TestHardwareAddrSliceValue_Zero(t *testing.T) {
+	nilValue := new(hardwareAddrSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*hardwareAddrSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringHardwareAddrMapValue_Zero(t *testing.T) {
+	var nilValue stringHardwareAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringHardwareAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntHardwareAddrMapValue_Zero(t *testing.T) {
+	var nilValue intHardwareAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intHardwareAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8HardwareAddrMapValue_Zero(t *testing.T) {
+	var nilValue int8HardwareAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8HardwareAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16HardwareAddrMapValue_Zero(t *testing.T) {
+	var nilValue int16HardwareAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16HardwareAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32HardwareAddrMapValue_Zero(t *testing.T) {
+	var nilValue int32HardwareAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32HardwareAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64HardwareAddrMapValue_Zero(t *testing.T) {
+	var nilValue int64HardwareAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64HardwareAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintHardwareAddrMapValue_Zero(t *testing.T) {
+	var nilValue uintHardwareAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintHardwareAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8HardwareAddrMapValue_Zero(t *testing.T) {
+	var nilValue uint8HardwareAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8HardwareAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16HardwareAddrMapValue_Zero(t *testing.T) {
+	var nilValue uint16HardwareAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16HardwareAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32HardwareAddrMapValue_Zero(t *testing.T) {
+	var nilValue uint32HardwareAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32HardwareAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64HardwareAddrMapValue_Zero(t *testing.T) {
+	var nilValue uint64HardwareAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64HardwareAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestHardwareAddrSliceValue(t *testing.T) {
+	t.Run("in: [00:00:5e:00:53:01,00:00:5e:00:53:02 00:00:5e:00:53:03]", func(t *testing.T) {
+		var err error
+		a := new([]net.HardwareAddr)
+		v := newHardwareAddrSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("00:00:5e:00:53:01,00:00:5e:00:53:02")
+		assert.Nil(t, err)
+		err = v.Set("00:00:5e:00:53:03")
+		assert.Nil(t, err)
+		assert.Equal(t, "[00:00:5e:00:53:01,00:00:5e:00:53:02,00:00:5e:00:53:03]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "hardwareAddrSlice", v.Type())
+	})
+	t.Run("in: [00:00:5e:00:53:01,bad]", func(t *testing.T) {
+		var err error
+		a := new([]net.HardwareAddr)
+		v := newHardwareAddrSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("00:00:5e:00:53:01,bad")
+		assert.EqualError(t, err, "address bad: invalid MAC address")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "hardwareAddrSlice", v.Type())
+	})
+
+}
+
+func TestStringHardwareAddrMapValue(t *testing.T) {
+	t.Run("in: [00:00:5e:00:53:01 00-00-5E-00-53-02]", func(t *testing.T) {
+		var err error
+		a := make(map[string]net.HardwareAddr)
+		v := newStringHardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("oFlpV:00:00:5e:00:53:01")
+		assert.Nil(t, err)
+		err = v.Set("ssIAY00-00-5E-00-53-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("kbKrI:00-00-5E-00-53-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]net.HardwareAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[string]net.HardwareAddr)
+		v := newStringHardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("mXeovbad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("eCluu:bad")
+		assert.EqualError(t, err, "address bad: invalid MAC address")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]net.HardwareAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntHardwareAddrMapValue(t *testing.T) {
+	t.Run("in: [00:00:5e:00:53:01 00-00-5E-00-53-02]", func(t *testing.T) {
+		var err error
+		a := make(map[int]net.HardwareAddr)
+		v := newIntHardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":00:00:5e:00:53:01")
+		assert.NotNil(t, err)
+		err = v.Set("4:00:00:5e:00:53:01")
+		assert.Nil(t, err)
+		err = v.Set("700-00-5E-00-53-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":00-00-5E-00-53-02")
+		assert.NotNil(t, err)
+		err = v.Set("7:00-00-5E-00-53-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]net.HardwareAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[int]net.HardwareAddr)
+		v := newIntHardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("3:bad")
+		assert.EqualError(t, err, "address bad: invalid MAC address")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]net.HardwareAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8HardwareAddrMapValue(t *testing.T) {
+	t.Run("in: [00:00:5e:00:53:01 00-00-5E-00-53-02]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]net.HardwareAddr)
+		v := newInt8HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":00:00:5e:00:53:01")
+		assert.NotNil(t, err)
+		err = v.Set("0:00:00:5e:00:53:01")
+		assert.Nil(t, err)
+		err = v.Set("200-00-5E-00-53-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":00-00-5E-00-53-02")
+		assert.NotNil(t, err)
+		err = v.Set("1:00-00-5E-00-53-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]net.HardwareAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]net.HardwareAddr)
+		v := newInt8HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("3:bad")
+		assert.EqualError(t, err, "address bad: invalid MAC address")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]net.HardwareAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16HardwareAddrMapValue(t *testing.T) {
+	t.Run("in: [00:00:5e:00:53:01 00-00-5E-00-53-02]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]net.HardwareAddr)
+		v := newInt16HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":00:00:5e:00:53:01")
+		assert.NotNil(t, err)
+		err = v.Set("2:00:00:5e:00:53:01")
+		assert.Nil(t, err)
+		err = v.Set("700-00-5E-00-53-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":00-00-5E-00-53-02")
+		assert.NotNil(t, err)
+		err = v.Set("2:00-00-5E-00-53-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]net.HardwareAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]net.HardwareAddr)
+		v := newInt16HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("3:bad")
+		assert.EqualError(t, err, "address bad: invalid MAC address")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]net.HardwareAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32HardwareAddrMapValue(t *testing.T) {
+	t.Run("in: [00:00:5e:00:53:01 00-00-5E-00-53-02]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]net.HardwareAddr)
+		v := newInt32HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":00:00:5e:00:53:01")
+		assert.NotNil(t, err)
+		err = v.Set("5:00:00:5e:00:53:01")
+		assert.Nil(t, err)
+		err = v.Set("700-00-5E-00-53-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":00-00-5E-00-53-02")
+		assert.NotNil(t, err)
+		err = v.Set("4:00-00-5E-00-53-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]net.HardwareAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]net.HardwareAddr)
+		v := newInt32HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("7:bad")
+		assert.EqualError(t, err, "address bad: invalid MAC address")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]net.HardwareAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64HardwareAddrMapValue(t *testing.T) {
+	t.Run("in: [00:00:5e:00:53:01 00-00-5E-00-53-02]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]net.HardwareAddr)
+		v := newInt64HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":00:00:5e:00:53:01")
+		assert.NotNil(t, err)
+		err = v.Set("7:00:00:5e:00:53:01")
+		assert.Nil(t, err)
+		err = v.Set("100-00-5E-00-53-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":00-00-5E-00-53-02")
+		assert.NotNil(t, err)
+		err = v.Set("6:00-00-5E-00-53-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]net.HardwareAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]net.HardwareAddr)
+		v := newInt64HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("2:bad")
+		assert.EqualError(t, err, "address bad: invalid MAC address")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]net.HardwareAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintHardwareAddrMapValue(t *testing.T) {
+	t.Run("in: [00:00:5e:00:53:01 00-00-5E-00-53-02]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]net.HardwareAddr)
+		v := newUintHardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":00:00:5e:00:53:01")
+		assert.NotNil(t, err)
+		err = v.Set("5:00:00:5e:00:53:01")
+		assert.Nil(t, err)
+		err = v.Set("000-00-5E-00-53-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":00-00-5E-00-53-02")
+		assert.NotNil(t, err)
+		err = v.Set("0:00-00-5E-00-53-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]net.HardwareAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]net.HardwareAddr)
+		v := newUintHardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("0:bad")
+		assert.EqualError(t, err, "address bad: invalid MAC address")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]net.HardwareAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8HardwareAddrMapValue(t *testing.T) {
+	t.Run("in: [00:00:5e:00:53:01 00-00-5E-00-53-02]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]net.HardwareAddr)
+		v := newUint8HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":00:00:5e:00:53:01")
+		assert.NotNil(t, err)
+		err = v.Set("0:00:00:5e:00:53:01")
+		assert.Nil(t, err)
+		err = v.Set("500-00-5E-00-53-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":00-00-5E-00-53-02")
+		assert.NotNil(t, err)
+		err = v.Set("2:00-00-5E-00-53-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]net.HardwareAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]net.HardwareAddr)
+		v := newUint8HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("1:bad")
+		assert.EqualError(t, err, "address bad: invalid MAC address")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]net.HardwareAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16HardwareAddrMapValue(t *testing.T) {
+	t.Run("in: [00:00:5e:00:53:01 00-00-5E-00-53-02]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]net.HardwareAddr)
+		v := newUint16HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":00:00:5e:00:53:01")
+		assert.NotNil(t, err)
+		err = v.Set("7:00:00:5e:00:53:01")
+		assert.Nil(t, err)
+		err = v.Set("600-00-5E-00-53-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":00-00-5E-00-53-02")
+		assert.NotNil(t, err)
+		err = v.Set("2:00-00-5E-00-53-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]net.HardwareAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]net.HardwareAddr)
+		v := newUint16HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("0:bad")
+		assert.EqualError(t, err, "address bad: invalid MAC address")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]net.HardwareAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32HardwareAddrMapValue(t *testing.T) {
+	t.Run("in: [00:00:5e:00:53:01 00-00-5E-00-53-02]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]net.HardwareAddr)
+		v := newUint32HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":00:00:5e:00:53:01")
+		assert.NotNil(t, err)
+		err = v.Set("0:00:00:5e:00:53:01")
+		assert.Nil(t, err)
+		err = v.Set("200-00-5E-00-53-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":00-00-5E-00-53-02")
+		assert.NotNil(t, err)
+		err = v.Set("7:00-00-5E-00-53-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]net.HardwareAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]net.HardwareAddr)
+		v := newUint32HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("3:bad")
+		assert.EqualError(t, err, "address bad: invalid MAC address")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]net.HardwareAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64HardwareAddrMapValue(t *testing.T) {
+	t.Run("in: [00:00:5e:00:53:01 00-00-5E-00-53-02]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]net.HardwareAddr)
+		v := newUint64HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set(":00:00:5e:00:53:01")
+		assert.NotNil(t, err)
+		err = v.Set("2:00:00:5e:00:53:01")
+		assert.Nil(t, err)
+		err = v.Set("500-00-5E-00-53-02")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":00-00-5E-00-53-02")
+		assert.NotNil(t, err)
+		err = v.Set("2:00-00-5E-00-53-02")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]net.HardwareAddr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [bad]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]net.HardwareAddr)
+		v := newUint64HardwareAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4bad")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":bad")
+		assert.NotNil(t, err)
+		err = v.Set("7:bad")
+		assert.EqualError(t, err, "address bad: invalid MAC address")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]net.HardwareAddr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestBigIntValue_Zero(t *testing.T) {
+	nilValue := new(bigIntValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*bigIntValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestBigIntValue(t *testing.T) {
+	t.Run("in: 123456789012345678901234567890", func(t *testing.T) {
+		a := new(big.Int)
+		v := newBigIntValue(&a)
+		assert.Equal(t, parseGeneratedPtrs(&a), v)
+		err := v.Set("123456789012345678901234567890")
+		assert.Nil(t, err)
+		assert.Equal(t, "123456789012345678901234567890", v.String())
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "bigInt", v.Type())
+	})
+	t.Run("in: 0x10", func(t *testing.T) {
+		a := new(big.Int)
+		v := newBigIntValue(&a)
+		assert.Equal(t, parseGeneratedPtrs(&a), v)
+		err := v.Set("0x10")
+		assert.Nil(t, err)
+		assert.Equal(t, "16", v.String())
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "bigInt", v.Type())
+	})
+	t.Run("in: abc", func(t *testing.T) {
+		a := new(big.Int)
+		v := newBigIntValue(&a)
+		assert.Equal(t, parseGeneratedPtrs(&a), v)
+		err := v.Set("abc")
+		assert.EqualError(t, err, "failed to parse big.Int: \"abc\"")
+		assert.Equal(t, "0", v.String())
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "bigInt", v.Type())
+	})
+
+}
+
+func TestBigIntSliceValue_Zero(t *testing.T) {
+	nilValue := new(bigIntSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*bigIntSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringBigIntMapValue_Zero(t *testing.T) {
+	var nilValue stringBigIntMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringBigIntMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntBigIntMapValue_Zero(t *testing.T) {
+	var nilValue intBigIntMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intBigIntMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8BigIntMapValue_Zero(t *testing.T) {
+	var nilValue int8BigIntMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8BigIntMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16BigIntMapValue_Zero(t *testing.T) {
+	var nilValue int16BigIntMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16BigIntMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32BigIntMapValue_Zero(t *testing.T) {
+	var nilValue int32BigIntMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32BigIntMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64BigIntMapValue_Zero(t *testing.T) {
+	var nilValue int64BigIntMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64BigIntMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintBigIntMapValue_Zero(t *testing.T) {
+	var nilValue uintBigIntMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintBigIntMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8BigIntMapValue_Zero(t *testing.T) {
+	var nilValue uint8BigIntMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8BigIntMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16BigIntMapValue_Zero(t *testing.T) {
+	var nilValue uint16BigIntMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16BigIntMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32BigIntMapValue_Zero(t *testing.T) {
+	var nilValue uint32BigIntMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32BigIntMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64BigIntMapValue_Zero(t *testing.T) {
+	var nilValue uint64BigIntMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64BigIntMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestBigIntSliceValue(t *testing.T) {
+	t.Run("in: [1,2 123456789012345678901234567890]", func(t *testing.T) {
+		var err error
+		a := new([]*big.Int)
+		v := newBigIntSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1,2")
+		assert.Nil(t, err)
+		err = v.Set("123456789012345678901234567890")
+		assert.Nil(t, err)
+		assert.Equal(t, "[1,2,123456789012345678901234567890]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "bigIntSlice", v.Type())
+	})
+	t.Run("in: [1,abc]", func(t *testing.T) {
+		var err error
+		a := new([]*big.Int)
+		v := newBigIntSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1,abc")
+		assert.EqualError(t, err, "failed to parse big.Int: \"abc\"")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "bigIntSlice", v.Type())
+	})
+
+}
+
+func TestStringBigIntMapValue(t *testing.T) {
+	t.Run("in: [1 123456789012345678901234567890]", func(t *testing.T) {
+		var err error
+		a := make(map[string]*big.Int)
+		v := newStringBigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("JnunS1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("elbYY:1")
+		assert.Nil(t, err)
+		err = v.Set("OzQJY123456789012345678901234567890")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("ZuYsI:123456789012345678901234567890")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]*big.Int", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[string]*big.Int)
+		v := newStringBigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("IVQniabc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("ApTAI:abc")
+		assert.EqualError(t, err, "failed to parse big.Int: \"abc\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]*big.Int", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntBigIntMapValue(t *testing.T) {
+	t.Run("in: [1 123456789012345678901234567890]", func(t *testing.T) {
+		var err error
+		a := make(map[int]*big.Int)
+		v := newIntBigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("21")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1")
+		assert.NotNil(t, err)
+		err = v.Set("7:1")
+		assert.Nil(t, err)
+		err = v.Set("5123456789012345678901234567890")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":123456789012345678901234567890")
+		assert.NotNil(t, err)
+		err = v.Set("7:123456789012345678901234567890")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]*big.Int", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[int]*big.Int)
+		v := newIntBigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("3:abc")
+		assert.EqualError(t, err, "failed to parse big.Int: \"abc\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]*big.Int", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8BigIntMapValue(t *testing.T) {
+	t.Run("in: [1 123456789012345678901234567890]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]*big.Int)
+		v := newInt8BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("21")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1")
+		assert.NotNil(t, err)
+		err = v.Set("2:1")
+		assert.Nil(t, err)
+		err = v.Set("6123456789012345678901234567890")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":123456789012345678901234567890")
+		assert.NotNil(t, err)
+		err = v.Set("1:123456789012345678901234567890")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]*big.Int", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]*big.Int)
+		v := newInt8BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("0:abc")
+		assert.EqualError(t, err, "failed to parse big.Int: \"abc\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]*big.Int", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16BigIntMapValue(t *testing.T) {
+	t.Run("in: [1 123456789012345678901234567890]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]*big.Int)
+		v := newInt16BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("11")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1")
+		assert.NotNil(t, err)
+		err = v.Set("1:1")
+		assert.Nil(t, err)
+		err = v.Set("6123456789012345678901234567890")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":123456789012345678901234567890")
+		assert.NotNil(t, err)
+		err = v.Set("1:123456789012345678901234567890")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]*big.Int", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]*big.Int)
+		v := newInt16BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("3:abc")
+		assert.EqualError(t, err, "failed to parse big.Int: \"abc\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]*big.Int", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32BigIntMapValue(t *testing.T) {
+	t.Run("in: [1 123456789012345678901234567890]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]*big.Int)
+		v := newInt32BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("41")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1")
+		assert.NotNil(t, err)
+		err = v.Set("4:1")
+		assert.Nil(t, err)
+		err = v.Set("0123456789012345678901234567890")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":123456789012345678901234567890")
+		assert.NotNil(t, err)
+		err = v.Set("5:123456789012345678901234567890")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]*big.Int", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]*big.Int)
+		v := newInt32BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("4:abc")
+		assert.EqualError(t, err, "failed to parse big.Int: \"abc\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]*big.Int", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64BigIntMapValue(t *testing.T) {
+	t.Run("in: [1 123456789012345678901234567890]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]*big.Int)
+		v := newInt64BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("01")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1")
+		assert.NotNil(t, err)
+		err = v.Set("3:1")
+		assert.Nil(t, err)
+		err = v.Set("0123456789012345678901234567890")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":123456789012345678901234567890")
+		assert.NotNil(t, err)
+		err = v.Set("3:123456789012345678901234567890")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]*big.Int", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]*big.Int)
+		v := newInt64BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("3:abc")
+		assert.EqualError(t, err, "failed to parse big.Int: \"abc\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]*big.Int", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintBigIntMapValue(t *testing.T) {
+	t.Run("in: [1 123456789012345678901234567890]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]*big.Int)
+		v := newUintBigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("71")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1")
+		assert.NotNil(t, err)
+		err = v.Set("1:1")
+		assert.Nil(t, err)
+		err = v.Set("5123456789012345678901234567890")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":123456789012345678901234567890")
+		assert.NotNil(t, err)
+		err = v.Set("0:123456789012345678901234567890")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]*big.Int", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]*big.Int)
+		v := newUintBigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("2:abc")
+		assert.EqualError(t, err, "failed to parse big.Int: \"abc\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]*big.Int", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8BigIntMapValue(t *testing.T) {
+	t.Run("in: [1 123456789012345678901234567890]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]*big.Int)
+		v := newUint8BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("51")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1")
+		assert.NotNil(t, err)
+		err = v.Set("6:1")
+		assert.Nil(t, err)
+		err = v.Set("5123456789012345678901234567890")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":123456789012345678901234567890")
+		assert.NotNil(t, err)
+		err = v.Set("5:123456789012345678901234567890")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]*big.Int", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]*big.Int)
+		v := newUint8BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("3:abc")
+		assert.EqualError(t, err, "failed to parse big.Int: \"abc\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]*big.Int", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16BigIntMapValue(t *testing.T) {
+	t.Run("in: [1 123456789012345678901234567890]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]*big.Int)
+		v := newUint16BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("21")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1")
+		assert.NotNil(t, err)
+		err = v.Set("5:1")
+		assert.Nil(t, err)
+		err = v.Set("6123456789012345678901234567890")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":123456789012345678901234567890")
+		assert.NotNil(t, err)
+		err = v.Set("0:123456789012345678901234567890")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]*big.Int", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]*big.Int)
+		v := newUint16BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("1:abc")
+		assert.EqualError(t, err, "failed to parse big.Int: \"abc\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]*big.Int", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32BigIntMapValue(t *testing.T) {
+	t.Run("in: [1 123456789012345678901234567890]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]*big.Int)
+		v := newUint32BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("31")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1")
+		assert.NotNil(t, err)
+		err = v.Set("2:1")
+		assert.Nil(t, err)
+		err = v.Set("0123456789012345678901234567890")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":123456789012345678901234567890")
+		assert.NotNil(t, err)
+		err = v.Set("2:123456789012345678901234567890")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]*big.Int", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]*big.Int)
+		v := newUint32BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("2:abc")
+		assert.EqualError(t, err, "failed to parse big.Int: \"abc\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]*big.Int", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64BigIntMapValue(t *testing.T) {
+	t.Run("in: [1 123456789012345678901234567890]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]*big.Int)
+		v := newUint64BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("31")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1")
+		assert.NotNil(t, err)
+		err = v.Set("1:1")
+		assert.Nil(t, err)
+		err = v.Set("5123456789012345678901234567890")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":123456789012345678901234567890")
+		assert.NotNil(t, err)
+		err = v.Set("3:123456789012345678901234567890")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]*big.Int", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]*big.Int)
+		v := newUint64BigIntMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("2:abc")
+		assert.EqualError(t, err, "failed to parse big.Int: \"abc\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]*big.Int", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestBigFloatValue_Zero(t *testing.T) {
+	nilValue := new(bigFloatValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*bigFloatValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestBigFloatValue(t *testing.T) {
+	t.Run("in: 1.5", func(t *testing.T) {
+		a := new(big.Float)
+		v := newBigFloatValue(&a)
+		assert.Equal(t, parseGeneratedPtrs(&a), v)
+		err := v.Set("1.5")
+		assert.Nil(t, err)
+		assert.Equal(t, "1.5", v.String())
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "bigFloat", v.Type())
+	})
+	t.Run("in: 1e100", func(t *testing.T) {
+		a := new(big.Float)
+		v := newBigFloatValue(&a)
+		assert.Equal(t, parseGeneratedPtrs(&a), v)
+		err := v.Set("1e100")
+		assert.Nil(t, err)
+		assert.Equal(t, "1e+100", v.String())
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "bigFloat", v.Type())
+	})
+	t.Run("in: abc", func(t *testing.T) {
+		a := new(big.Float)
+		v := newBigFloatValue(&a)
+		assert.Equal(t, parseGeneratedPtrs(&a), v)
+		err := v.Set("abc")
+		assert.EqualError(t, err, "number has no digits")
+		assert.Equal(t, "0", v.String())
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "bigFloat", v.Type())
+	})
+
+}
+
+func TestBigFloatSliceValue_Zero(t *testing.T) {
+	nilValue := new(bigFloatSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*bigFloatSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringBigFloatMapValue_Zero(t *testing.T) {
+	var nilValue stringBigFloatMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringBigFloatMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntBigFloatMapValue_Zero(t *testing.T) {
+	var nilValue intBigFloatMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intBigFloatMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8BigFloatMapValue_Zero(t *testing.T) {
+	var nilValue int8BigFloatMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8BigFloatMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16BigFloatMapValue_Zero(t *testing.T) {
+	var nilValue int16BigFloatMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16BigFloatMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32BigFloatMapValue_Zero(t *testing.T) {
+	var nilValue int32BigFloatMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32BigFloatMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64BigFloatMapValue_Zero(t *testing.T) {
+	var nilValue int64BigFloatMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64BigFloatMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintBigFloatMapValue_Zero(t *testing.T) {
+	var nilValue uintBigFloatMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintBigFloatMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8BigFloatMapValue_Zero(t *testing.T) {
+	var nilValue uint8BigFloatMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8BigFloatMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16BigFloatMapValue_Zero(t *testing.T) {
+	var nilValue uint16BigFloatMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16BigFloatMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32BigFloatMapValue_Zero(t *testing.T) {
+	var nilValue uint32BigFloatMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32BigFloatMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64BigFloatMapValue_Zero(t *testing.T) {
+	var nilValue uint64BigFloatMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64BigFloatMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestBigFloatSliceValue(t *testing.T) {
+	t.Run("in: [1.5,2 0.25]", func(t *testing.T) {
+		var err error
+		a := new([]*big.Float)
+		v := newBigFloatSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1.5,2")
+		assert.Nil(t, err)
+		err = v.Set("0.25")
+		assert.Nil(t, err)
+		assert.Equal(t, "[1.5,2,0.25]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "bigFloatSlice", v.Type())
+	})
+	t.Run("in: [1.5,abc]", func(t *testing.T) {
+		var err error
+		a := new([]*big.Float)
+		v := newBigFloatSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1.5,abc")
+		assert.EqualError(t, err, "number has no digits")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "bigFloatSlice", v.Type())
+	})
+
+}
+
+func TestStringBigFloatMapValue(t *testing.T) {
+	t.Run("in: [1.5 2]", func(t *testing.T) {
+		var err error
+		a := make(map[string]*big.Float)
+		v := newStringBigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("DtMbm1.5")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("KEEks:1.5")
+		assert.Nil(t, err)
+		err = v.Set("gqibD2")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("iuAMP:2")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]*big.Float", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[string]*big.Float)
+		v := newStringBigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("EkWUVabc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("cnYIY:abc")
+		assert.EqualError(t, err, "number has no digits")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]*big.Float", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntBigFloatMapValue(t *testing.T) {
+	t.Run("in: [1.5 2]", func(t *testing.T) {
+		var err error
+		a := make(map[int]*big.Float)
+		v := newIntBigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("51.5")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5")
+		assert.NotNil(t, err)
+		err = v.Set("6:1.5")
+		assert.Nil(t, err)
+		err = v.Set("72")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2")
+		assert.NotNil(t, err)
+		err = v.Set("4:2")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]*big.Float", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[int]*big.Float)
+		v := newIntBigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("5:abc")
+		assert.EqualError(t, err, "number has no digits")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]*big.Float", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8BigFloatMapValue(t *testing.T) {
+	t.Run("in: [1.5 2]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]*big.Float)
+		v := newInt8BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("51.5")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5")
+		assert.NotNil(t, err)
+		err = v.Set("5:1.5")
+		assert.Nil(t, err)
+		err = v.Set("42")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2")
+		assert.NotNil(t, err)
+		err = v.Set("2:2")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]*big.Float", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]*big.Float)
+		v := newInt8BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("4:abc")
+		assert.EqualError(t, err, "number has no digits")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]*big.Float", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16BigFloatMapValue(t *testing.T) {
+	t.Run("in: [1.5 2]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]*big.Float)
+		v := newInt16BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("61.5")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5")
+		assert.NotNil(t, err)
+		err = v.Set("5:1.5")
+		assert.Nil(t, err)
+		err = v.Set("22")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2")
+		assert.NotNil(t, err)
+		err = v.Set("6:2")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]*big.Float", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]*big.Float)
+		v := newInt16BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("4:abc")
+		assert.EqualError(t, err, "number has no digits")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]*big.Float", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32BigFloatMapValue(t *testing.T) {
+	t.Run("in: [1.5 2]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]*big.Float)
+		v := newInt32BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("51.5")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5")
+		assert.NotNil(t, err)
+		err = v.Set("5:1.5")
+		assert.Nil(t, err)
+		err = v.Set("22")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2")
+		assert.NotNil(t, err)
+		err = v.Set("7:2")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]*big.Float", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]*big.Float)
+		v := newInt32BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("6:abc")
+		assert.EqualError(t, err, "number has no digits")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]*big.Float", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64BigFloatMapValue(t *testing.T) {
+	t.Run("in: [1.5 2]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]*big.Float)
+		v := newInt64BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("11.5")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5")
+		assert.NotNil(t, err)
+		err = v.Set("2:1.5")
+		assert.Nil(t, err)
+		err = v.Set("62")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2")
+		assert.NotNil(t, err)
+		err = v.Set("0:2")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]*big.Float", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]*big.Float)
+		v := newInt64BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("1:abc")
+		assert.EqualError(t, err, "number has no digits")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]*big.Float", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintBigFloatMapValue(t *testing.T) {
+	t.Run("in: [1.5 2]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]*big.Float)
+		v := newUintBigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("21.5")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5")
+		assert.NotNil(t, err)
+		err = v.Set("0:1.5")
+		assert.Nil(t, err)
+		err = v.Set("62")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2")
+		assert.NotNil(t, err)
+		err = v.Set("1:2")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]*big.Float", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]*big.Float)
+		v := newUintBigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("5:abc")
+		assert.EqualError(t, err, "number has no digits")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]*big.Float", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8BigFloatMapValue(t *testing.T) {
+	t.Run("in: [1.5 2]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]*big.Float)
+		v := newUint8BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("61.5")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5")
+		assert.NotNil(t, err)
+		err = v.Set("6:1.5")
+		assert.Nil(t, err)
+		err = v.Set("62")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2")
+		assert.NotNil(t, err)
+		err = v.Set("5:2")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]*big.Float", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]*big.Float)
+		v := newUint8BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("2:abc")
+		assert.EqualError(t, err, "number has no digits")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]*big.Float", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16BigFloatMapValue(t *testing.T) {
+	t.Run("in: [1.5 2]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]*big.Float)
+		v := newUint16BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("21.5")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5")
+		assert.NotNil(t, err)
+		err = v.Set("2:1.5")
+		assert.Nil(t, err)
+		err = v.Set("42")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2")
+		assert.NotNil(t, err)
+		err = v.Set("2:2")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]*big.Float", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]*big.Float)
+		v := newUint16BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("2:abc")
+		assert.EqualError(t, err, "number has no digits")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]*big.Float", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32BigFloatMapValue(t *testing.T) {
+	t.Run("in: [1.5 2]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]*big.Float)
+		v := newUint32BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("41.5")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5")
+		assert.NotNil(t, err)
+		err = v.Set("2:1.5")
+		assert.Nil(t, err)
+		err = v.Set("42")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2")
+		assert.NotNil(t, err)
+		err = v.Set("3:2")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]*big.Float", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]*big.Float)
+		v := newUint32BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("7:abc")
+		assert.EqualError(t, err, "number has no digits")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]*big.Float", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64BigFloatMapValue(t *testing.T) {
+	t.Run("in: [1.5 2]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]*big.Float)
+		v := newUint64BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("51.5")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5")
+		assert.NotNil(t, err)
+		err = v.Set("2:1.5")
+		assert.Nil(t, err)
+		err = v.Set("12")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":2")
+		assert.NotNil(t, err)
+		err = v.Set("6:2")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]*big.Float", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [abc]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]*big.Float)
+		v := newUint64BigFloatMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5abc")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":abc")
+		assert.NotNil(t, err)
+		err = v.Set("5:abc")
+		assert.EqualError(t, err, "number has no digits")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]*big.Float", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestFileModeValue_Zero(t *testing.T) {
+	nilValue := new(fileModeValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*fileModeValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestFileModeValue(t *testing.T) {
+	t.Run("in: 0644", func(t *testing.T) {
+		a := new(os.FileMode)
+		v := newFileModeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("0644")
+		assert.Nil(t, err)
+		assert.Equal(t, "0644", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "fileMode", v.Type())
+	})
+	t.Run("in: 755", func(t *testing.T) {
+		a := new(os.FileMode)
+		v := newFileModeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("755")
+		assert.Nil(t, err)
+		assert.Equal(t, "0755", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "fileMode", v.Type())
+	})
+	t.Run("in: 9", func(t *testing.T) {
+		a := new(os.FileMode)
+		v := newFileModeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("9")
+		assert.EqualError(t, err, "strconv.ParseUint: parsing \"9\": invalid syntax")
+		assert.Equal(t, "0", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "fileMode", v.Type())
+	})
+
+}
+
+func TestFileModeSliceValue_Zero(t *testing.T) {
+	nilValue := new(fileModeSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*fileModeSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringFileModeMapValue_Zero(t *testing.T) {
+	var nilValue stringFileModeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringFileModeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntFileModeMapValue_Zero(t *testing.T) {
+	var nilValue intFileModeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intFileModeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8FileModeMapValue_Zero(t *testing.T) {
+	var nilValue int8FileModeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8FileModeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16FileModeMapValue_Zero(t *testing.T) {
+	var nilValue int16FileModeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16FileModeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32FileModeMapValue_Zero(t *testing.T) {
+	var nilValue int32FileModeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32FileModeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64FileModeMapValue_Zero(t *testing.T) {
+	var nilValue int64FileModeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64FileModeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintFileModeMapValue_Zero(t *testing.T) {
+	var nilValue uintFileModeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintFileModeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8FileModeMapValue_Zero(t *testing.T) {
+	var nilValue uint8FileModeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8FileModeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16FileModeMapValue_Zero(t *testing.T) {
+	var nilValue uint16FileModeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16FileModeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32FileModeMapValue_Zero(t *testing.T) {
+	var nilValue uint32FileModeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32FileModeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64FileModeMapValue_Zero(t *testing.T) {
+	var nilValue uint64FileModeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64FileModeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestFileModeSliceValue(t *testing.T) {
+	t.Run("in: [0644,0600 755]", func(t *testing.T) {
+		var err error
+		a := new([]os.FileMode)
+		v := newFileModeSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0644,0600")
+		assert.Nil(t, err)
+		err = v.Set("755")
+		assert.Nil(t, err)
+		assert.Equal(t, "[0644,0600,0755]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "fileModeSlice", v.Type())
+	})
+	t.Run("in: [0644,9]", func(t *testing.T) {
+		var err error
+		a := new([]os.FileMode)
+		v := newFileModeSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0644,9")
+		assert.EqualError(t, err, "strconv.ParseUint: parsing \"9\": invalid syntax")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "fileModeSlice", v.Type())
+	})
+
+}
+
+func TestStringFileModeMapValue(t *testing.T) {
+	t.Run("in: [0644 0755]", func(t *testing.T) {
+		var err error
+		a := make(map[string]os.FileMode)
+		v := newStringFileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("Asjwt0644")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("tyMUO:0644")
+		assert.Nil(t, err)
+		err = v.Set("opasu0755")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("hTESs:0755")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]os.FileMode", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [9]", func(t *testing.T) {
+		var err error
+		a := make(map[string]os.FileMode)
+		v := newStringFileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("Demer9")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("TKSzU:9")
+		assert.EqualError(t, err, "strconv.ParseUint: parsing \"9\": invalid syntax")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]os.FileMode", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntFileModeMapValue(t *testing.T) {
+	t.Run("in: [0644 0755]", func(t *testing.T) {
+		var err error
+		a := make(map[int]os.FileMode)
+		v := newIntFileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("70644")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0644")
+		assert.NotNil(t, err)
+		err = v.Set("1:0644")
+		assert.Nil(t, err)
+		err = v.Set("30755")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0755")
+		assert.NotNil(t, err)
+		err = v.Set("0:0755")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]os.FileMode", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [9]", func(t *testing.T) {
+		var err error
+		a := make(map[int]os.FileMode)
+		v := newIntFileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("59")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":9")
+		assert.NotNil(t, err)
+		err = v.Set("2:9")
+		assert.EqualError(t, err, "strconv.ParseUint: parsing \"9\": invalid syntax")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]os.FileMode", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8FileModeMapValue(t *testing.T) {
+	t.Run("in: [0644 0755]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]os.FileMode)
+		v := newInt8FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("50644")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0644")
+		assert.NotNil(t, err)
+		err = v.Set("7:0644")
+		assert.Nil(t, err)
+		err = v.Set("40755")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0755")
+		assert.NotNil(t, err)
+		err = v.Set("1:0755")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]os.FileMode", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [9]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]os.FileMode)
+		v := newInt8FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("19")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":9")
+		assert.NotNil(t, err)
+		err = v.Set("2:9")
+		assert.EqualError(t, err, "strconv.ParseUint: parsing \"9\": invalid syntax")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]os.FileMode", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16FileModeMapValue(t *testing.T) {
+	t.Run("in: [0644 0755]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]os.FileMode)
+		v := newInt16FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("60644")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0644")
+		assert.NotNil(t, err)
+		err = v.Set("6:0644")
+		assert.Nil(t, err)
+		err = v.Set("00755")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0755")
+		assert.NotNil(t, err)
+		err = v.Set("7:0755")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]os.FileMode", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [9]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]os.FileMode)
+		v := newInt16FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("09")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":9")
+		assert.NotNil(t, err)
+		err = v.Set("2:9")
+		assert.EqualError(t, err, "strconv.ParseUint: parsing \"9\": invalid syntax")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]os.FileMode", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32FileModeMapValue(t *testing.T) {
+	t.Run("in: [0644 0755]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]os.FileMode)
+		v := newInt32FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("40644")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0644")
+		assert.NotNil(t, err)
+		err = v.Set("1:0644")
+		assert.Nil(t, err)
+		err = v.Set("20755")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0755")
+		assert.NotNil(t, err)
+		err = v.Set("6:0755")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]os.FileMode", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [9]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]os.FileMode)
+		v := newInt32FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("69")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":9")
+		assert.NotNil(t, err)
+		err = v.Set("7:9")
+		assert.EqualError(t, err, "strconv.ParseUint: parsing \"9\": invalid syntax")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]os.FileMode", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64FileModeMapValue(t *testing.T) {
+	t.Run("in: [0644 0755]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]os.FileMode)
+		v := newInt64FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("50644")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0644")
+		assert.NotNil(t, err)
+		err = v.Set("4:0644")
+		assert.Nil(t, err)
+		err = v.Set("60755")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0755")
+		assert.NotNil(t, err)
+		err = v.Set("4:0755")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]os.FileMode", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [9]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]os.FileMode)
+		v := newInt64FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("29")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":9")
+		assert.NotNil(t, err)
+		err = v.Set("3:9")
+		assert.EqualError(t, err, "strconv.ParseUint: parsing \"9\": invalid syntax")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]os.FileMode", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintFileModeMapValue(t *testing.T) {
+	t.Run("in: [0644 0755]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]os.FileMode)
+		v := newUintFileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("50644")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0644")
+		assert.NotNil(t, err)
+		err = v.Set("4:0644")
+		assert.Nil(t, err)
+		err = v.Set("60755")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0755")
+		assert.NotNil(t, err)
+		err = v.Set("0:0755")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]os.FileMode", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [9]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]os.FileMode)
+		v := newUintFileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("59")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":9")
+		assert.NotNil(t, err)
+		err = v.Set("4:9")
+		assert.EqualError(t, err, "strconv.ParseUint: parsing \"9\": invalid syntax")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]os.FileMode", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8FileModeMapValue(t *testing.T) {
+	t.Run("in: [0644 0755]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]os.FileMode)
+		v := newUint8FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("40644")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0644")
+		assert.NotNil(t, err)
+		err = v.Set("1:0644")
+		assert.Nil(t, err)
+		err = v.Set("70755")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0755")
+		assert.NotNil(t, err)
+		err = v.Set("7:0755")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]os.FileMode", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [9]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]os.FileMode)
+		v := newUint8FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("19")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":9")
+		assert.NotNil(t, err)
+		err = v.Set("6:9")
+		assert.EqualError(t, err, "strconv.ParseUint: parsing \"9\": invalid syntax")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]os.FileMode", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16FileModeMapValue(t *testing.T) {
+	t.Run("in: [0644 0755]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]os.FileMode)
+		v := newUint16FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("20644")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0644")
+		assert.NotNil(t, err)
+		err = v.Set("7:0644")
+		assert.Nil(t, err)
+		err = v.Set("60755")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0755")
+		assert.NotNil(t, err)
+		err = v.Set("1:0755")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]os.FileMode", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [9]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]os.FileMode)
+		v := newUint16FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("19")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":9")
+		assert.NotNil(t, err)
+		err = v.Set("3:9")
+		assert.EqualError(t, err, "strconv.ParseUint: parsing \"9\": invalid syntax")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]os.FileMode", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32FileModeMapValue(t *testing.T) {
+	t.Run("in: [0644 0755]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]os.FileMode)
+		v := newUint32FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("60644")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0644")
+		assert.NotNil(t, err)
+		err = v.Set("3:0644")
+		assert.Nil(t, err)
+		err = v.Set("00755")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0755")
+		assert.NotNil(t, err)
+		err = v.Set("5:0755")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]os.FileMode", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [9]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]os.FileMode)
+		v := newUint32FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("49")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":9")
+		assert.NotNil(t, err)
+		err = v.Set("0:9")
+		assert.EqualError(t, err, "strconv.ParseUint: parsing \"9\": invalid syntax")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]os.FileMode", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64FileModeMapValue(t *testing.T) {
+	t.Run("in: [0644 0755]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]os.FileMode)
+		v := newUint64FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("20644")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0644")
+		assert.NotNil(t, err)
+		err = v.Set("6:0644")
+		assert.Nil(t, err)
+		err = v.Set("60755")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":0755")
+		assert.NotNil(t, err)
+		err = v.Set("1:0755")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]os.FileMode", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [9]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]os.FileMode)
+		v := newUint64FileModeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("29")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":9")
+		assert.NotNil(t, err)
+		err = v.Set("6:9")
+		assert.EqualError(t, err, "strconv.ParseUint: parsing \"9\": invalid syntax")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]os.FileMode", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestLocationValue_Zero(t *testing.T) {
+	nilValue := new(locationValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*locationValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestLocationValue(t *testing.T) {
+	t.Run("in: UTC", func(t *testing.T) {
+		a := new(time.Location)
+		v := newLocationValue(&a)
+		assert.Equal(t, parseGeneratedPtrs(&a), v)
+		err := v.Set("UTC")
+		assert.Nil(t, err)
+		assert.Equal(t, "UTC", v.String())
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "location", v.Type())
+	})
+	t.Run("in: Local", func(t *testing.T) {
+		a := new(time.Location)
+		v := newLocationValue(&a)
+		assert.Equal(t, parseGeneratedPtrs(&a), v)
+		err := v.Set("Local")
+		assert.Nil(t, err)
+		assert.Equal(t, "Local", v.String())
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "location", v.Type())
+	})
+	t.Run("in: Mars/Phobos", func(t *testing.T) {
+		a := new(time.Location)
+		v := newLocationValue(&a)
+		assert.Equal(t, parseGeneratedPtrs(&a), v)
+		err := v.Set("Mars/Phobos")
+		assert.EqualError(t, err, "unknown time zone Mars/Phobos")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "location", v.Type())
+	})
+
+}
+
+func TestLocationSliceValue_Zero(t *testing.T) {
+	nilValue := new(locationSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*locationSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringLocationMapValue_Zero(t *testing.T) {
+	var nilValue stringLocationMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringLocationMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntLocationMapValue_Zero(t *testing.T) {
+	var nilValue intLocationMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intLocationMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8LocationMapValue_Zero(t *testing.T) {
+	var nilValue int8LocationMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8LocationMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16LocationMapValue_Zero(t *testing.T) {
+	var nilValue int16LocationMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16LocationMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32LocationMapValue_Zero(t *testing.T) {
+	var nilValue int32LocationMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32LocationMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64LocationMapValue_Zero(t *testing.T) {
+	var nilValue int64LocationMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64LocationMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintLocationMapValue_Zero(t *testing.T) {
+	var nilValue uintLocationMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintLocationMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8LocationMapValue_Zero(t *testing.T) {
+	var nilValue uint8LocationMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8LocationMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16LocationMapValue_Zero(t *testing.T) {
+	var nilValue uint16LocationMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16LocationMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32LocationMapValue_Zero(t *testing.T) {
+	var nilValue uint32LocationMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32LocationMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64LocationMapValue_Zero(t *testing.T) {
+	var nilValue uint64LocationMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64LocationMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestLocationSliceValue(t *testing.T) {
+	t.Run("in: [UTC,Local UTC]", func(t *testing.T) {
+		var err error
+		a := new([]*time.Location)
+		v := newLocationSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("UTC,Local")
+		assert.Nil(t, err)
+		err = v.Set("UTC")
+		assert.Nil(t, err)
+		assert.Equal(t, "[UTC,Local,UTC]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "locationSlice", v.Type())
+	})
+	t.Run("in: [UTC,Mars/Phobos]", func(t *testing.T) {
+		var err error
+		a := new([]*time.Location)
+		v := newLocationSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("UTC,Mars/Phobos")
+		assert.EqualError(t, err, "unknown time zone Mars/Phobos")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "locationSlice", v.Type())
+	})
+
+}
+
+func TestStringLocationMapValue(t *testing.T) {
+	t.Run("in: [UTC Local]", func(t *testing.T) {
+		var err error
+		a := make(map[string]*time.Location)
+		v := newStringLocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("gnQkAUTC")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("LVSDT:UTC")
+		assert.Nil(t, err)
+		err = v.Set("cUfAdLocal")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("tzlLw:Local")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]*time.Location", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [Mars/Phobos]", func(t *testing.T) {
+		var err error
+		a := make(map[string]*time.Location)
+		v := newStringLocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("wZUBUMars/Phobos")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("QPrhV:Mars/Phobos")
+		assert.EqualError(t, err, "unknown time zone Mars/Phobos")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]*time.Location", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntLocationMapValue(t *testing.T) {
+	t.Run("in: [UTC Local]", func(t *testing.T) {
+		var err error
+		a := make(map[int]*time.Location)
+		v := newIntLocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7UTC")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":UTC")
+		assert.NotNil(t, err)
+		err = v.Set("3:UTC")
+		assert.Nil(t, err)
+		err = v.Set("5Local")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Local")
+		assert.NotNil(t, err)
+		err = v.Set("5:Local")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]*time.Location", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [Mars/Phobos]", func(t *testing.T) {
+		var err error
+		a := make(map[int]*time.Location)
+		v := newIntLocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3Mars/Phobos")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Mars/Phobos")
+		assert.NotNil(t, err)
+		err = v.Set("6:Mars/Phobos")
+		assert.EqualError(t, err, "unknown time zone Mars/Phobos")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]*time.Location", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8LocationMapValue(t *testing.T) {
+	t.Run("in: [UTC Local]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]*time.Location)
+		v := newInt8LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5UTC")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":UTC")
+		assert.NotNil(t, err)
+		err = v.Set("3:UTC")
+		assert.Nil(t, err)
+		err = v.Set("0Local")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Local")
+		assert.NotNil(t, err)
+		err = v.Set("7:Local")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]*time.Location", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [Mars/Phobos]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]*time.Location)
+		v := newInt8LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7Mars/Phobos")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Mars/Phobos")
+		assert.NotNil(t, err)
+		err = v.Set("3:Mars/Phobos")
+		assert.EqualError(t, err, "unknown time zone Mars/Phobos")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]*time.Location", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16LocationMapValue(t *testing.T) {
+	t.Run("in: [UTC Local]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]*time.Location)
+		v := newInt16LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6UTC")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":UTC")
+		assert.NotNil(t, err)
+		err = v.Set("3:UTC")
+		assert.Nil(t, err)
+		err = v.Set("5Local")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Local")
+		assert.NotNil(t, err)
+		err = v.Set("0:Local")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]*time.Location", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [Mars/Phobos]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]*time.Location)
+		v := newInt16LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1Mars/Phobos")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Mars/Phobos")
+		assert.NotNil(t, err)
+		err = v.Set("0:Mars/Phobos")
+		assert.EqualError(t, err, "unknown time zone Mars/Phobos")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]*time.Location", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32LocationMapValue(t *testing.T) {
+	t.Run("in: [UTC Local]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]*time.Location)
+		v := newInt32LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2UTC")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":UTC")
+		assert.NotNil(t, err)
+		err = v.Set("1:UTC")
+		assert.Nil(t, err)
+		err = v.Set("7Local")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Local")
+		assert.NotNil(t, err)
+		err = v.Set("2:Local")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]*time.Location", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [Mars/Phobos]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]*time.Location)
+		v := newInt32LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1Mars/Phobos")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Mars/Phobos")
+		assert.NotNil(t, err)
+		err = v.Set("1:Mars/Phobos")
+		assert.EqualError(t, err, "unknown time zone Mars/Phobos")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]*time.Location", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64LocationMapValue(t *testing.T) {
+	t.Run("in: [UTC Local]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]*time.Location)
+		v := newInt64LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7UTC")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":UTC")
+		assert.NotNil(t, err)
+		err = v.Set("2:UTC")
+		assert.Nil(t, err)
+		err = v.Set("2Local")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Local")
+		assert.NotNil(t, err)
+		err = v.Set("0:Local")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]*time.Location", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [Mars/Phobos]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]*time.Location)
+		v := newInt64LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0Mars/Phobos")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Mars/Phobos")
+		assert.NotNil(t, err)
+		err = v.Set("6:Mars/Phobos")
+		assert.EqualError(t, err, "unknown time zone Mars/Phobos")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]*time.Location", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintLocationMapValue(t *testing.T) {
+	t.Run("in: [UTC Local]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]*time.Location)
+		v := newUintLocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6UTC")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":UTC")
+		assert.NotNil(t, err)
+		err = v.Set("5:UTC")
+		assert.Nil(t, err)
+		err = v.Set("6Local")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Local")
+		assert.NotNil(t, err)
+		err = v.Set("6:Local")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]*time.Location", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [Mars/Phobos]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]*time.Location)
+		v := newUintLocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2Mars/Phobos")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Mars/Phobos")
+		assert.NotNil(t, err)
+		err = v.Set("6:Mars/Phobos")
+		assert.EqualError(t, err, "unknown time zone Mars/Phobos")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]*time.Location", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8LocationMapValue(t *testing.T) {
+	t.Run("in: [UTC Local]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]*time.Location)
+		v := newUint8LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2UTC")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":UTC")
+		assert.NotNil(t, err)
+		err = v.Set("2:UTC")
+		assert.Nil(t, err)
+		err = v.Set("7Local")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Local")
+		assert.NotNil(t, err)
+		err = v.Set("3:Local")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]*time.Location", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [Mars/Phobos]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]*time.Location)
+		v := newUint8LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4Mars/Phobos")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Mars/Phobos")
+		assert.NotNil(t, err)
+		err = v.Set("0:Mars/Phobos")
+		assert.EqualError(t, err, "unknown time zone Mars/Phobos")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]*time.Location", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16LocationMapValue(t *testing.T) {
+	t.Run("in: [UTC Local]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]*time.Location)
+		v := newUint16LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4UTC")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":UTC")
+		assert.NotNil(t, err)
+		err = v.Set("0:UTC")
+		assert.Nil(t, err)
+		err = v.Set("3Local")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Local")
+		assert.NotNil(t, err)
+		err = v.Set("1:Local")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]*time.Location", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [Mars/Phobos]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]*time.Location)
+		v := newUint16LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3Mars/Phobos")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Mars/Phobos")
+		assert.NotNil(t, err)
+		err = v.Set("7:Mars/Phobos")
+		assert.EqualError(t, err, "unknown time zone Mars/Phobos")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]*time.Location", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32LocationMapValue(t *testing.T) {
+	t.Run("in: [UTC Local]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]*time.Location)
+		v := newUint32LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6UTC")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":UTC")
+		assert.NotNil(t, err)
+		err = v.Set("6:UTC")
+		assert.Nil(t, err)
+		err = v.Set("5Local")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Local")
+		assert.NotNil(t, err)
+		err = v.Set("3:Local")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]*time.Location", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [Mars/Phobos]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]*time.Location)
+		v := newUint32LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6Mars/Phobos")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Mars/Phobos")
+		assert.NotNil(t, err)
+		err = v.Set("1:Mars/Phobos")
+		assert.EqualError(t, err, "unknown time zone Mars/Phobos")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]*time.Location", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64LocationMapValue(t *testing.T) {
+	t.Run("in: [UTC Local]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]*time.Location)
+		v := newUint64LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6UTC")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":UTC")
+		assert.NotNil(t, err)
+		err = v.Set("2:UTC")
+		assert.Nil(t, err)
+		err = v.Set("6Local")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Local")
+		assert.NotNil(t, err)
+		err = v.Set("1:Local")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]*time.Location", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [Mars/Phobos]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]*time.Location)
+		v := newUint64LocationMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4Mars/Phobos")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":Mars/Phobos")
+		assert.NotNil(t, err)
+		err = v.Set("7:Mars/Phobos")
+		assert.EqualError(t, err, "unknown time zone Mars/Phobos")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]*time.Location", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestByteSizeValue_Zero(t *testing.T) {
+	nilValue := new(byteSizeValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*byteSizeValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestByteSizeValue(t *testing.T) {
+	t.Run("in: 512KiB", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("512KiB")
+		assert.Nil(t, err)
+		assert.Equal(t, "512KiB", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 1.5GB", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("1.5GB")
+		assert.Nil(t, err)
+		assert.Equal(t, "1.5GB", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 4.35MB", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("4.35MB")
+		assert.Nil(t, err)
+		assert.Equal(t, "4.35MB", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 10M", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("10M")
+		assert.Nil(t, err)
+		assert.Equal(t, "10MB", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 1536", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("1536")
+		assert.Nil(t, err)
+		assert.Equal(t, "1.5KiB", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 1001", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("1001")
+		assert.Nil(t, err)
+		assert.Equal(t, "1001B", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 0", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("0")
+		assert.Nil(t, err)
+		assert.Equal(t, "0B", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 2 mib", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("2 mib")
+		assert.Nil(t, err)
+		assert.Equal(t, "2MiB", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 10XB", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("10XB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"10XB\", unknown unit")
+		assert.Equal(t, "0B", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: MB", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("MB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"MB\"")
+		assert.Equal(t, "0B", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 1.5B", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("1.5B")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"1.5B\", not a whole number of bytes")
+		assert.Equal(t, "0B", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 1e3", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("1e3")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"1e3\"")
+		assert.Equal(t, "0B", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 1.2.3KB", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("1.2.3KB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"1.2.3KB\"")
+		assert.Equal(t, "0B", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: -1KB", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("-1KB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"-1KB\"")
+		assert.Equal(t, "0B", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 20EiB", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("20EiB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"20EiB\", value out of range")
+		assert.Equal(t, "0B", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+
+}
+
+func TestByteSizeSliceValue_Zero(t *testing.T) {
+	nilValue := new(byteSizeSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*byteSizeSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue stringByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue intByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue int8ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue int16ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue int32ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue int64ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue uintByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue uint8ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue uint16ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue uint32ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue uint64ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestByteSizeSliceValue(t *testing.T) {
+	t.Run("in: [1KiB,2KB 3MB]", func(t *testing.T) {
+		var err error
+		a := new([]ByteSize)
+		v := newByteSizeSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1KiB,2KB")
+		assert.Nil(t, err)
+		err = v.Set("3MB")
+		assert.Nil(t, err)
+		assert.Equal(t, "[1KiB,2KB,3MB]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSizeSlice", v.Type())
+	})
+	t.Run("in: [1KiB,2XB]", func(t *testing.T) {
+		var err error
+		a := new([]ByteSize)
+		v := newByteSizeSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1KiB,2XB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"2XB\", unknown unit")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSizeSlice", v.Type())
+	})
+
+}
+
+func TestStringByteSizeMapValue(t *testing.T) {
+	t.Run("in: [1KiB 10M]", func(t *testing.T) {
+		var err error
+		a := make(map[string]ByteSize)
+		v := newStringByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("Tqryj1KiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("yDaOt:1KiB")
+		assert.Nil(t, err)
+		err = v.Set("vUvVJ10M")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("hEZGu:10M")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10XB]", func(t *testing.T) {
+		var err error
+		a := make(map[string]ByteSize)
+		v := newStringByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("ZITIV10XB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("yonUO:10XB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"10XB\", unknown unit")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntByteSizeMapValue(t *testing.T) {
+	t.Run("in: [1KiB 10M]", func(t *testing.T) {
+		var err error
+		a := make(map[int]ByteSize)
+		v := newIntByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("41KiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1KiB")
+		assert.NotNil(t, err)
+		err = v.Set("5:1KiB")
+		assert.Nil(t, err)
+		err = v.Set("410M")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10M")
+		assert.NotNil(t, err)
+		err = v.Set("3:10M")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10XB]", func(t *testing.T) {
+		var err error
+		a := make(map[int]ByteSize)
+		v := newIntByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("710XB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10XB")
+		assert.NotNil(t, err)
+		err = v.Set("3:10XB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"10XB\", unknown unit")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [1KiB 10M]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]ByteSize)
+		v := newInt8ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("01KiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1KiB")
+		assert.NotNil(t, err)
+		err = v.Set("0:1KiB")
+		assert.Nil(t, err)
+		err = v.Set("710M")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10M")
+		assert.NotNil(t, err)
+		err = v.Set("7:10M")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10XB]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]ByteSize)
+		v := newInt8ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("510XB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10XB")
+		assert.NotNil(t, err)
+		err = v.Set("2:10XB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"10XB\", unknown unit")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [1KiB 10M]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]ByteSize)
+		v := newInt16ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("31KiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1KiB")
+		assert.NotNil(t, err)
+		err = v.Set("6:1KiB")
+		assert.Nil(t, err)
+		err = v.Set("110M")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10M")
+		assert.NotNil(t, err)
+		err = v.Set("7:10M")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10XB]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]ByteSize)
+		v := newInt16ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("010XB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10XB")
+		assert.NotNil(t, err)
+		err = v.Set("5:10XB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"10XB\", unknown unit")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [1KiB 10M]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]ByteSize)
+		v := newInt32ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("31KiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1KiB")
+		assert.NotNil(t, err)
+		err = v.Set("3:1KiB")
+		assert.Nil(t, err)
+		err = v.Set("210M")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10M")
+		assert.NotNil(t, err)
+		err = v.Set("1:10M")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10XB]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]ByteSize)
+		v := newInt32ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("310XB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10XB")
+		assert.NotNil(t, err)
+		err = v.Set("2:10XB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"10XB\", unknown unit")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [1KiB 10M]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]ByteSize)
+		v := newInt64ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("71KiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1KiB")
+		assert.NotNil(t, err)
+		err = v.Set("2:1KiB")
+		assert.Nil(t, err)
+		err = v.Set("510M")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10M")
+		assert.NotNil(t, err)
+		err = v.Set("4:10M")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10XB]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]ByteSize)
+		v := newInt64ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("510XB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10XB")
+		assert.NotNil(t, err)
+		err = v.Set("0:10XB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"10XB\", unknown unit")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintByteSizeMapValue(t *testing.T) {
+	t.Run("in: [1KiB 10M]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]ByteSize)
+		v := newUintByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("01KiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1KiB")
+		assert.NotNil(t, err)
+		err = v.Set("5:1KiB")
+		assert.Nil(t, err)
+		err = v.Set("610M")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10M")
+		assert.NotNil(t, err)
+		err = v.Set("2:10M")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10XB]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]ByteSize)
+		v := newUintByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("110XB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10XB")
+		assert.NotNil(t, err)
+		err = v.Set("0:10XB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"10XB\", unknown unit")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [1KiB 10M]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]ByteSize)
+		v := newUint8ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("21KiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1KiB")
+		assert.NotNil(t, err)
+		err = v.Set("3:1KiB")
+		assert.Nil(t, err)
+		err = v.Set("510M")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10M")
+		assert.NotNil(t, err)
+		err = v.Set("4:10M")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10XB]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]ByteSize)
+		v := newUint8ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("610XB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10XB")
+		assert.NotNil(t, err)
+		err = v.Set("6:10XB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"10XB\", unknown unit")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [1KiB 10M]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]ByteSize)
+		v := newUint16ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("31KiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1KiB")
+		assert.NotNil(t, err)
+		err = v.Set("7:1KiB")
+		assert.Nil(t, err)
+		err = v.Set("510M")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10M")
+		assert.NotNil(t, err)
+		err = v.Set("2:10M")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10XB]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]ByteSize)
+		v := newUint16ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("210XB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10XB")
+		assert.NotNil(t, err)
+		err = v.Set("3:10XB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"10XB\", unknown unit")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [1KiB 10M]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]ByteSize)
+		v := newUint32ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("11KiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1KiB")
+		assert.NotNil(t, err)
+		err = v.Set("5:1KiB")
+		assert.Nil(t, err)
+		err = v.Set("310M")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10M")
+		assert.NotNil(t, err)
+		err = v.Set("5:10M")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10XB]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]ByteSize)
+		v := newUint32ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("310XB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10XB")
+		assert.NotNil(t, err)
+		err = v.Set("2:10XB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"10XB\", unknown unit")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [1KiB 10M]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]ByteSize)
+		v := newUint64ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("51KiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1KiB")
+		assert.NotNil(t, err)
+		err = v.Set("2:1KiB")
+		assert.Nil(t, err)
+		err = v.Set("510M")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10M")
+		assert.NotNil(t, err)
+		err = v.Set("0:10M")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10XB]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]ByteSize)
+		v := newUint64ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("210XB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10XB")
+		assert.NotNil(t, err)
+		err = v.Set("3:10XB")
+		assert.EqualError(t, err, "failed to parse ByteSize: \"10XB\", unknown unit")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
 func TestParseGeneratedMap_NilDefault(t *testing.T) {
 	a := new(bool)
 	v := parseGeneratedMap(a)
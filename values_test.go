@@ -40,6 +40,117 @@ func TestCounter_Set(t *testing.T) {
 	assert.Equal(t, "11", counter.String())
 }
 
+func TestByteSize_String(t *testing.T) {
+	tests := []struct {
+		in  ByteSize
+		out string
+	}{
+		{0, "0B"},
+		{1, "1B"},
+		{1024, "1KiB"},
+		{1000, "1KB"},
+		{1536, "1.5KiB"},
+		{1500000000, "1.5GB"},
+		{4350000, "4.35MB"},
+		{1024000, "1024KB"},
+		{1 << 20, "1MiB"},
+		{1<<30 + 1<<29, "1.5GiB"},
+		{1234567, "1234567B"},
+		{1 << 60, "1EiB"},
+		{1<<20 + 1, "1048577B"},
+		{ByteSize(^uint64(0)), "18446744073709551615B"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.out, test.in.String())
+		parsed, err := parseByteSize(test.out)
+		assert.NoError(t, err)
+		assert.Equal(t, test.in, parsed)
+	}
+
+	// every value is printed in the form, that is parsed back to it
+	for b := ByteSize(1); b < 1<<62; b = b*3 + 7 {
+		parsed, err := parseByteSize(b.String())
+		assert.NoError(t, err)
+		assert.Equal(t, b, parsed, b.String())
+	}
+}
+
+func TestSplitKeyValue(t *testing.T) {
+	tests := []struct {
+		in, sep  string
+		key, val string
+		err      string
+	}{
+		{in: "key:val", key: "key", val: "val"},
+		{in: "api:http://host:8080", key: "api", val: "http://host:8080"},
+		{in: `a\:b:c`, key: "a:b", val: "c"},
+		{in: `a\\:b`, key: `a\`, val: "b"},
+		{in: `a\b:c`, key: `a\b`, val: "c"},
+		{in: "key:", key: "key", val: ""},
+		{in: "key=a:b", sep: "=", key: "key", val: "a:b"},
+		{in: `k\=ey=val`, sep: "=", key: "k=ey", val: "val"},
+		{in: "key=>val", sep: "=>", key: "key", val: "val"},
+		{in: "key", err: "invalid map flag syntax, use -map=key1:val1"},
+		{in: `key\:val`, err: "invalid map flag syntax, use -map=key1:val1"},
+		{in: "key:val", sep: "=", err: "invalid map flag syntax, use -map=key1=val1"},
+	}
+	for _, test := range tests {
+		key, val, err := splitKeyValue(test.in, test.sep)
+		if test.err != "" {
+			assert.EqualError(t, err, test.err, test.in)
+			continue
+		}
+		if assert.NoError(t, err, test.in) {
+			assert.Equal(t, test.key, key, test.in)
+			assert.Equal(t, test.val, val, test.in)
+		}
+		if test.sep == "" {
+			test.sep = ":"
+		}
+		key, _, err = splitKeyValue(escapeMapKey(test.key, test.sep)+test.sep+test.val, test.sep)
+		assert.NoError(t, err, test.in)
+		assert.Equal(t, test.key, key, test.in)
+	}
+}
+
+func TestSplitSlice(t *testing.T) {
+	tests := []struct {
+		in, sep string
+		out     []string
+		err     string
+	}{
+		{in: "a,b", out: []string{"a", "b"}},
+		{in: "", out: []string{""}},
+		{in: "a;b,c", sep: ";", out: []string{"a", "b,c"}},
+		{in: "a, b", sep: SeparatorNone, out: []string{"a, b"}},
+		{in: `"a,b",c`, out: []string{"a,b", "c"}},
+		{in: `a,"b ""c"", d",""`, out: []string{"a", `b "c", d`, ""}},
+		{in: `x"y,z`, out: []string{`x"y`, "z"}},
+		{in: `"a||b"||c`, sep: "||", out: []string{"a||b", "c"}},
+		{in: `"a,b`, err: `unterminated quoted element in "\"a,b"`},
+		{in: `"a"b,c`, err: `unexpected "b,c" after quoted element`},
+	}
+	for _, test := range tests {
+		out, err := splitSlice(test.in, test.sep)
+		if test.err != "" {
+			assert.EqualError(t, err, test.err, test.in)
+			continue
+		}
+		if assert.NoError(t, err, test.in) {
+			assert.Equal(t, test.out, out, test.in)
+		}
+		if test.sep == SeparatorNone {
+			continue
+		}
+		if test.sep == "" {
+			test.sep = ","
+		}
+		out, err = splitSlice(joinSlice(test.out, test.sep), test.sep)
+		assert.NoError(t, err, test.in)
+		assert.Equal(t, test.out, out, test.in)
+	}
+}
+
 func TestBoolValue_IsBoolFlag(t *testing.T) {
 	b := &boolValue{}
 	assert.True(t, b.IsBoolFlag())
@@ -86,3 +197,11 @@ func TestValidateValue_Set(t *testing.T) {
 	}
 	assert.EqualError(t, v.Set("newVal"), "invalid newVal")
 }
+
+func TestValidateValue_Get(t *testing.T) {
+	v := &validateValue{Value: newStringValue(strP("stringValue"))}
+	assert.Equal(t, "stringValue", v.Get())
+
+	v = &validateValue{Value: nil}
+	assert.Nil(t, v.Get())
+}
@@ -0,0 +1,301 @@
+package sflags
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// newElemFunc returns Value for a single element, ptr is a pointer to it.
+// It's used for slices and maps of types, that aren't generated.
+type newElemFunc func(ptr reflect.Value) Value
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// newTextElemFunc returns newElemFunc for types, that implement encoding.TextUnmarshaler,
+// or nil for others.
+func newTextElemFunc(typ reflect.Type) newElemFunc {
+	if !reflect.PtrTo(typ).Implements(textUnmarshalerType) {
+		return nil
+	}
+	return func(ptr reflect.Value) Value { return newTextValue(ptr) }
+}
+
+// newSliceElemFunc returns newElemFunc for slices of registered, generated or text types,
+// that are elements of maps, e.g. map[string][]string, or nil for others.
+func newSliceElemFunc(typ reflect.Type) newElemFunc {
+	if typ.Kind() != reflect.Slice {
+		return nil
+	}
+	if newValue := lookupType(typ.Elem()); newValue != nil {
+		return func(ptr reflect.Value) Value { return newElemSliceValue(ptr.Elem(), registeredElemFunc(newValue)) }
+	}
+	if parseGenerated(reflect.New(typ).Interface()) != nil {
+		return func(ptr reflect.Value) Value { return parseGenerated(ptr.Interface()) }
+	}
+	if newElem := newTextElemFunc(typ.Elem()); newElem != nil {
+		return func(ptr reflect.Value) Value { return newElemSliceValue(ptr.Elem(), newElem) }
+	}
+	return nil
+}
+
+// -- textValue
+
+// textValue adapts encoding.TextUnmarshaler to Value.
+// String uses encoding.TextMarshaler or fmt.Stringer if they are implemented.
+type textValue struct {
+	value reflect.Value // pointer to the value
+}
+
+var _ Value = (*textValue)(nil)
+var _ Getter = (*textValue)(nil)
+
+func newTextValue(ptr reflect.Value) *textValue {
+	return &textValue{value: ptr}
+}
+
+func (v *textValue) Set(s string) error {
+	return v.value.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+}
+
+func (v *textValue) Get() interface{} {
+	if v != nil && v.value.IsValid() {
+		return v.value.Elem().Interface()
+	}
+	return nil
+}
+
+func (v *textValue) String() string {
+	if v == nil || !v.value.IsValid() {
+		return ""
+	}
+	switch val := v.value.Interface().(type) {
+	case encoding.TextMarshaler:
+		text, err := val.MarshalText()
+		if err != nil {
+			return ""
+		}
+		return string(text)
+	case fmt.Stringer:
+		return val.String()
+	default:
+		return fmt.Sprintf("%v", v.value.Elem().Interface())
+	}
+}
+
+func (v *textValue) Type() string { return typeName(v.value.Type().Elem()) }
+
+// -- elemSliceValue
+
+// elemSliceValue is a slice of elements, that are parsed by newElem.
+type elemSliceValue struct {
+	value   reflect.Value // addressable slice
+	newElem newElemFunc
+	changed bool
+	sep     string
+}
+
+var _ RepeatableFlag = (*elemSliceValue)(nil)
+var _ Value = (*elemSliceValue)(nil)
+var _ Getter = (*elemSliceValue)(nil)
+
+func newElemSliceValue(slice reflect.Value, newElem newElemFunc) *elemSliceValue {
+	return &elemSliceValue{
+		value:   slice,
+		newElem: newElem,
+	}
+}
+
+func (v *elemSliceValue) Set(raw string) error {
+	ss, err := splitSlice(raw, v.sep)
+	if err != nil {
+		return err
+	}
+	out := make([]reflect.Value, 0, len(ss))
+	for _, s := range ss {
+		ptr := reflect.New(v.value.Type().Elem())
+		if err := v.newElem(ptr).Set(s); err != nil {
+			return err
+		}
+		out = append(out, ptr.Elem())
+	}
+	if !v.changed {
+		v.value.Set(reflect.MakeSlice(v.value.Type(), 0, len(out)))
+	}
+	v.value.Set(reflect.Append(v.value, out...))
+	v.changed = true
+	return nil
+}
+
+func (v *elemSliceValue) Get() interface{} {
+	if v != nil && v.value.IsValid() {
+		return v.value.Interface()
+	}
+	return nil
+}
+
+func (v *elemSliceValue) String() string {
+	if v == nil || !v.value.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, v.value.Len())
+	for i := 0; i < v.value.Len(); i++ {
+		out = append(out, v.newElem(v.value.Index(i).Addr()).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *elemSliceValue) Type() string {
+	return v.newElem(reflect.New(v.value.Type().Elem())).Type() + "Slice"
+}
+
+func (v *elemSliceValue) IsCumulative() bool {
+	return true
+}
+
+func (v *elemSliceValue) sliceSeparator() string { return v.sep }
+
+func (v *elemSliceValue) clear() {
+	v.value.Set(reflect.MakeSlice(v.value.Type(), 0, 0))
+	v.changed = true
+}
+
+func (v *elemSliceValue) appendNext() { v.changed = true }
+
+func (v *elemSliceValue) setSliceSeparator(sep string) { v.sep = sep }
+
+// -- elemMapValue
+
+// elemMapValue is a map with string or integer keys and elements, that are parsed by newElem.
+type elemMapValue struct {
+	value   reflect.Value // map
+	newElem newElemFunc
+	sep     string
+	elemSep string // separator of elements of slices, that are map values
+}
+
+var _ RepeatableFlag = (*elemMapValue)(nil)
+var _ Value = (*elemMapValue)(nil)
+var _ Getter = (*elemMapValue)(nil)
+
+func newElemMapValue(m reflect.Value, newElem newElemFunc) *elemMapValue {
+	return &elemMapValue{
+		value:   m,
+		newElem: newElem,
+	}
+}
+
+func (v *elemMapValue) Set(s string) error {
+	rawKey, rawVal, err := splitKeyValue(s, v.sep)
+	if err != nil {
+		return err
+	}
+	key, err := parseMapKey(rawKey, v.value.Type().Key())
+	if err != nil {
+		return err
+	}
+	ptr := reflect.New(v.value.Type().Elem())
+	elem := v.newElem(ptr)
+	if sliceVal, casted := elem.(sliceSeparated); casted {
+		sliceVal.setSliceSeparator(v.elemSep)
+	}
+	if err := elem.Set(rawVal); err != nil {
+		return err
+	}
+	v.value.SetMapIndex(key, ptr.Elem())
+	return nil
+}
+
+func (v *elemMapValue) Get() interface{} {
+	if v != nil && v.value.IsValid() {
+		return v.value.Interface()
+	}
+	return nil
+}
+
+// String returns elements formatted by their values in the same way as fmt does for maps.
+func (v *elemMapValue) String() string {
+	if v == nil || !v.value.IsValid() || v.value.Len() == 0 {
+		return ""
+	}
+	keys := v.value.MapKeys()
+	sortMapKeys(keys)
+	out := make([]string, 0, len(keys))
+	for _, key := range keys {
+		ptr := reflect.New(v.value.Type().Elem())
+		ptr.Elem().Set(v.value.MapIndex(key))
+		out = append(out, fmt.Sprintf("%v:%s", key.Interface(), v.newElem(ptr).String()))
+	}
+	return "map[" + strings.Join(out, " ") + "]"
+}
+
+func (v *elemMapValue) Type() string {
+	elemType := v.value.Type().Elem()
+	if elemType.Kind() == reflect.Slice {
+		return "map[" + v.value.Type().Key().String() + "][]" + typeName(elemType.Elem())
+	}
+	return "map[" + v.value.Type().Key().String() + "]" + v.newElem(reflect.New(elemType)).Type()
+}
+
+func (v *elemMapValue) IsCumulative() bool {
+	return true
+}
+
+func (v *elemMapValue) mapSeparator() string { return v.sep }
+
+func (v *elemMapValue) clear() { v.value.Set(reflect.MakeMap(v.value.Type())) }
+
+func (v *elemMapValue) appendNext() {}
+
+func (v *elemMapValue) setMapSeparator(sep string) { v.sep = sep }
+
+// elemSliceSeparator returns separator of elements of slices, that are map values.
+func (v *elemMapValue) elemSliceSeparator() string {
+	if v.elemSep == "" {
+		return defaultSliceSeparator
+	}
+	return v.elemSep
+}
+
+func (v *elemMapValue) setElemSliceSeparator(sep string) { v.elemSep = sep }
+
+// sortMapKeys sorts string or integer keys of a map.
+func sortMapKeys(keys []reflect.Value) {
+	sort.Slice(keys, func(i, j int) bool {
+		switch kind := keys[i].Kind(); {
+		case kind >= reflect.Int && kind <= reflect.Int64:
+			return keys[i].Int() < keys[j].Int()
+		case kind >= reflect.Uint && kind <= reflect.Uint64:
+			return keys[i].Uint() < keys[j].Uint()
+		default:
+			return keys[i].String() < keys[j].String()
+		}
+	})
+}
+
+// parseMapKey parses s as a map key of typ, kind of typ should be one of MapAllowedKinds.
+func parseMapKey(s string, typ reflect.Type) (reflect.Value, error) {
+	key := reflect.New(typ).Elem()
+	switch kind := typ.Kind(); {
+	case kind == reflect.String:
+		key.SetString(s)
+	case kind >= reflect.Int && kind <= reflect.Int64:
+		parsed, err := strconv.ParseInt(s, 0, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		key.SetInt(parsed)
+	case kind >= reflect.Uint && kind <= reflect.Uint64:
+		parsed, err := strconv.ParseUint(s, 0, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		key.SetUint(parsed)
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported map key type %s", typ)
+	}
+	return key, nil
+}
@@ -0,0 +1,135 @@
+package sflags
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type logLevel int
+
+func (l *logLevel) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "debug":
+		*l = 0
+	case "info":
+		*l = 1
+	case "error":
+		*l = 2
+	default:
+		return errors.New("unknown level")
+	}
+	return nil
+}
+
+func (l logLevel) MarshalText() ([]byte, error) {
+	return []byte([]string{"debug", "info", "error"}[l]), nil
+}
+
+type userID string
+
+func (id *userID) UnmarshalText(text []byte) error {
+	if !strings.HasPrefix(string(text), "u") {
+		return errors.New("id should start with u")
+	}
+	*id = userID(text)
+	return nil
+}
+
+func TestTextValue(t *testing.T) {
+	cfg := struct {
+		Level  logLevel `default:"info"`
+		User   userID
+		Rat    *big.Rat
+		Owner  *userID
+		Levels []logLevel
+		Users  map[string]userID
+		Limits map[uint8]logLevel
+	}{}
+	flags, err := ParseStruct(&cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 7)
+
+	assert.Equal(t, logLevel(1), cfg.Level)
+	assert.Equal(t, "info", flags[0].DefValue)
+	assert.Equal(t, "logLevel", flags[0].Value.Type())
+	require.NoError(t, flags[0].Value.Set("ERROR"))
+	assert.Equal(t, logLevel(2), cfg.Level)
+	assert.Equal(t, "error", flags[0].Value.String())
+	assert.EqualError(t, flags[0].Value.Set("trace"), "unknown level")
+
+	assert.Equal(t, "userID", flags[1].Value.Type())
+	require.NoError(t, flags[1].Value.Set("u42"))
+	assert.Equal(t, userID("u42"), cfg.User)
+	assert.Equal(t, "u42", flags[1].Value.String())
+	assert.Equal(t, userID("u42"), flags[1].Value.(Getter).Get())
+	assert.Error(t, flags[1].Value.Set("42"))
+
+	assert.Equal(t, "rat", flags[2].Value.Type())
+	require.NoError(t, flags[2].Value.Set("3/6"))
+	assert.Equal(t, "1/2", cfg.Rat.String())
+	assert.Equal(t, "1/2", flags[2].Value.String())
+
+	assert.Equal(t, "userID", flags[3].Value.Type())
+	require.NoError(t, flags[3].Value.Set("u1"))
+	assert.Equal(t, userID("u1"), *cfg.Owner)
+
+	assert.Equal(t, "logLevelSlice", flags[4].Value.Type())
+	assert.Equal(t, "[]", flags[4].Value.String())
+	require.NoError(t, flags[4].Value.Set("debug,info"))
+	require.NoError(t, flags[4].Value.Set("error"))
+	assert.Equal(t, []logLevel{0, 1, 2}, cfg.Levels)
+	assert.Equal(t, "[debug,info,error]", flags[4].Value.String())
+	assert.True(t, flags[4].Value.(RepeatableFlag).IsCumulative())
+	assert.EqualError(t, flags[4].Value.Set("info,trace"), "unknown level")
+	assert.Equal(t, []logLevel{0, 1, 2}, cfg.Levels)
+
+	assert.Equal(t, "map[string]userID", flags[5].Value.Type())
+	require.NoError(t, flags[5].Value.Set("admin:u1"))
+	require.NoError(t, flags[5].Value.Set("guest:u2"))
+	assert.Equal(t, map[string]userID{"admin": "u1", "guest": "u2"}, cfg.Users)
+	assert.EqualError(t, flags[5].Value.Set("admin"), "invalid map flag syntax, use -map=key1:val1")
+	assert.Error(t, flags[5].Value.Set("admin:1"))
+
+	assert.Equal(t, "map[uint8]logLevel", flags[6].Value.Type())
+	require.NoError(t, flags[6].Value.Set("1:debug"))
+	assert.Equal(t, map[uint8]logLevel{1: 0}, cfg.Limits)
+	assert.Equal(t, "map[1:debug]", flags[6].Value.String())
+	assert.Error(t, flags[6].Value.Set("one:debug"))
+	assert.Error(t, flags[6].Value.Set("256:debug"))
+}
+
+func TestMapOfSlices(t *testing.T) {
+	cfg := struct {
+		Labels map[string][]string
+		Ports  map[int][]int
+		Levels map[string][]logLevel
+		Shapes map[string][]point
+	}{}
+	flags, err := ParseStruct(&cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 4)
+
+	assert.Equal(t, "map[string][]string", flags[0].Value.Type())
+	require.NoError(t, flags[0].Value.Set("env:prod,eu"))
+	require.NoError(t, flags[0].Value.Set("team:core"))
+	assert.Equal(t, map[string][]string{"env": {"prod", "eu"}, "team": {"core"}}, cfg.Labels)
+	assert.Equal(t, "map[env:[prod,eu] team:[core]]", flags[0].Value.String())
+
+	assert.Equal(t, "map[int][]int", flags[1].Value.Type())
+	require.NoError(t, flags[1].Value.Set("1:80,443"))
+	assert.Equal(t, map[int][]int{1: {80, 443}}, cfg.Ports)
+	assert.Error(t, flags[1].Value.Set("1:http"))
+
+	assert.Equal(t, "map[string][]logLevel", flags[2].Value.Type())
+	require.NoError(t, flags[2].Value.Set("api:debug,error"))
+	assert.Equal(t, map[string][]logLevel{"api": {0, 2}}, cfg.Levels)
+
+	assert.Equal(t, "map[string][]point", flags[3].Value.Type())
+	require.NoError(t, flags[3].Value.Set("line:0x0,1x1"))
+	assert.Equal(t, map[string][]point{"line": {{0, 0}, {1, 1}}}, cfg.Shapes)
+}